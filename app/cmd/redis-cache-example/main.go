@@ -1,7 +1,14 @@
 package main
 
-import "redis/internal/app"
+import (
+	"os"
+	"redis/internal/app"
+)
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		app.Migrate(os.Args[2:])
+		return
+	}
 	app.Run()
 }
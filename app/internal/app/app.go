@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"github.com/getsentry/sentry-go"
@@ -15,6 +16,8 @@ import (
 	"redis/internal/user/cache"
 	psql "redis/internal/user/db"
 	"redis/internal/version"
+	"redis/pkg/breaker"
+	"redis/pkg/jwtauth"
 	"redis/pkg/logging"
 	"redis/pkg/monitoring"
 	"redis/pkg/tracing"
@@ -22,11 +25,19 @@ import (
 	"time"
 )
 
+// appWriteTimeout is the write timeout of the application server, request deadlines derive from it
+const appWriteTimeout = 30 * time.Second
+
 type app struct {
 	logger               logging.Logger
 	tracer               tracing.AppTracer
+	verifier             *jwtauth.Verifier
 	storage              user.Storage
 	cache                user.Cache
+	sessions             user.SessionStore
+	bus                  user.InvalidationBus
+	stopInvalidations    context.CancelFunc
+	stopKeepAlive        chan struct{}
 	appRouter, monRouter *mux.Router
 	service              user.Service
 	appSrv, monSrv       *http.Server
@@ -34,25 +45,38 @@ type app struct {
 
 func (a *app) initStorage() {
 	userStorage, err := psql.NewStorage(&a.logger)
-	a.logger.Info("Application storage initialized.")
-
 	if err != nil {
-		a.logger.Error(err.Error())
+		// the app starts anyway, requests get 503 and /ready fails until KeepAlive connects
+		a.logger.Warn("Application storage unavailable, starting degraded: " + err.Error())
+	} else {
+		a.logger.Info("Application storage initialized.")
 	}
-	a.storage = userStorage
+	// retries of one request count as a single failure for the breaker
+	a.storage = psql.NewBreaker(psql.NewRetrying(userStorage), &a.logger)
 
-	go a.storage.KeepAlive()
+	go a.storage.KeepAlive(a.stopKeepAlive)
 }
 
 func (a *app) initCache() {
 	userCache, err := cache.New(&a.logger)
-	a.logger.Info("Application cache initialized.")
-
 	if err != nil {
-		a.logger.Error(err.Error())
+		// the app starts anyway, users are read from storage and /ready fails until KeepAlive connects
+		a.logger.Warn("Application cache unavailable, starting degraded: " + err.Error())
+	} else {
+		a.logger.Info("Application cache initialized.")
 	}
-	a.cache = userCache
-	go a.cache.KeepAlive()
+	// one breaker guards users, sessions and invalidations, the local cache keeps
+	// answering from memory while it is open
+	redisCache := cache.NewBreaker(userCache, &a.logger)
+	a.cache = cache.NewLocal(redisCache)
+	a.sessions = redisCache
+	a.bus = redisCache
+	go a.cache.KeepAlive(a.stopKeepAlive)
+
+	// evict local copies of users written by other instances
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopInvalidations = cancel
+	go a.bus.SubscribeInvalidations(ctx, a.cache.Evict)
 }
 
 func (a *app) initSentry() {
@@ -78,9 +102,22 @@ func (a *app) initTracer() {
 	a.tracer = tracer
 }
 
+func (a *app) initAuth() {
+	verifier, err := jwtauth.NewFromEnv()
+	if errors.Is(err, jwtauth.ErrNotConfigured) {
+		a.logger.Info("JWT bearer authentication disabled, set JWT_HS256_SECRET or JWT_JWKS_FILE to enable it.")
+		return
+	}
+	if err != nil {
+		a.logger.Fatal("Init JWT verifier failed: " + err.Error())
+	}
+	a.logger.Info("Application JWT verifier initialized.")
+	a.verifier = verifier
+}
+
 func (a *app) initService() {
 	// TODO: refactor function params
-	userService, err := user.NewService(a.storage, a.cache, a.logger, a.tracer.TracerProvider)
+	userService, err := user.NewService(a.storage, a.cache, a.sessions, a.bus, a.logger, a.tracer.TracerProvider)
 	a.logger.Info("Application service initialized.")
 
 	if err != nil {
@@ -90,7 +127,9 @@ func (a *app) initService() {
 }
 
 func (a *app) startAppHTTPServer() {
-	userHandler := user.GetHandler(a.service)
+	a.appRouter.Use(user.SessionMiddleware(a.service), user.AuthMiddleware(a.service, a.verifier))
+	// leave a second to write the 504 once the request deadline passed
+	userHandler := user.GetHandler(a.service, appWriteTimeout-time.Second)
 	userHandler.Register(a.appRouter)
 
 	a.logger.Info("Starting server :8080")
@@ -98,7 +137,7 @@ func (a *app) startAppHTTPServer() {
 	srv := &http.Server{
 		Handler:      a.appRouter,
 		Addr:         ":8080",
-		WriteTimeout: 30 * time.Second,
+		WriteTimeout: appWriteTimeout,
 		ReadTimeout:  30 * time.Second,
 	}
 
@@ -114,10 +153,12 @@ func (a *app) startAppHTTPServer() {
 func (a *app) startMonHTTPServer() {
 	hc := healthcheck.NewHandler()
 	hc.AddLivenessCheck("goroutine-threshold", user.GoroutineCountCheck(1000))
-	hc.AddReadinessCheck("database", user.DatabasePingCheck(a.storage, 1*time.Second))
+	// a database outage alone leaves the instance ready but degraded, it serves users from cache
+	readiness := user.NewReadiness(a.storage, a.cache, 1*time.Second)
+	hc.AddReadinessCheck("database", readiness.DatabaseCheck())
 	hc.AddReadinessCheck("cache", user.CachePingCheck(a.cache, 1*time.Second))
 	metricsHandler := monitoring.GetHandler(a.logger)
-	metricsHandler.Register(a.monRouter, hc)
+	metricsHandler.Register(a.monRouter, monitoring.WithReports(monitoring.WithDegraded(hc, readiness.Degraded), breakerReports))
 
 	srvMon := &http.Server{
 		Handler:      a.monRouter,
@@ -137,10 +178,21 @@ func (a *app) startMonHTTPServer() {
 	a.monSrv = srvMon
 }
 
+// breakerReports lists circuit breaker states on /ready?full=1, an open postgresql breaker
+// leaves the instance ready while redis answers and would not show up among the checks
+func breakerReports() map[string]string {
+	reports := map[string]string{}
+	for name, state := range breaker.States() {
+		reports[name+"-circuit-breaker"] = state.String()
+	}
+	return reports
+}
+
 func (a *app) start() {
 	a.parseArgs()
 	a.initSentry()
 	a.initTracer()
+	a.initAuth()
 	a.initStorage()
 	a.initCache()
 	a.initService()
@@ -160,6 +212,8 @@ func (a *app) shutdown() {
 		a.fatalServer(err)
 	}
 	serverCancel()
+	a.stopInvalidations()
+	close(a.stopKeepAlive)
 	a.storage.Close()
 	err = a.cache.Close()
 	if err != nil {
@@ -207,15 +261,18 @@ func newApp() *app {
 	logger.Info("Metrics router initialized.")
 
 	return &app{
-		logger:    logger,
-		tracer:    tracing.AppTracer{},
-		storage:   nil,
-		cache:     nil,
-		appRouter: router,
-		monRouter: metricsRouter,
-		service:   nil,
-		appSrv:    nil,
-		monSrv:    nil,
+		logger:        logger,
+		tracer:        tracing.AppTracer{},
+		verifier:      nil,
+		storage:       nil,
+		cache:         nil,
+		sessions:      nil,
+		stopKeepAlive: make(chan struct{}),
+		appRouter:     router,
+		monRouter:     metricsRouter,
+		service:       nil,
+		appSrv:        nil,
+		monSrv:        nil,
 	}
 }
 
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"redis/migrations"
+	"redis/pkg/logging"
+	"redis/pkg/migrate"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const migrateUsage = `usage: redis-cache-example migrate <command> [flags]
+
+commands:
+  up                 apply all pending migrations
+  down [-steps N]    revert the last N applied migrations (default 1)
+  status             show applied and pending migrations
+  create [-dir DIR] NAME
+                     write an empty up/down pair for the next version`
+
+// Migrate runs the migrate subcommand with args following "migrate"
+func Migrate(args []string) {
+	logger := logging.GetLogger()
+
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		os.Exit(2)
+	}
+
+	cmd, args := args[0], args[1:]
+	if cmd == "create" {
+		fs := flag.NewFlagSet("migrate create", flag.ExitOnError)
+		dir := fs.String("dir", "migrations", "directory with migration files")
+		_ = fs.Parse(args)
+		if fs.NArg() != 1 {
+			fmt.Fprintln(os.Stderr, migrateUsage)
+			os.Exit(2)
+		}
+		up, down, err := migrate.Create(*dir, fs.Arg(0))
+		if err != nil {
+			logger.Fatal("Create migration failed: " + err.Error())
+		}
+		logger.Info("Migration created.", logger.String("up", up), logger.String("down", down))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	pool, err := pgxpool.Connect(ctx, os.Getenv("DATABASE_URL"))
+	if err != nil {
+		logger.Fatal("Connect to database failed: " + err.Error())
+	}
+	defer pool.Close()
+
+	m, err := migrate.New(pool, migrations.FS, &logger)
+	if err != nil {
+		logger.Fatal("Load migrations failed: " + err.Error())
+	}
+
+	switch cmd {
+	case "up":
+		n, err := m.Up(ctx)
+		if err != nil {
+			logger.Fatal("Migrate up failed: " + err.Error())
+		}
+		logger.Info("Migrate up finished.", logger.Int("applied", n))
+	case "down":
+		fs := flag.NewFlagSet("migrate down", flag.ExitOnError)
+		steps := fs.Int("steps", 1, "number of migrations to revert")
+		_ = fs.Parse(args)
+		n, err := m.Down(ctx, *steps)
+		if err != nil {
+			logger.Fatal("Migrate down failed: " + err.Error())
+		}
+		logger.Info("Migrate down finished.", logger.Int("reverted", n))
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			logger.Fatal("Migrate status failed: " + err.Error())
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+		for _, s := range statuses {
+			state, at := "pending", ""
+			if s.Applied {
+				state, at = "applied", s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", s.Version, s.Name, state, at)
+		}
+		_ = w.Flush()
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,203 @@
+package user
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+const (
+	loginURL  = "/auth/login"
+	logoutURL = "/auth/logout"
+)
+
+const (
+	sessionHeader = "X-Session-Token"
+	sessionCookie = "session"
+)
+
+type LoginRequest struct {
+	NickName string `json:"nickname"`
+	Pass     string `json:"pass"`
+}
+
+type LoginResponse struct {
+	Token     string    `json:"token"`
+	ExpiresIn int64     `json:"expires_in"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (l *LoginRequest) Validate() error {
+	ve := &ValidationError{}
+	if l.NickName == "" {
+		ve.Add("nickname", "is required")
+	}
+	if l.Pass == "" {
+		ve.Add("pass", "is required")
+	}
+	if len(ve.Fields) > 0 {
+		return ve
+	}
+	return nil
+}
+
+// Login Handler opens a session and returns its opaque token, also as a cookie
+func (h *userHandler) login(w http.ResponseWriter, r *http.Request) {
+	tracer := h.UserService.getTracer()
+	tr := tracer.Tracer("Handler.login")
+	// Return ctx, cancelFunc, opts
+	opts, cancel, reqCtx := h.configTracer(r)
+	defer cancel()
+
+	parentCtx, span := tr.Start(reqCtx, "Login", opts...)
+	defer span.End()
+
+	h.setSpanAttributes(span, r)
+
+	req := &LoginRequest{}
+	if err := parseLogin(r, req); err != nil {
+		h.handleErrorResponse(&respData{
+			w:          &w,
+			r:          r,
+			span:       span,
+			httpMethod: http.MethodPost,
+			payload:    err,
+		})
+		return
+	}
+
+	sess, err := h.UserService.login(req.NickName, req.Pass, parentCtx)
+	if err != nil {
+		h.handleErrorResponse(&respData{
+			w:          &w,
+			r:          r,
+			span:       span,
+			httpMethod: http.MethodPost,
+			payload:    err,
+		})
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    sess.Token,
+		Path:     "/",
+		Expires:  sess.ExpiresAt,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	})
+	h.handleSuccessResponse(&respData{
+		w:          &w,
+		span:       span,
+		statusCode: http.StatusOK,
+		httpMethod: http.MethodPost,
+		payload: LoginResponse{
+			Token:     sess.Token,
+			ExpiresIn: int64(time.Until(sess.ExpiresAt).Seconds()),
+			ExpiresAt: sess.ExpiresAt,
+		},
+	})
+}
+
+// Logout Handler drops the session passed in the header or cookie
+func (h *userHandler) logout(w http.ResponseWriter, r *http.Request) {
+	tracer := h.UserService.getTracer()
+	tr := tracer.Tracer("Handler.logout")
+	// Return ctx, cancelFunc, opts
+	opts, cancel, reqCtx := h.configTracer(r)
+	defer cancel()
+
+	parentCtx, span := tr.Start(reqCtx, "Logout", opts...)
+	defer span.End()
+
+	h.setSpanAttributes(span, r)
+
+	sess, ok := SessionFromContext(r.Context())
+	if !ok {
+		h.handleErrorResponse(&respData{
+			w:          &w,
+			r:          r,
+			span:       span,
+			httpMethod: http.MethodPost,
+			payload:    Wrap(ErrUnauthorized, errors.New("no active session")),
+		})
+		return
+	}
+
+	if err := h.UserService.logout(sess.Token, parentCtx); err != nil {
+		h.handleErrorResponse(&respData{
+			w:          &w,
+			r:          r,
+			span:       span,
+			httpMethod: http.MethodPost,
+			payload:    err,
+		})
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+	w.WriteHeader(http.StatusNoContent)
+	httpStatusCodes.WithLabelValues("204", http.MethodPost).Inc()
+}
+
+// SessionMiddleware resolves a session token from the X-Session-Token header or the session cookie
+// into the request context. Requests without a valid session pass through anonymous, handlers decide
+// whether they need one via SessionFromContext. A session store that cannot answer fails the request,
+// a logged in user must not be treated as anonymous.
+func SessionMiddleware(userService Service) mux.MiddlewareFunc {
+	h := &userHandler{UserService: userService}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := sessionToken(r)
+			if token == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			sess, err := userService.resolveSession(token, r.Context())
+			if errors.Is(err, ErrUnauthorized) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err != nil {
+				h.handleErrorResponse(&respData{
+					w:          &w,
+					r:          r,
+					span:       otrace.SpanFromContext(r.Context()),
+					httpMethod: r.Method,
+					payload:    err,
+				})
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(contextWithSession(r.Context(), sess)))
+		})
+	}
+}
+
+func parseLogin(r *http.Request, l *LoginRequest) error {
+	if err := parseBody(r, l); err != nil {
+		return err
+	}
+	return l.Validate()
+}
+
+func sessionToken(r *http.Request) string {
+	if token := strings.TrimSpace(r.Header.Get(sessionHeader)); token != "" {
+		return token
+	}
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		return c.Value
+	}
+	return ""
+}
@@ -0,0 +1,132 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"redis/pkg/jwtauth"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+const (
+	RoleAdmin = "admin"
+	RoleUser  = "user"
+)
+
+// route names used to look up authorization policies
+const (
+	updateUserRoute = "updateUser"
+	deleteUserRoute = "deleteUser"
+)
+
+// Principal is the authenticated caller, Subject is the user id
+type Principal struct {
+	Subject string
+	Roles   []string
+}
+
+func (p Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Policy decides whether principal may call the matched route, a nil principal means anonymous
+type Policy func(p *Principal, r *http.Request) error
+
+// routes without a policy stay public
+var routePolicies = map[string]Policy{
+	updateUserRoute: adminOrSelf,
+	deleteUserRoute: adminOrSelf,
+}
+
+type principalCtxKey struct{}
+
+// PrincipalFromContext returns the caller resolved by AuthMiddleware
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalCtxKey{}).(Principal)
+	return p, ok
+}
+
+func authenticated(p *Principal) error {
+	if p == nil {
+		return Wrap(ErrUnauthorized, errors.New("authentication required"))
+	}
+	return nil
+}
+
+// adminOrSelf lets admins act on any user and everyone else only on their own id
+func adminOrSelf(p *Principal, r *http.Request) error {
+	if err := authenticated(p); err != nil {
+		return err
+	}
+	if p.HasRole(RoleAdmin) || p.Subject == mux.Vars(r)["id"] {
+		return nil
+	}
+	return Wrap(ErrForbidden, errors.New("only admins may act on other users"))
+}
+
+// AuthMiddleware authenticates the caller with a JWT bearer token or, without one, with the session
+// resolved by SessionMiddleware, and enforces the policy of the matched route.
+// A nil verifier disables bearer tokens.
+func AuthMiddleware(userService Service, verifier *jwtauth.Verifier) mux.MiddlewareFunc {
+	h := &userHandler{UserService: userService}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, err := resolvePrincipal(r, verifier)
+			if err == nil {
+				if policy, ok := routePolicies[mux.CurrentRoute(r).GetName()]; ok {
+					err = policy(principal, r)
+				}
+			}
+			if err != nil {
+				if errors.Is(err, ErrUnauthorized) {
+					w.Header().Set("WWW-Authenticate", `Bearer realm="redis-cache-example"`)
+				}
+				h.handleErrorResponse(&respData{
+					w:          &w,
+					r:          r,
+					span:       otrace.SpanFromContext(r.Context()),
+					httpMethod: r.Method,
+					payload:    err,
+				})
+				return
+			}
+
+			if principal != nil {
+				r = r.WithContext(context.WithValue(r.Context(), principalCtxKey{}, *principal))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func resolvePrincipal(r *http.Request, verifier *jwtauth.Verifier) (*Principal, error) {
+	header := r.Header.Get("Authorization")
+	if header != "" {
+		token := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+		if token == header || token == "" {
+			return nil, Wrap(ErrUnauthorized, errors.New("authorization header must use the Bearer scheme"))
+		}
+		if verifier == nil {
+			return nil, Wrap(ErrUnauthorized, errors.New("bearer tokens are not accepted"))
+		}
+		claims, err := verifier.Verify(token)
+		if err != nil {
+			return nil, Wrap(ErrUnauthorized, err)
+		}
+		return &Principal{Subject: claims.Subject, Roles: claims.Roles}, nil
+	}
+
+	if sess, ok := SessionFromContext(r.Context()); ok {
+		return &Principal{Subject: strconv.FormatInt(sess.UserId, 10), Roles: []string{RoleUser}}, nil
+	}
+	return nil, nil
+}
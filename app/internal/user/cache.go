@@ -2,18 +2,51 @@ package user
 
 import (
 	"context"
+	"time"
 )
 
+// Freshness describes a cached user. FreshFor turns negative once the entry is stale, a stale
+// entry is still served while a single background refresh replaces it. Beta weights the
+// probabilistic early refresh, 0 disables it.
+type Freshness struct {
+	FreshFor time.Duration
+	Beta     float64
+}
+
+func (f Freshness) Stale() bool {
+	return f.FreshFor <= 0
+}
+
+// Cache builds its own keys, callers pass ids, nicknames and page bounds
 type Cache interface {
-	Get(ctx context.Context, id string) (u User, err error)
-	GetAll(ctx context.Context, key string) (users []User, err error)
+	Get(ctx context.Context, id string) (u User, f Freshness, err error)
+	GetByNickname(ctx context.Context, nickname string) (u User, f Freshness, err error)
+	GetAll(ctx context.Context, version, limit, offset int64) (users []User, err error)
+	// GetLastKnown returns the long lived copy written by Set, meant for when storage is unavailable
+	GetLastKnown(ctx context.Context, id string) (u User, err error)
+	// Set and SetByNickname store a user loaded from storage. While a tombstone left by Invalidate
+	// lives they store nothing and return nil, the user may have been read before the write.
 	Set(ctx context.Context, u User) error
-	SetAll(ctx context.Context, key string, val []User) error
-	Del(ctx context.Context, id string) error
 	SetByNickname(ctx context.Context, u User) error
+	SetAll(ctx context.Context, version, limit, offset int64, val []User) error
+	// SetMissing and SetMissingByNickname remember an absent user for a short ttl, tombstones
+	// included. Get and GetByNickname return ErrCachedNotFound until it expires or Invalidate drops it.
+	SetMissing(ctx context.Context, id string) error
+	SetMissingByNickname(ctx context.Context, nickname string) error
+	Del(ctx context.Context, id string) error
 	Expire(ctx context.Context, id string) error
-	ExpireAll(ctx context.Context, key string) error
+	ExpireByNickname(ctx context.Context, nickname string) error
+	ExpireAll(ctx context.Context, version, limit, offset int64) error
+	// PageVersion is the current generation of list pages, read it before the storage query
+	// and pass it to GetAll/SetAll so a page loaded before a write never lands in the new generation
+	PageVersion(ctx context.Context) (int64, error)
+	// Invalidate replaces id and nickname entries of every given user with short lived tombstones
+	// and retires all list pages. Writers call it instead of Set, the next read loads the new row.
+	Invalidate(ctx context.Context, users ...User) error
+	// Evict drops copies held by this process only, shared entries are left to Invalidate
+	Evict(inv Invalidation)
 	PingClient(ctx context.Context) error
 	Close() error
-	KeepAlive()
+	// KeepAlive reconnects in the background until stop is closed
+	KeepAlive(stop <-chan struct{})
 }
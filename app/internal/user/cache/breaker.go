@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"redis/internal/user"
+	"redis/pkg/breaker"
+	"redis/pkg/logging"
+)
+
+var _ Redis = &breakerCache{}
+
+// Redis is everything the adapter serves over its one client, users, sessions and invalidations
+type Redis interface {
+	user.Cache
+	user.SessionStore
+	user.InvalidationBus
+}
+
+// breakerCache fails fast while redis keeps failing, the service then treats every
+// lookup as a miss and goes to storage without waiting on redis first. Sessions and
+// invalidations share the breaker, they fail on the same client.
+type breakerCache struct {
+	next    Redis
+	breaker *breaker.Breaker
+}
+
+// NewBreaker wraps next with a circuit breaker configured by CACHE_BREAKER_FAILURES,
+// CACHE_BREAKER_OPEN_TIMEOUT and CACHE_BREAKER_HALF_OPEN_REQUESTS. With 0 failures next is returned as is.
+func NewBreaker(next Redis, appLogger *logging.Logger) Redis {
+	settings := breaker.SettingsFromEnv("CACHE")
+	if settings.Failures == 0 {
+		return next
+	}
+	settings.Name = "redis"
+	settings.IsFailure = breaker.Outages(user.ErrUnavailable, user.ErrTimeout)
+	settings.OnStateChange = func(from, to breaker.State) {
+		appLogger.Warn("Redis circuit breaker " + from.String() + " -> " + to.String())
+	}
+	return &breakerCache{next: next, breaker: breaker.New(settings)}
+}
+
+func (b *breakerCache) do(fn func() error) error {
+	err := b.breaker.Do(fn)
+	if errors.Is(err, breaker.ErrOpen) {
+		return user.Wrap(user.ErrUnavailable, errors.New("redis "+err.Error()))
+	}
+	return err
+}
+
+func (b *breakerCache) Get(ctx context.Context, id string) (u user.User, f user.Freshness, err error) {
+	err = b.do(func() error {
+		u, f, err = b.next.Get(ctx, id)
+		return err
+	})
+	return u, f, err
+}
+
+func (b *breakerCache) GetByNickname(ctx context.Context, nickname string) (u user.User, f user.Freshness, err error) {
+	err = b.do(func() error {
+		u, f, err = b.next.GetByNickname(ctx, nickname)
+		return err
+	})
+	return u, f, err
+}
+
+func (b *breakerCache) GetAll(ctx context.Context, version, limit, offset int64) (users []user.User, err error) {
+	err = b.do(func() error {
+		users, err = b.next.GetAll(ctx, version, limit, offset)
+		return err
+	})
+	return users, err
+}
+
+func (b *breakerCache) GetLastKnown(ctx context.Context, id string) (u user.User, err error) {
+	err = b.do(func() error {
+		u, err = b.next.GetLastKnown(ctx, id)
+		return err
+	})
+	return u, err
+}
+
+func (b *breakerCache) Set(ctx context.Context, u user.User) error {
+	return b.do(func() error { return b.next.Set(ctx, u) })
+}
+
+func (b *breakerCache) SetByNickname(ctx context.Context, u user.User) error {
+	return b.do(func() error { return b.next.SetByNickname(ctx, u) })
+}
+
+func (b *breakerCache) SetAll(ctx context.Context, version, limit, offset int64, val []user.User) error {
+	return b.do(func() error { return b.next.SetAll(ctx, version, limit, offset, val) })
+}
+
+func (b *breakerCache) SetMissing(ctx context.Context, id string) error {
+	return b.do(func() error { return b.next.SetMissing(ctx, id) })
+}
+
+func (b *breakerCache) SetMissingByNickname(ctx context.Context, nickname string) error {
+	return b.do(func() error { return b.next.SetMissingByNickname(ctx, nickname) })
+}
+
+func (b *breakerCache) Del(ctx context.Context, id string) error {
+	return b.do(func() error { return b.next.Del(ctx, id) })
+}
+
+func (b *breakerCache) Expire(ctx context.Context, id string) error {
+	return b.do(func() error { return b.next.Expire(ctx, id) })
+}
+
+func (b *breakerCache) ExpireByNickname(ctx context.Context, nickname string) error {
+	return b.do(func() error { return b.next.ExpireByNickname(ctx, nickname) })
+}
+
+func (b *breakerCache) ExpireAll(ctx context.Context, version, limit, offset int64) error {
+	return b.do(func() error { return b.next.ExpireAll(ctx, version, limit, offset) })
+}
+
+func (b *breakerCache) PageVersion(ctx context.Context) (version int64, err error) {
+	err = b.do(func() error {
+		version, err = b.next.PageVersion(ctx)
+		return err
+	})
+	return version, err
+}
+
+func (b *breakerCache) Invalidate(ctx context.Context, users ...user.User) error {
+	return b.do(func() error { return b.next.Invalidate(ctx, users...) })
+}
+
+func (b *breakerCache) Evict(inv user.Invalidation) {
+	b.next.Evict(inv)
+}
+
+func (b *breakerCache) CreateSession(ctx context.Context, s *user.Session) error {
+	return b.do(func() error { return b.next.CreateSession(ctx, s) })
+}
+
+func (b *breakerCache) GetSession(ctx context.Context, token string) (s user.Session, err error) {
+	err = b.do(func() error {
+		s, err = b.next.GetSession(ctx, token)
+		return err
+	})
+	return s, err
+}
+
+func (b *breakerCache) DeleteSession(ctx context.Context, token string) error {
+	return b.do(func() error { return b.next.DeleteSession(ctx, token) })
+}
+
+func (b *breakerCache) PublishInvalidation(ctx context.Context, inv user.Invalidation) error {
+	return b.do(func() error { return b.next.PublishInvalidation(ctx, inv) })
+}
+
+// SubscribeInvalidations passes through, it runs for the life of the process and resubscribes
+// at its own pace like KeepAlive, a single result at shutdown would say nothing about redis
+func (b *breakerCache) SubscribeInvalidations(ctx context.Context, handle func(user.Invalidation)) {
+	b.next.SubscribeInvalidations(ctx, handle)
+}
+
+// PingClient goes through the breaker too, an open breaker fails the readiness check
+// and readiness pings serve as probes once it turns half-open
+func (b *breakerCache) PingClient(ctx context.Context) error {
+	return b.do(func() error { return b.next.PingClient(ctx) })
+}
+
+func (b *breakerCache) Close() error {
+	return b.next.Close()
+}
+
+func (b *breakerCache) KeepAlive(stop <-chan struct{}) {
+	b.next.KeepAlive(stop)
+}
@@ -0,0 +1,133 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Codec serializes cache values. Every stored value starts with the ID of the codec
+// that wrote it, so switching CACHE_CODEC keeps existing entries readable until they expire.
+type Codec interface {
+	ID() byte
+	Name() string
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// codec IDs are persisted in redis, never reuse or renumber them
+const (
+	gobCodecID     byte = 1
+	jsonCodecID    byte = 2
+	msgpackCodecID byte = 3
+)
+
+const defaultCodec = "gob"
+
+var codecs = map[byte]Codec{
+	gobCodecID:     gobCodec{},
+	jsonCodecID:    jsonCodec{},
+	msgpackCodecID: msgpackCodec{},
+}
+
+var errUnknownCodec = errors.New("unknown cache codec")
+
+// codecFromEnv reads CACHE_CODEC, one of gob, json or msgpack. An unknown name
+// returns gob along with the error so a typo never leaves the cache without a codec.
+func codecFromEnv() (Codec, error) {
+	name := strings.ToLower(os.Getenv("CACHE_CODEC"))
+	if name == "" {
+		name = defaultCodec
+	}
+	for _, c := range codecs {
+		if c.Name() == name {
+			return c, nil
+		}
+	}
+	return gobCodec{}, fmt.Errorf("%w: %q, using %s", errUnknownCodec, name, defaultCodec)
+}
+
+// encode marshals v with c, compresses the result when cmp asks for it and
+// prefixes it with the header byte
+func encode(c Codec, cmp compression, v interface{}) ([]byte, error) {
+	b, err := c.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	b, compressorID := cmp.compress(b)
+	return append([]byte{c.ID() | compressorID<<compressionShift}, b...), nil
+}
+
+// decode picks codec and compressor by the header byte, whatever is configured for writes
+func decode(data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return errors.New("empty cache value")
+	}
+	c, ok := codecs[data[0]&codecMask]
+	if !ok {
+		return fmt.Errorf("%w: id %d", errUnknownCodec, data[0]&codecMask)
+	}
+	b, err := decompress(data[0]>>compressionShift, data[1:])
+	if err != nil {
+		return err
+	}
+	return c.Unmarshal(b, v)
+}
+
+type gobCodec struct{}
+
+func (gobCodec) ID() byte     { return gobCodecID }
+func (gobCodec) Name() string { return "gob" }
+
+func (gobCodec) Marshal(v interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(v); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func (gobCodec) Unmarshal(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) ID() byte     { return jsonCodecID }
+func (jsonCodec) Name() string { return "json" }
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// msgpackCodec reuses the json tags so both codecs agree on field names
+type msgpackCodec struct{}
+
+func (msgpackCodec) ID() byte     { return msgpackCodecID }
+func (msgpackCodec) Name() string { return "msgpack" }
+
+func (msgpackCodec) Marshal(v interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	enc := msgpack.NewEncoder(&b)
+	enc.SetCustomStructTag("json")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func (msgpackCodec) Unmarshal(data []byte, v interface{}) error {
+	dec := msgpack.NewDecoder(bytes.NewReader(data))
+	dec.SetCustomStructTag("json")
+	return dec.Decode(v)
+}
@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"redis/internal/user"
+)
+
+var allCodecs = []Codec{gobCodec{}, jsonCodec{}, msgpackCodec{}}
+
+var benchUser = user.User{Id: 42, NickName: "john_doe", FistName: "John", LastName: "Doe", Gender: "male", Status: 1}
+
+// benchPage is a full list page, the largest value the service caches
+func benchPage() []user.User {
+	users := make([]user.User, 100)
+	for i := range users {
+		u := benchUser
+		u.Id = int64(i + 1)
+		u.NickName = fmt.Sprintf("john_doe_%d", i)
+		users[i] = u
+	}
+	return users
+}
+
+type benchValue struct {
+	name  string
+	value interface{}
+}
+
+func benchValues() []benchValue {
+	return []benchValue{{"user", benchUser}, {"page", benchPage()}}
+}
+
+func TestDecodeRoundTrip(t *testing.T) {
+	for _, c := range allCodecs {
+		for _, cmp := range []compression{{}, {compressor: snappyCompressor{}}, {compressor: zstdCompressor{}}} {
+			data, err := encode(c, cmp, benchPage())
+			if err != nil {
+				t.Fatalf("%s: %v", c.Name(), err)
+			}
+			var got []user.User
+			if err := decode(data, &got); err != nil {
+				t.Fatalf("%s: %v", c.Name(), err)
+			}
+			if !reflect.DeepEqual(got, benchPage()) {
+				t.Errorf("%s: decoded page differs", c.Name())
+			}
+		}
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	for _, v := range benchValues() {
+		for _, c := range allCodecs {
+			b.Run(c.Name()+"/"+v.name, func(b *testing.B) {
+				var data []byte
+				var err error
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if data, err = encode(c, compression{}, v.value); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.ReportMetric(float64(len(data)), "payload-bytes")
+			})
+		}
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	for _, v := range benchValues() {
+		for _, c := range allCodecs {
+			b.Run(c.Name()+"/"+v.name, func(b *testing.B) {
+				data, err := encode(c, compression{}, v.value)
+				if err != nil {
+					b.Fatal(err)
+				}
+				target := reflect.New(reflect.TypeOf(v.value))
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if err := decode(data, target.Interface()); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.ReportMetric(float64(len(data)), "payload-bytes")
+			})
+		}
+	}
+}
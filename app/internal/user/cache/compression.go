@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"redis/pkg/env"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// The header byte of a stored value keeps the codec ID in the low nibble and the
+// compressor ID in the high one, values written before compression existed read as uncompressed.
+const (
+	codecMask        byte = 0x0f
+	compressionShift      = 4
+)
+
+// compressor IDs are persisted in redis, never reuse or renumber them
+const (
+	noCompressionID byte = 0
+	snappyID        byte = 1
+	zstdID          byte = 2
+)
+
+const defaultCompressionThreshold = 1024
+
+type compressor interface {
+	ID() byte
+	Name() string
+	Compress(src []byte) []byte
+	Decompress(src []byte) ([]byte, error)
+}
+
+var (
+	// zstd encoder and decoder are safe for concurrent EncodeAll/DecodeAll calls
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+var compressors = map[byte]compressor{
+	snappyID: snappyCompressor{},
+	zstdID:   zstdCompressor{},
+}
+
+// compression compresses encoded values of at least threshold bytes, a nil compressor disables it
+type compression struct {
+	compressor compressor
+	threshold  int
+}
+
+// compressionFromEnv reads CACHE_COMPRESSION, one of none, snappy or zstd, and
+// CACHE_COMPRESSION_THRESHOLD in bytes. An unknown name disables compression along with the error.
+func compressionFromEnv() (compression, error) {
+	cmp := compression{threshold: env.Int("CACHE_COMPRESSION_THRESHOLD", defaultCompressionThreshold)}
+
+	name := strings.ToLower(os.Getenv("CACHE_COMPRESSION"))
+	if name == "" || name == "none" {
+		return cmp, nil
+	}
+	for _, c := range compressors {
+		if c.Name() == name {
+			cmp.compressor = c
+			return cmp, nil
+		}
+	}
+	return cmp, fmt.Errorf("unknown cache compression %q, compression disabled", name)
+}
+
+// compress returns data unchanged with noCompressionID when it is below the threshold
+// or does not shrink
+func (cmp compression) compress(data []byte) ([]byte, byte) {
+	if cmp.compressor == nil || len(data) < cmp.threshold {
+		return data, noCompressionID
+	}
+	out := cmp.compressor.Compress(data)
+	if len(out) >= len(data) {
+		return data, noCompressionID
+	}
+	name := cmp.compressor.Name()
+	compressedValuesTotal.WithLabelValues(name).Inc()
+	compressionSavedBytes.WithLabelValues(name).Add(float64(len(data) - len(out)))
+	return out, cmp.compressor.ID()
+}
+
+func decompress(id byte, data []byte) ([]byte, error) {
+	if id == noCompressionID {
+		return data, nil
+	}
+	c, ok := compressors[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown cache compression id %d", id)
+	}
+	return c.Decompress(data)
+}
+
+type snappyCompressor struct{}
+
+func (snappyCompressor) ID() byte     { return snappyID }
+func (snappyCompressor) Name() string { return "snappy" }
+
+func (snappyCompressor) Compress(src []byte) []byte {
+	return snappy.Encode(nil, src)
+}
+
+func (snappyCompressor) Decompress(src []byte) ([]byte, error) {
+	return snappy.Decode(nil, src)
+}
+
+type zstdCompressor struct{}
+
+func (zstdCompressor) ID() byte     { return zstdID }
+func (zstdCompressor) Name() string { return "zstd" }
+
+func (zstdCompressor) Compress(src []byte) []byte {
+	return zstdEncoder.EncodeAll(src, nil)
+}
+
+func (zstdCompressor) Decompress(src []byte) ([]byte, error) {
+	return zstdDecoder.DecodeAll(src, nil)
+}
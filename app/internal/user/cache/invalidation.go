@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"redis/internal/user"
+	"time"
+
+	"github.com/go-redis/redis/v9"
+)
+
+var _ user.InvalidationBus = &cache{}
+
+const invalidationKind = "invalidations"
+
+// invalidationChannel is the pub/sub channel shared by all instances, user:v1:invalidations
+func invalidationChannel() string {
+	return buildKey(invalidationKind)
+}
+
+// newInstanceID tells the invalidations of this process apart from those of its replicas
+func newInstanceID() string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d-%d", host, os.Getpid(), time.Now().UnixNano())
+}
+
+// PublishInvalidation sends inv as JSON so instances built with another cache codec still understand it
+func (c *cache) PublishInvalidation(ctx context.Context, inv user.Invalidation) error {
+	inv.Origin = c.instance
+	b, err := json.Marshal(inv)
+	if err != nil {
+		return err
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return translateError(client.Publish(ctx, invalidationChannel(), b).Err())
+}
+
+// SubscribeInvalidations resubscribes every KeepAlivePollPeriod while redis is unreachable,
+// like KeepAlive, and asks handle to drop everything once it is back as events may have been lost
+func (c *cache) SubscribeInvalidations(ctx context.Context, handle func(user.Invalidation)) {
+	missed := false
+	for ctx.Err() == nil {
+		if client, err := c.client(); err == nil {
+			ps := client.Subscribe(ctx, invalidationChannel())
+			// the first reply confirms the subscription
+			if _, err := ps.Receive(ctx); err == nil {
+				if missed {
+					handle(user.Invalidation{All: true})
+					missed = false
+				}
+				c.logger.Info("Subscribed to cache invalidations")
+				c.receiveInvalidations(ctx, ps, handle)
+			} else if ctx.Err() == nil {
+				c.logger.Error("Subscribe to cache invalidations failed: " + err.Error())
+			}
+			_ = ps.Close()
+		}
+		missed = true
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second * KeepAlivePollPeriod):
+			c.logger.Info("Resubscribe to cache invalidations...")
+		}
+	}
+}
+
+func (c *cache) receiveInvalidations(ctx context.Context, ps *redis.PubSub, handle func(user.Invalidation)) {
+	for {
+		msg, err := ps.ReceiveMessage(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				c.logger.Error("Cache invalidations subscription lost: " + err.Error())
+			}
+			return
+		}
+
+		var inv user.Invalidation
+		if err := json.Unmarshal([]byte(msg.Payload), &inv); err != nil {
+			c.logger.Error("Malformed cache invalidation: " + err.Error())
+			continue
+		}
+		// this instance already dropped its own copies before publishing
+		if inv.Origin == c.instance {
+			continue
+		}
+		handle(inv)
+	}
+}
+
+// Evict has nothing to drop, every entry of this cache is shared by all instances
+func (c *cache) Evict(user.Invalidation) {}
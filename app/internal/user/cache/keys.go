@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Every key is <namespace>:<version>:<kind>:<value>. Bumping keyVersion orphans all
+// entries written by the previous layout instead of decoding them wrongly, a change
+// decode can tell apart by the header byte keeps it.
+const (
+	keyNamespace = "user"
+	keyVersion   = "v1"
+	keyDelimiter = ":"
+)
+
+const (
+	idKind          = "id"
+	lastKnownKind   = "last-known"
+	nicknameKind    = "nick"
+	pageKind        = "page"
+	pageVersionKind = "page-version"
+	sessionKind     = "session"
+)
+
+func buildKey(kind string, parts ...string) string {
+	return strings.Join(append([]string{keyNamespace, keyVersion, kind}, parts...), keyDelimiter)
+}
+
+// idKey returns user:v1:id:42
+func idKey(id string) string {
+	return buildKey(idKind, id)
+}
+
+// lastKnownKey returns user:v1:last-known:42
+func lastKnownKey(id string) string {
+	return buildKey(lastKnownKind, id)
+}
+
+// nicknameKey lowercases like the storage lookup and escapes the delimiter, user:v1:nick:john%3Adoe
+func nicknameKey(nickname string) string {
+	return buildKey(nicknameKind, url.QueryEscape(strings.ToLower(nickname)))
+}
+
+// pageKey keeps limit and offset apart and carries the page generation, user:v1:page:7:12:3
+// never equals user:v1:page:7:1:23 and every write moves readers to generation 8
+func pageKey(version, limit, offset int64) string {
+	return buildKey(pageKind, strconv.FormatInt(version, 10), strconv.FormatInt(limit, 10), strconv.FormatInt(offset, 10))
+}
+
+// pageVersionKey holds the page generation counter, user:v1:page-version
+func pageVersionKey() string {
+	return buildKey(pageVersionKind)
+}
+
+// sessionKey expects a hex digest, never a raw token
+func sessionKey(digest string) string {
+	return buildKey(sessionKind, digest)
+}
@@ -0,0 +1,276 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"redis/internal/user"
+	"redis/pkg/env"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var _ user.Cache = &local{}
+
+const defaultLocalTTL = 2 * time.Second
+
+// local is a bounded in-process LRU in front of another user.Cache. Entries live for a
+// short ttl only: list pages are keyed by the page generation read from the next cache,
+// so writes on other replicas retire them at once, single users may lag for up to the ttl.
+type local struct {
+	next    user.Cache
+	ttl     time.Duration
+	maxSize int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type localEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+	// slid is set once the ttl of the next cache was refreshed for this entry,
+	// later hits are served locally and skip the Expire round trip
+	slid bool
+}
+
+// NewLocal wraps next with an in-process cache sized by CACHE_L1_SIZE entries, with a
+// CACHE_L1_TTL go duration lifetime. Without a positive size next is returned as is.
+func NewLocal(next user.Cache) user.Cache {
+	size := env.Int("CACHE_L1_SIZE", 0)
+	if size == 0 {
+		return next
+	}
+	return &local{
+		next:    next,
+		ttl:     env.Duration("CACHE_L1_TTL", defaultLocalTTL),
+		maxSize: size,
+		entries: make(map[string]*list.Element, size),
+		lru:     list.New(),
+	}
+}
+
+// localUser remembers the freshness reported by the next cache and when it was reported
+type localUser struct {
+	user     user.User
+	fresh    user.Freshness
+	loadedAt time.Time
+}
+
+func (l *local) Get(ctx context.Context, id string) (user.User, user.Freshness, error) {
+	return l.getUser(idKey(id), func() (user.User, user.Freshness, error) {
+		return l.next.Get(ctx, id)
+	})
+}
+
+func (l *local) GetByNickname(ctx context.Context, nickname string) (user.User, user.Freshness, error) {
+	return l.getUser(nicknameKey(nickname), func() (user.User, user.Freshness, error) {
+		return l.next.GetByNickname(ctx, nickname)
+	})
+}
+
+func (l *local) getUser(key string, load func() (user.User, user.Freshness, error)) (user.User, user.Freshness, error) {
+	if v, ok := l.load(key); ok {
+		lu := v.(localUser)
+		fresh := lu.fresh
+		fresh.FreshFor -= time.Since(lu.loadedAt)
+		return lu.user, fresh, nil
+	}
+	u, fresh, err := load()
+	if err != nil {
+		return user.User{}, user.Freshness{}, err
+	}
+	l.store(key, localUser{user: u, fresh: fresh, loadedAt: time.Now()})
+	return u, fresh, nil
+}
+
+func (l *local) GetAll(ctx context.Context, version, limit, offset int64) ([]user.User, error) {
+	key := pageKey(version, limit, offset)
+	if v, ok := l.load(key); ok {
+		return v.([]user.User), nil
+	}
+	users, err := l.next.GetAll(ctx, version, limit, offset)
+	if err != nil {
+		return []user.User{}, err
+	}
+	l.store(key, users)
+	return users, nil
+}
+
+// Set drops a local copy instead of storing u, the next cache may refuse u for a tombstone
+// and the local copy is filled by the next Get from whatever it kept
+func (l *local) Set(ctx context.Context, u user.User) error {
+	l.evict(idKey(strconv.FormatInt(u.Id, 10)))
+	return l.next.Set(ctx, u)
+}
+
+func (l *local) SetByNickname(ctx context.Context, u user.User) error {
+	l.evict(nicknameKey(u.NickName))
+	return l.next.SetByNickname(ctx, u)
+}
+
+// SetMissing drops a local copy, absent users are remembered by the next cache only
+func (l *local) SetMissing(ctx context.Context, id string) error {
+	l.evict(idKey(id))
+	return l.next.SetMissing(ctx, id)
+}
+
+func (l *local) SetMissingByNickname(ctx context.Context, nickname string) error {
+	l.evict(nicknameKey(nickname))
+	return l.next.SetMissingByNickname(ctx, nickname)
+}
+
+func (l *local) SetAll(ctx context.Context, version, limit, offset int64, val []user.User) error {
+	if err := l.next.SetAll(ctx, version, limit, offset, val); err != nil {
+		return err
+	}
+	l.store(pageKey(version, limit, offset), val)
+	return nil
+}
+
+func (l *local) Del(ctx context.Context, id string) error {
+	l.evict(idKey(id))
+	return l.next.Del(ctx, id)
+}
+
+func (l *local) Expire(ctx context.Context, id string) error {
+	if l.slide(idKey(id)) {
+		return nil
+	}
+	return l.next.Expire(ctx, id)
+}
+
+func (l *local) ExpireByNickname(ctx context.Context, nickname string) error {
+	if l.slide(nicknameKey(nickname)) {
+		return nil
+	}
+	return l.next.ExpireByNickname(ctx, nickname)
+}
+
+func (l *local) ExpireAll(ctx context.Context, version, limit, offset int64) error {
+	if l.slide(pageKey(version, limit, offset)) {
+		return nil
+	}
+	return l.next.ExpireAll(ctx, version, limit, offset)
+}
+
+// GetLastKnown is only read while storage is down, it always asks the next cache
+func (l *local) GetLastKnown(ctx context.Context, id string) (user.User, error) {
+	return l.next.GetLastKnown(ctx, id)
+}
+
+// PageVersion always asks the next cache, it is what keeps list pages consistent across replicas
+func (l *local) PageVersion(ctx context.Context) (int64, error) {
+	return l.next.PageVersion(ctx)
+}
+
+func (l *local) Invalidate(ctx context.Context, users ...user.User) error {
+	for _, u := range users {
+		l.evict(idKey(strconv.FormatInt(u.Id, 10)))
+		if u.NickName != "" {
+			l.evict(nicknameKey(u.NickName))
+		}
+	}
+	return l.next.Invalidate(ctx, users...)
+}
+
+// Evict drops the id and nickname entries named by inv, or everything for inv.All.
+// List pages are left alone, the page generation already retired them.
+func (l *local) Evict(inv user.Invalidation) {
+	if inv.All {
+		l.mu.Lock()
+		l.entries = make(map[string]*list.Element, l.maxSize)
+		l.lru.Init()
+		localEntries.Set(0)
+		l.mu.Unlock()
+	}
+	for _, id := range inv.Ids {
+		l.evict(idKey(strconv.FormatInt(id, 10)))
+	}
+	for _, nickname := range inv.NickNames {
+		l.evict(nicknameKey(nickname))
+	}
+	l.next.Evict(inv)
+}
+
+func (l *local) PingClient(ctx context.Context) error {
+	return l.next.PingClient(ctx)
+}
+
+func (l *local) Close() error {
+	return l.next.Close()
+}
+
+func (l *local) KeepAlive(stop <-chan struct{}) {
+	l.next.KeepAlive(stop)
+}
+
+func (l *local) load(key string) (interface{}, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.entries[key]
+	if !ok {
+		localRequestsTotal.WithLabelValues("miss").Inc()
+		return nil, false
+	}
+	e := el.Value.(*localEntry)
+	if time.Now().After(e.expiresAt) {
+		l.remove(el)
+		localRequestsTotal.WithLabelValues("miss").Inc()
+		return nil, false
+	}
+	l.lru.MoveToFront(el)
+	localRequestsTotal.WithLabelValues("hit").Inc()
+	return e.value, true
+}
+
+func (l *local) store(key string, value interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.entries[key]; ok {
+		el.Value = &localEntry{key: key, value: value, expiresAt: time.Now().Add(l.ttl)}
+		l.lru.MoveToFront(el)
+		return
+	}
+	l.entries[key] = l.lru.PushFront(&localEntry{key: key, value: value, expiresAt: time.Now().Add(l.ttl)})
+	for l.lru.Len() > l.maxSize {
+		l.remove(l.lru.Back())
+		localEvictionsTotal.Inc()
+	}
+	localEntries.Set(float64(l.lru.Len()))
+}
+
+// slide reports whether the entry was already slid and marks it, only the first
+// Expire after a fill reaches the next cache
+func (l *local) slide(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.entries[key]
+	if !ok {
+		return false
+	}
+	e := el.Value.(*localEntry)
+	slid := e.slid
+	e.slid = true
+	return slid
+}
+
+func (l *local) evict(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.entries[key]; ok {
+		l.remove(el)
+	}
+}
+
+func (l *local) remove(el *list.Element) {
+	l.lru.Remove(el)
+	delete(l.entries, el.Value.(*localEntry).key)
+	localEntries.Set(float64(l.lru.Len()))
+}
@@ -0,0 +1,33 @@
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	compressedValuesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "redis_cache_example_user_cache_compressed_values_total",
+		Help: "Cache values stored compressed.",
+	}, []string{"algorithm"})
+
+	compressionSavedBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "redis_cache_example_user_cache_compression_saved_bytes_total",
+		Help: "Bytes saved in redis by compressing cache values.",
+	}, []string{"algorithm"})
+
+	localRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "redis_cache_example_user_cache_l1_requests_total",
+		Help: "In-process cache lookups by result, hit or miss.",
+	}, []string{"result"})
+
+	localEvictionsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "redis_cache_example_user_cache_l1_evictions_total",
+		Help: "In-process cache entries evicted to stay within CACHE_L1_SIZE.",
+	})
+
+	localEntries = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "redis_cache_example_user_cache_l1_entries",
+		Help: "Entries held by the in-process cache.",
+	})
+)
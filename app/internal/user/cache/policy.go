@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"math/rand"
+	"redis/pkg/env"
+	"time"
+)
+
+const (
+	defaultEntryTTL   = 25 * time.Second
+	defaultMissingTTL = 5 * time.Second
+	defaultTombstone  = 5 * time.Second
+	defaultLastKnown  = 24 * time.Hour
+	defaultJitter     = 0.1
+	defaultBeta       = 1.0
+	maxJitter         = 1.0
+)
+
+// policy decides how long each kind of entry lives in redis
+type policy struct {
+	userTTL     time.Duration
+	nicknameTTL time.Duration
+	pageTTL     time.Duration
+	// userStale and nicknameStale keep entries this long past their ttl, a stale entry is
+	// served while the service reloads it in the background
+	userStale     time.Duration
+	nicknameStale time.Duration
+	// userBeta and nicknameBeta weight the XFetch early refresh, 0 disables it
+	userBeta     float64
+	nicknameBeta float64
+	// missingTTL is kept short, a user created behind the back of the service shows up after it
+	missingTTL time.Duration
+	// tombstoneTTL is how long Invalidate keeps readers that loaded a user before the write
+	// from putting their copy back, it has to outlast the time between a storage read and the cache write
+	tombstoneTTL time.Duration
+	// lastKnownTTL keeps a copy of every user loaded from storage to serve while storage is down, 0 disables it
+	lastKnownTTL time.Duration
+	// jitter is the fraction of a ttl added at random, 0.1 turns 25s into 25s..27.5s
+	// so entries written together do not expire together
+	jitter float64
+	// sliding refreshes the ttl on every cache hit, without it entries expire
+	// a fixed time after they were loaded from storage
+	sliding bool
+}
+
+// policyFromEnv reads CACHE_USER_TTL, CACHE_NICKNAME_TTL, CACHE_PAGE_TTL, CACHE_MISSING_TTL,
+// CACHE_TOMBSTONE_TTL, CACHE_USER_STALE_TTL, CACHE_NICKNAME_STALE_TTL and CACHE_LAST_KNOWN_TTL as go durations,
+// CACHE_USER_XFETCH_BETA and CACHE_NICKNAME_XFETCH_BETA as floats, CACHE_TTL_JITTER as
+// a fraction between 0 and 1 and CACHE_SLIDING_EXPIRATION as a bool
+func policyFromEnv() policy {
+	p := policy{
+		userTTL:       env.Duration("CACHE_USER_TTL", defaultEntryTTL),
+		nicknameTTL:   env.Duration("CACHE_NICKNAME_TTL", defaultEntryTTL),
+		pageTTL:       env.Duration("CACHE_PAGE_TTL", defaultEntryTTL),
+		missingTTL:    env.Duration("CACHE_MISSING_TTL", defaultMissingTTL),
+		tombstoneTTL:  env.Duration("CACHE_TOMBSTONE_TTL", defaultTombstone),
+		userStale:     env.DurationOrZero("CACHE_USER_STALE_TTL", 0),
+		nicknameStale: env.DurationOrZero("CACHE_NICKNAME_STALE_TTL", 0),
+		lastKnownTTL:  env.DurationOrZero("CACHE_LAST_KNOWN_TTL", defaultLastKnown),
+		userBeta:      env.Float("CACHE_USER_XFETCH_BETA", defaultBeta),
+		nicknameBeta:  env.Float("CACHE_NICKNAME_XFETCH_BETA", defaultBeta),
+		jitter:        env.Float("CACHE_TTL_JITTER", defaultJitter),
+		sliding:       env.Bool("CACHE_SLIDING_EXPIRATION", true),
+	}
+	if p.jitter > maxJitter {
+		p.jitter = defaultJitter
+	}
+	return p
+}
+
+// userExpiration and nicknameExpiration include the stale window, redis drops the entry only after it
+func (p policy) userExpiration() time.Duration {
+	return p.withJitter(p.userTTL) + p.userStale
+}
+
+func (p policy) nicknameExpiration() time.Duration {
+	return p.withJitter(p.nicknameTTL) + p.nicknameStale
+}
+
+func (p policy) pageExpiration() time.Duration {
+	return p.withJitter(p.pageTTL)
+}
+
+func (p policy) missingExpiration() time.Duration {
+	return p.withJitter(p.missingTTL)
+}
+
+func (p policy) withJitter(ttl time.Duration) time.Duration {
+	if p.jitter <= 0 {
+		return ttl
+	}
+	return ttl + time.Duration(rand.Float64()*p.jitter*float64(ttl))
+}
@@ -3,11 +3,16 @@ package cache
 import (
 	"bytes"
 	"context"
-	"encoding/gob"
+	"errors"
+	"fmt"
 	"github.com/go-redis/redis/v9"
+	"math"
+	"net"
 	"os"
 	"redis/internal/user"
+	"redis/pkg/env"
 	"redis/pkg/logging"
+	"redis/pkg/reconnect"
 	"strconv"
 	"time"
 )
@@ -17,136 +22,387 @@ var _ user.Cache = &cache{}
 const KeepAlivePollPeriod = 60
 
 type cache struct {
-	client *redis.Client
-	logger *logging.Logger
+	conn        *reconnect.Manager
+	logger      *logging.Logger
+	policy      policy
+	codec       Codec
+	compression compression
+	instance    string
+	sessionTTL  time.Duration
 }
 
-func dial() *redis.Client {
-	return redis.NewClient(&redis.Options{
-		Addr:        os.Getenv("REDIS"),
-		DB:          0,
-		DialTimeout: 600 * time.Second,
-		ReadTimeout: 600 * time.Second,
-	})
+// dial returns a client only once redis answers, a client that never connected is closed
+func dial(ctx context.Context) (redis.UniversalClient, error) {
+	client, err := newClient(os.Getenv("REDIS"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid REDIS: %w", err)
+	}
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+	client.AddHook(newTracingHook())
+	return client, nil
 }
 
 func New(appLogger *logging.Logger) (*cache, error) {
-	client := dial()
-	if _, err := client.Ping(context.Background()).Result(); err != nil {
-		return &cache{
-			client: nil,
-			logger: appLogger,
-		}, err
+	codec, err := codecFromEnv()
+	if err != nil {
+		appLogger.Warn(err.Error())
+	}
+	cmp, err := compressionFromEnv()
+	if err != nil {
+		appLogger.Warn(err.Error())
+	}
+
+	var conn interface{}
+	client, err := dial(context.Background())
+	if err == nil {
+		conn = client
 	}
 
 	return &cache{
-		client: client,
-		logger: appLogger,
-	}, nil
+		conn:        reconnect.New(conn, connOptions(), appLogger),
+		logger:      appLogger,
+		policy:      policyFromEnv(),
+		codec:       codec,
+		compression: cmp,
+		instance:    newInstanceID(),
+		sessionTTL:  env.Duration("SESSION_TTL", defaultSessionTTL),
+	}, err
 }
 
-func (c *cache) Get(ctx context.Context, id string) (user.User, error) {
-	cmd := c.client.Get(ctx, id)
+func connOptions() reconnect.Options {
+	return reconnect.Options{
+		Name:   "redis",
+		Period: time.Second * KeepAlivePollPeriod,
+		Dial: func(ctx context.Context) (interface{}, error) {
+			return dial(ctx)
+		},
+		Ping: func(ctx context.Context, conn interface{}) error {
+			return conn.(redis.UniversalClient).Ping(ctx).Err()
+		},
+		Close: func(conn interface{}) {
+			_ = conn.(redis.UniversalClient).Close()
+		},
+	}
+}
 
-	cmdb, err := cmd.Bytes()
+// client returns the connection current at the time of the call, KeepAlive may replace it any time.
+// Until redis was reached once there is none and every call fails fast with user.ErrNoConnection.
+func (c *cache) client() (redis.UniversalClient, error) {
+	client, ok := c.conn.Load().(redis.UniversalClient)
+	if !ok {
+		return nil, user.ErrNoConnection
+	}
+	return client, nil
+}
+
+func (c *cache) Get(ctx context.Context, id string) (user.User, user.Freshness, error) {
+	return c.getUser(ctx, idKey(id), c.policy.userStale, c.policy.userBeta)
+}
+
+func (c *cache) GetByNickname(ctx context.Context, nickname string) (user.User, user.Freshness, error) {
+	return c.getUser(ctx, nicknameKey(nickname), c.policy.nicknameStale, c.policy.nicknameBeta)
+}
+
+// PTTL replies for keys without expiration and for absent keys
+const (
+	noExpiration time.Duration = -1
+	keyMissing   time.Duration = -2
+)
+
+// missingMarker is stored for absent users, no codec has the id 0
+var missingMarker = []byte{0}
+
+// tombstone is left by Invalidate for CACHE_TOMBSTONE_TTL, lookups treat it as a miss and
+// fillScript refuses to replace it. No codec has the id 15.
+var tombstone = []byte{0xff}
+
+// fillScript stores a value loaded from storage unless the key holds a tombstone, a reader that
+// loaded the user before a write must not put the old copy back after Invalidate.
+// It touches a single key, so it runs on any node of a cluster.
+var fillScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// fill reports whether value was stored, a tombstoned key is not an error
+func fill(ctx context.Context, client redis.UniversalClient, key string, value []byte, ttl time.Duration) (bool, error) {
+	stored, err := fillScript.Run(ctx, client, []string{key}, tombstone, value, ttl.Milliseconds()).Int()
+	return stored == 1, translateError(err)
+}
+
+// getUser reads the value and its remaining ttl in one round trip, the part of the ttl
+// beyond the stale window is how long the entry stays fresh
+func (c *cache) getUser(ctx context.Context, key string, stale time.Duration, beta float64) (user.User, user.Freshness, error) {
+	client, err := c.client()
 	if err != nil {
-		return user.User{}, err
+		return user.User{}, user.Freshness{}, err
+	}
+	pipe := client.Pipeline()
+	cmd := pipe.Get(ctx, key)
+	ttl := pipe.PTTL(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return user.User{}, user.Freshness{}, translateError(err)
 	}
 
-	b := bytes.NewReader(cmdb)
+	cmdb, err := cmd.Bytes()
+	if err != nil {
+		return user.User{}, user.Freshness{}, translateError(err)
+	}
+	if bytes.Equal(cmdb, missingMarker) {
+		return user.User{}, user.Freshness{}, user.ErrCachedNotFound
+	}
+	if bytes.Equal(cmdb, tombstone) {
+		return user.User{}, user.Freshness{}, translateError(redis.Nil)
+	}
 
 	var res user.User
 
-	if err := gob.NewDecoder(b).Decode(&res); err != nil {
-		return user.User{}, err
+	if err := decode(cmdb, &res); err != nil {
+		return user.User{}, user.Freshness{}, err
 	}
 
-	return res, nil
+	fresh := user.Freshness{FreshFor: ttl.Val() - stale, Beta: beta}
+	switch ttl.Val() {
+	case noExpiration:
+		fresh.FreshFor = math.MaxInt64
+	case keyMissing:
+		// expired between GET and PTTL
+		fresh.FreshFor = 0
+	}
+	return res, fresh, nil
 }
 
-func (c *cache) GetAll(ctx context.Context, key string) (users []user.User, err error) {
-	cmd := c.client.Get(ctx, key)
-	cmdb, err := cmd.Bytes()
+func (c *cache) GetAll(ctx context.Context, version, limit, offset int64) (users []user.User, err error) {
+	client, err := c.client()
 	if err != nil {
 		return []user.User{}, err
 	}
+	cmd := client.Get(ctx, pageKey(version, limit, offset))
+	cmdb, err := cmd.Bytes()
+	if err != nil {
+		return []user.User{}, translateError(err)
+	}
 
-	b := bytes.NewReader(cmdb)
-
-	if err := gob.NewDecoder(b).Decode(&users); err != nil {
+	if err := decode(cmdb, &users); err != nil {
 		return []user.User{}, err
 	}
 
 	return users, nil
 }
 
+// Set also refreshes the last known good copy, it outlives the entry and is read only while storage is down.
+// A user refused for a tombstone leaves the last known copy alone as well.
 func (c *cache) Set(ctx context.Context, u user.User) error {
-	var b bytes.Buffer
-
-	if err := gob.NewEncoder(&b).Encode(u); err != nil {
+	b, err := c.encodeUser(u)
+	if err != nil {
 		return err
 	}
 
-	return c.client.Set(ctx, strconv.FormatInt(u.Id, 10), b.Bytes(), 25*time.Second).Err()
+	id := strconv.FormatInt(u.Id, 10)
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	stored, err := fill(ctx, client, idKey(id), b, c.policy.userExpiration())
+	if err != nil || !stored || c.policy.lastKnownTTL <= 0 {
+		return err
+	}
+	return translateError(client.Set(ctx, lastKnownKey(id), b, c.policy.lastKnownTTL).Err())
 }
 
 func (c *cache) SetByNickname(ctx context.Context, u user.User) error {
-	var b bytes.Buffer
+	b, err := c.encodeUser(u)
+	if err != nil {
+		return err
+	}
 
-	if err := gob.NewEncoder(&b).Encode(u); err != nil {
+	client, err := c.client()
+	if err != nil {
 		return err
 	}
+	_, err = fill(ctx, client, nicknameKey(u.NickName), b, c.policy.nicknameExpiration())
+	return err
+}
 
-	return c.client.Set(ctx, u.NickName, b.Bytes(), 25*time.Second).Err()
+func (c *cache) encodeUser(u user.User) ([]byte, error) {
+	u.Pass = ""
+	return encode(c.codec, c.compression, u)
 }
 
-func (c *cache) SetAll(ctx context.Context, key string, val []user.User) error {
-	var b bytes.Buffer
+func (c *cache) GetLastKnown(ctx context.Context, id string) (user.User, error) {
+	client, err := c.client()
+	if err != nil {
+		return user.User{}, err
+	}
+	cmdb, err := client.Get(ctx, lastKnownKey(id)).Bytes()
+	if err != nil {
+		return user.User{}, translateError(err)
+	}
 
-	if err := gob.NewEncoder(&b).Encode(val); err != nil {
+	var res user.User
+
+	if err := decode(cmdb, &res); err != nil {
+		return user.User{}, err
+	}
+
+	return res, nil
+}
+
+func (c *cache) SetMissing(ctx context.Context, id string) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	_, err = fill(ctx, client, idKey(id), missingMarker, c.policy.missingExpiration())
+	return err
+}
+
+func (c *cache) SetMissingByNickname(ctx context.Context, nickname string) error {
+	client, err := c.client()
+	if err != nil {
 		return err
 	}
+	_, err = fill(ctx, client, nicknameKey(nickname), missingMarker, c.policy.missingExpiration())
+	return err
+}
 
-	return c.client.Set(ctx, key, b.Bytes(), 25*time.Second).Err()
+func (c *cache) SetAll(ctx context.Context, version, limit, offset int64, val []user.User) error {
+	// password hashes never reach redis, copy so the caller slice stays intact
+	users := make([]user.User, len(val))
+	for i, u := range val {
+		u.Pass = ""
+		users[i] = u
+	}
+
+	b, err := encode(c.codec, c.compression, users)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return translateError(client.Set(ctx, pageKey(version, limit, offset), b, c.policy.pageExpiration()).Err())
 }
 
+// Expire methods slide the ttl after a hit, without sliding expiration they skip the round trip
 func (c *cache) Expire(ctx context.Context, id string) error {
-	return c.client.Expire(ctx, id, 25*time.Second).Err()
+	if !c.policy.sliding {
+		return nil
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return translateError(client.Expire(ctx, idKey(id), c.policy.userExpiration()).Err())
+}
+
+func (c *cache) ExpireByNickname(ctx context.Context, nickname string) error {
+	if !c.policy.sliding {
+		return nil
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return translateError(client.Expire(ctx, nicknameKey(nickname), c.policy.nicknameExpiration()).Err())
+}
+
+func (c *cache) ExpireAll(ctx context.Context, version, limit, offset int64) error {
+	if !c.policy.sliding {
+		return nil
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return translateError(client.Expire(ctx, pageKey(version, limit, offset), c.policy.pageExpiration()).Err())
 }
 
-func (c *cache) ExpireAll(ctx context.Context, key string) error {
-	return c.client.Expire(ctx, key, 25*time.Second).Err()
+func (c *cache) PageVersion(ctx context.Context) (int64, error) {
+	client, err := c.client()
+	if err != nil {
+		return 0, err
+	}
+	version, err := client.Get(ctx, pageVersionKey()).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return version, translateError(err)
+}
+
+// Invalidate tombstones the users id and nickname entries, drops their last known copies and
+// bumps the page generation, retired pages are never read again and expire with their TTL.
+// A single node or sentinel runs it in one MULTI. A cluster splits it by hash slot, so readers
+// may briefly see some keys invalidated and others not, every key still ends up invalidated.
+func (c *cache) Invalidate(ctx context.Context, users ...user.User) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	pipe := client.TxPipeline()
+	for _, u := range users {
+		id := strconv.FormatInt(u.Id, 10)
+		pipe.Set(ctx, idKey(id), tombstone, c.policy.tombstoneTTL)
+		pipe.Del(ctx, lastKnownKey(id))
+		if u.NickName != "" {
+			pipe.Set(ctx, nicknameKey(u.NickName), tombstone, c.policy.tombstoneTTL)
+		}
+	}
+	pipe.Incr(ctx, pageVersionKey())
+	_, err = pipe.Exec(ctx)
+	return translateError(err)
 }
 
 func (c *cache) Del(ctx context.Context, id string) error {
-	return c.client.Del(ctx, id).Err()
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return translateError(client.Del(ctx, idKey(id)).Err())
 }
 
 func (c *cache) PingClient(ctx context.Context) error {
-	return c.client.Ping(ctx).Err()
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return translateError(client.Ping(ctx).Err())
 }
 
-func (c *cache) Close() error {
-	return c.client.Close()
-}
-
-func (c *cache) KeepAlive() {
-	var err error
-	for {
-		time.Sleep(time.Second * KeepAlivePollPeriod)
-		lostConnect := false
-		if c.client == nil {
-			lostConnect = true
-		} else if err = c.PingClient(context.Background()); err != nil {
-			lostConnect = true
-		}
-		if !lostConnect {
-			continue
-		}
-		c.logger.Info("Reconnect to Redis...")
-		c.client = dial()
-		if c.client == nil {
-			continue
-		}
+// translateError maps redis client errors to user domain errors at the adapter boundary
+func translateError(err error) error {
+	var redisErr redis.Error
+	var netErr net.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, redis.Nil):
+		return user.Internal(user.ErrNotFound, err)
+	case errors.Is(err, context.Canceled):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return user.Internal(user.ErrTimeout, err)
+	case errors.As(err, &redisErr):
+		// server replied with an error, the connection itself is fine
+		return err
 	}
+	return user.Internal(user.ErrUnavailable, err)
+}
+
+func (c *cache) Close() error {
+	c.conn.Close()
+	return nil
+}
+
+// KeepAlive pings redis every KeepAlivePollPeriod and reconnects with backoff until stop is closed
+func (c *cache) KeepAlive(stop <-chan struct{}) {
+	c.conn.Run(stop)
 }
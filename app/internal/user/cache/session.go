@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"redis/internal/user"
+	"time"
+)
+
+var _ user.SessionStore = &cache{}
+
+const defaultSessionTTL = 30 * time.Minute
+
+// sessionTokenKey stores only a digest of the token so a redis dump holds no usable credentials
+func sessionTokenKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return sessionKey(hex.EncodeToString(sum[:]))
+}
+
+func (c *cache) CreateSession(ctx context.Context, s *user.Session) error {
+	s.ExpiresAt = time.Now().Add(c.sessionTTL)
+	stored := *s
+	stored.Token = ""
+
+	b, err := encode(c.codec, c.compression, stored)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return translateError(client.Set(ctx, sessionTokenKey(s.Token), b, c.sessionTTL).Err())
+}
+
+func (c *cache) GetSession(ctx context.Context, token string) (user.Session, error) {
+	// GETEX slides the expiration in the same round trip
+	client, err := c.client()
+	if err != nil {
+		return user.Session{}, err
+	}
+	cmdb, err := client.GetEx(ctx, sessionTokenKey(token), c.sessionTTL).Bytes()
+	if err != nil {
+		return user.Session{}, translateError(err)
+	}
+
+	var res user.Session
+	if err := decode(cmdb, &res); err != nil {
+		return user.Session{}, err
+	}
+	res.Token = token
+	res.ExpiresAt = time.Now().Add(c.sessionTTL)
+
+	return res, nil
+}
+
+func (c *cache) DeleteSession(ctx context.Context, token string) error {
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	return translateError(client.Del(ctx, sessionTokenKey(token)).Err())
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"redis/internal/user"
+	"redis/pkg/breaker"
+	"redis/pkg/logging"
+)
+
+var _ user.Storage = &breakerStorage{}
+
+// breakerStorage fails fast while postgresql keeps failing, requests get 503 or
+// a last known copy from the cache at once instead of waiting for their deadline
+type breakerStorage struct {
+	next    user.Storage
+	breaker *breaker.Breaker
+}
+
+// NewBreaker wraps next with a circuit breaker configured by DATABASE_BREAKER_FAILURES,
+// DATABASE_BREAKER_OPEN_TIMEOUT and DATABASE_BREAKER_HALF_OPEN_REQUESTS. With 0 failures next is returned as is.
+func NewBreaker(next user.Storage, appLogger *logging.Logger) user.Storage {
+	settings := breaker.SettingsFromEnv("DATABASE")
+	if settings.Failures == 0 {
+		return next
+	}
+	settings.Name = "postgresql"
+	settings.IsFailure = breaker.Outages(user.ErrUnavailable, user.ErrTimeout)
+	settings.OnStateChange = func(from, to breaker.State) {
+		appLogger.Warn("Postgresql circuit breaker " + from.String() + " -> " + to.String())
+	}
+	return &breakerStorage{next: next, breaker: breaker.New(settings)}
+}
+
+func (b *breakerStorage) do(fn func() error) error {
+	err := b.breaker.Do(fn)
+	if errors.Is(err, breaker.ErrOpen) {
+		return user.Wrap(user.ErrUnavailable, errors.New("postgresql "+err.Error()))
+	}
+	return err
+}
+
+func (b *breakerStorage) Create(ctx context.Context, u *user.User) error {
+	return b.do(func() error { return b.next.Create(ctx, u) })
+}
+
+func (b *breakerStorage) FindAll(ctx context.Context, limit, offset int64) (users []user.User, err error) {
+	err = b.do(func() error {
+		users, err = b.next.FindAll(ctx, limit, offset)
+		return err
+	})
+	return users, err
+}
+
+func (b *breakerStorage) FindOne(ctx context.Context, id string) (u user.User, err error) {
+	err = b.do(func() error {
+		u, err = b.next.FindOne(ctx, id)
+		return err
+	})
+	return u, err
+}
+
+func (b *breakerStorage) FindOneByNickName(ctx context.Context, nickname string) (u user.User, err error) {
+	err = b.do(func() error {
+		u, err = b.next.FindOneByNickName(ctx, nickname)
+		return err
+	})
+	return u, err
+}
+
+func (b *breakerStorage) Update(ctx context.Context, u *user.User) (prev user.User, err error) {
+	err = b.do(func() error {
+		prev, err = b.next.Update(ctx, u)
+		return err
+	})
+	return prev, err
+}
+
+func (b *breakerStorage) Delete(ctx context.Context, id string) (prev user.User, err error) {
+	err = b.do(func() error {
+		prev, err = b.next.Delete(ctx, id)
+		return err
+	})
+	return prev, err
+}
+
+func (b *breakerStorage) ReplacePassword(ctx context.Context, id int64, oldHash, newHash string) error {
+	return b.do(func() error { return b.next.ReplacePassword(ctx, id, oldHash, newHash) })
+}
+
+// PingPool goes through the breaker too, an open breaker shows up in the readiness check
+// and readiness pings serve as probes once it turns half-open
+func (b *breakerStorage) PingPool(ctx context.Context) error {
+	return b.do(func() error { return b.next.PingPool(ctx) })
+}
+
+func (b *breakerStorage) Close() {
+	b.next.Close()
+}
+
+func (b *breakerStorage) KeepAlive(stop <-chan struct{}) {
+	b.next.KeepAlive(stop)
+}
@@ -6,8 +6,12 @@ import (
 	"os"
 	"redis/internal/user"
 	"redis/pkg/logging"
+	"redis/pkg/reconnect"
+	"strings"
 	"time"
 
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/log/zapadapter"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
@@ -16,6 +20,16 @@ var _ user.Storage = &db{}
 
 const KeepAlivePollPeriod = 3
 
+// postgres error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	uniqueViolationCode       = "23505"
+	dataExceptionClass        = "22"
+	transactionRollbackClass  = "40"
+	connectionExceptionClass  = "08"
+	operatorInterventionClass = "57"
+	nicknameUniqueIndex       = "users_nickname_lower_key"
+)
+
 //const ZapInfoLevel = 0
 
 //const (
@@ -28,26 +42,54 @@ const KeepAlivePollPeriod = 3
 //)
 
 type db struct {
-	pool   *pgxpool.Pool
+	conn   *reconnect.Manager
 	logger *logging.Logger
 	config *pgxpool.Config
 }
 
 func NewStorage(appLogger *logging.Logger) (user.Storage, error) {
 	config := initConfig(appLogger)
+	var conn interface{}
 	pool, err := dial(context.Background(), config)
-	if err != nil {
-		return &db{
-			pool:   nil,
-			logger: appLogger,
-			config: config,
-		}, err
+	if err == nil {
+		conn = pool
 	}
 	return &db{
-		pool:   pool,
+		conn:   reconnect.New(conn, connOptions(config), appLogger),
 		logger: appLogger,
 		config: config,
-	}, nil
+	}, err
+}
+
+func connOptions(config *pgxpool.Config) reconnect.Options {
+	return reconnect.Options{
+		Name:   "postgresql",
+		Period: time.Second * KeepAlivePollPeriod,
+		Dial: func(ctx context.Context) (interface{}, error) {
+			return dial(ctx, config)
+		},
+		Ping: func(ctx context.Context, conn interface{}) error {
+			return conn.(*pgxpool.Pool).Ping(ctx)
+		},
+		Close: func(conn interface{}) {
+			// waits for connections acquired by running queries to be released
+			conn.(*pgxpool.Pool).Close()
+		},
+	}
+}
+
+// acquire takes a connection from the pool current at the time of the call, KeepAlive may replace
+// the pool any time. Until postgresql was reached once there is none and user.ErrNoConnection is returned.
+func (p *db) acquire(ctx context.Context) (*pgxpool.Conn, error) {
+	pool, ok := p.conn.Load().(*pgxpool.Pool)
+	if !ok {
+		return nil, user.ErrNoConnection
+	}
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return conn, nil
 }
 
 func initConfig(appLogger *logging.Logger) *pgxpool.Config {
@@ -69,36 +111,40 @@ func initConfig(appLogger *logging.Logger) *pgxpool.Config {
 }
 
 func dial(ctx context.Context, config *pgxpool.Config) (*pgxpool.Pool, error) {
+	if config == nil {
+		return nil, errors.New("invalid DATABASE_URL")
+	}
 	return pgxpool.ConnectConfig(ctx, config)
 }
 
-func (p *db) Create(u *user.User) error {
+func (p *db) Create(ctx context.Context, u *user.User) error {
 	query := `INSERT INTO "users" (nickname, firstname, lastname, gender, pass, status) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
-	conn, err := p.pool.Acquire(context.Background())
+	conn, err := p.acquire(ctx)
 	if err != nil {
 		return err
 	}
 	defer conn.Release()
 
-	if err := conn.QueryRow(context.Background(), query, u.NickName, u.FistName, u.LastName, u.Gender, u.Pass, u.Status).Scan(&u.Id); err != nil {
-		return err
+	if err := conn.QueryRow(ctx, query, u.NickName, u.FistName, u.LastName, u.Gender, u.Pass, u.Status).Scan(&u.Id); err != nil {
+		return translateError(err)
 	}
-	return err
+	return nil
 }
 
-func (p *db) FindAll(limit, offset int64) (users []user.User, err error) {
+func (p *db) FindAll(ctx context.Context, limit, offset int64) (users []user.User, err error) {
 	query := `SELECT id, nickname, firstname, lastname, gender, pass, status FROM users WHERE id > $1 LIMIT $2`
 
-	conn, err := p.pool.Acquire(context.Background())
+	conn, err := p.acquire(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer conn.Release()
 
-	rows, err := conn.Query(context.Background(), query, offset, limit)
+	rows, err := conn.Query(ctx, query, offset, limit)
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
+	defer rows.Close()
 
 	users = make([]user.User, 0)
 
@@ -106,115 +152,160 @@ func (p *db) FindAll(limit, offset int64) (users []user.User, err error) {
 		var u user.User
 		err = rows.Scan(&u.Id, &u.NickName, &u.FistName, &u.LastName, &u.Gender, &u.Pass, &u.Status)
 		if err != nil {
-			return nil, err
+			return nil, translateError(err)
 		}
 		users = append(users, u)
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	return users, nil
 }
 
-func (p *db) FindOne(id string) (u user.User, err error) {
+func (p *db) FindOne(ctx context.Context, id string) (u user.User, err error) {
 	//defer trace(*p.logger, id)()
 	query := `SELECT id, nickname, firstname, lastname, gender, pass, status FROM "users" WHERE id = $1`
 
 	var res user.User
 
-	conn, err := p.pool.Acquire(context.Background())
+	conn, err := p.acquire(ctx)
 	if err != nil {
 		return user.User{}, err
 	}
 	defer conn.Release()
 
-	if err := conn.QueryRow(context.Background(), query, id).
+	if err := conn.QueryRow(ctx, query, id).
 		Scan(&res.Id, &res.NickName, &res.FistName, &res.LastName, &res.Gender, &res.Pass, &res.Status); err != nil {
-		return user.User{}, err
+		return user.User{}, translateError(err)
 	}
 
 	return res, nil
 }
 
-func (p *db) Update(u *user.User) error {
-	query := `UPDATE "users" SET nickname=$1, firstname=$2, lastname=$3, gender=$4, pass=$5, status=$6 WHERE id=$7 RETURNING id`
+func (p *db) Update(ctx context.Context, u *user.User) (prev user.User, err error) {
+	query := `UPDATE "users" u SET nickname=$1, firstname=$2, lastname=$3, gender=$4, pass=$5, status=$6
+		FROM (SELECT id, nickname FROM "users" WHERE id=$7 FOR UPDATE) old
+		WHERE u.id = old.id RETURNING old.id, old.nickname`
 
-	conn, err := p.pool.Acquire(context.Background())
+	conn, err := p.acquire(ctx)
 	if err != nil {
-		return err
+		return user.User{}, err
 	}
 	defer conn.Release()
 
-	cmdTag, err := conn.Exec(context.Background(), query, u.NickName, u.FistName, u.LastName, u.Gender, u.Pass, u.Status, u.Id)
-	if cmdTag.RowsAffected() == 0 {
-		return errors.New("user for update not found")
+	if err := conn.QueryRow(ctx, query, u.NickName, u.FistName, u.LastName, u.Gender, u.Pass, u.Status, u.Id).
+		Scan(&prev.Id, &prev.NickName); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return user.User{}, user.Wrap(user.ErrNotFound, errors.New("user for update not found"))
+		}
+		return user.User{}, translateError(err)
 	}
-	return err
+	return prev, nil
 }
 
-func (p *db) Delete(id string) error {
-	query := `DELETE FROM "users" WHERE id = $1 RETURNING id`
+func (p *db) Delete(ctx context.Context, id string) (prev user.User, err error) {
+	query := `DELETE FROM "users" WHERE id = $1 RETURNING id, nickname`
 
-	conn, err := p.pool.Acquire(context.Background())
+	conn, err := p.acquire(ctx)
+	if err != nil {
+		return user.User{}, err
+	}
+	defer conn.Release()
+
+	if err := conn.QueryRow(ctx, query, id).Scan(&prev.Id, &prev.NickName); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return user.User{}, user.Wrap(user.ErrNotFound, errors.New("user for delete not found"))
+		}
+		return user.User{}, translateError(err)
+	}
+	return prev, nil
+}
+
+func (p *db) ReplacePassword(ctx context.Context, id int64, oldHash, newHash string) error {
+	query := `UPDATE "users" SET pass=$1 WHERE id=$2 AND pass=$3`
+
+	conn, err := p.acquire(ctx)
 	if err != nil {
 		return err
 	}
 	defer conn.Release()
-	cmdTag, err := conn.Exec(context.Background(), query, id)
-	if cmdTag.RowsAffected() == 0 {
-		return errors.New("user for delete not found")
+
+	tag, err := conn.Exec(ctx, query, newHash, id, oldHash)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return user.Wrap(user.ErrConflict, errors.New("password changed or user deleted since it was read"))
 	}
-	return err
+	return nil
 }
 
 func (p *db) Close() {
-	p.pool.Close()
+	p.conn.Close()
 }
 
-func (p *db) FindOneByNickName(nickname string) (u user.User, err error) {
-	query := `SELECT id, nickname, firstname, lastname, gender, pass, status FROM "users" WHERE nickname LIKE $1 LIMIT 1`
+func (p *db) FindOneByNickName(ctx context.Context, nickname string) (u user.User, err error) {
+	query := `SELECT id, nickname, firstname, lastname, gender, pass, status FROM "users" WHERE lower(nickname) = lower($1) LIMIT 1`
 
 	var res user.User
 
-	conn, err := p.pool.Acquire(context.Background())
+	conn, err := p.acquire(ctx)
 	if err != nil {
 		return user.User{}, err
 	}
 	defer conn.Release()
 
-	if err := conn.QueryRow(context.Background(), query, nickname).
+	if err := conn.QueryRow(ctx, query, nickname).
 		Scan(&res.Id, &res.NickName, &res.FistName, &res.LastName, &res.Gender, &res.Pass, &res.Status); err != nil {
-		return user.User{}, err
+		return user.User{}, translateError(err)
 	}
 
 	return res, nil
 }
 
-func (p *db) PingPool(ctx context.Context) error {
-	return p.pool.Ping(ctx)
-}
-
-func (p *db) KeepAlive() {
-	var err error
-	for {
-		time.Sleep(time.Second * KeepAlivePollPeriod)
-		lostConnect := false
-		if p.pool == nil {
-			lostConnect = true
-		} else if err = p.PingPool(context.Background()); err != nil {
-			lostConnect = true
-		}
-		if !lostConnect {
-			continue
-		}
-		p.logger.Info("Reconnect to Postgresql...")
-		p.pool, err = dial(context.Background(), p.config)
-		if err != nil {
-			continue
+// translateError maps pgx and postgres errors to user domain errors at the adapter boundary
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return user.Internal(user.ErrNotFound, err)
+	case errors.Is(err, context.Canceled):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), pgconn.Timeout(err):
+		return user.Internal(user.ErrTimeout, err)
+	case errors.As(err, &pgErr):
+		switch {
+		case pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == nicknameUniqueIndex:
+			return user.Internal(user.ErrNicknameTaken, err)
+		case pgErr.Code == uniqueViolationCode:
+			return user.Internal(user.ErrConflict, err)
+		case strings.HasPrefix(pgErr.Code, dataExceptionClass):
+			return user.Internal(user.ErrInvalidInput, err)
+		case strings.HasPrefix(pgErr.Code, transactionRollbackClass):
+			// serialization failures and deadlocks left after retries, the client may try again
+			return user.Internal(user.ErrConflict, err)
+		case strings.HasPrefix(pgErr.Code, connectionExceptionClass), strings.HasPrefix(pgErr.Code, operatorInterventionClass):
+			return user.Internal(user.ErrUnavailable, err)
 		}
+		return err
 	}
+	// everything else happens before the server answers: pool, dial or network failures
+	return user.Internal(user.ErrUnavailable, err)
+}
+
+func (p *db) PingPool(ctx context.Context) error {
+	pool, ok := p.conn.Load().(*pgxpool.Pool)
+	if !ok {
+		return user.ErrNoConnection
+	}
+	return pool.Ping(ctx)
+}
+
+// KeepAlive pings postgresql every KeepAlivePollPeriod and reconnects with backoff until stop is closed
+func (p *db) KeepAlive(stop <-chan struct{}) {
+	p.conn.Run(stop)
 }
 
 //func trace(l logging.Logger, id string) func() {
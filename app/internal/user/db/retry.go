@@ -0,0 +1,198 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"redis/internal/user"
+	"redis/pkg/env"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+var _ user.Storage = &retryStorage{}
+
+const (
+	defaultRetries         = 2
+	defaultRetryBackoff    = 50 * time.Millisecond
+	defaultRetryMaxBackoff = time.Second
+)
+
+// postgres error codes of statements the server rolled back, they are safe to run again
+const (
+	serializationFailureCode = "40001"
+	deadlockDetectedCode     = "40P01"
+	adminShutdownCode        = "57P01"
+)
+
+var retriesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "redis_cache_example_user_db_retries_total",
+	Help: "Database operations retried after a transient error, by operation and reason.",
+}, []string{"operation", "reason"})
+
+// retryStorage runs operations again after transient errors, with a jittered exponential
+// backoff and only as long as the request deadline leaves room for another attempt
+type retryStorage struct {
+	next       user.Storage
+	retries    int
+	backoff    time.Duration
+	maxBackoff time.Duration
+}
+
+// NewRetrying wraps next with retries configured by DATABASE_RETRIES as an integer and
+// DATABASE_RETRY_BACKOFF and DATABASE_RETRY_MAX_BACKOFF as go durations. With 0 retries next is returned as is.
+func NewRetrying(next user.Storage) user.Storage {
+	retries := env.Int("DATABASE_RETRIES", defaultRetries)
+	if retries == 0 {
+		return next
+	}
+	return &retryStorage{
+		next:       next,
+		retries:    retries,
+		backoff:    env.Duration("DATABASE_RETRY_BACKOFF", defaultRetryBackoff),
+		maxBackoff: env.Duration("DATABASE_RETRY_MAX_BACKOFF", defaultRetryMaxBackoff),
+	}
+}
+
+// retryReason names why err is worth another attempt, "" when it is not. A dropped connection
+// is retried for idempotent operations only unless pgx reports the query never reached the server.
+func retryReason(err error, idempotent bool) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case serializationFailureCode:
+			return "serialization_failure"
+		case deadlockDetectedCode:
+			return "deadlock_detected"
+		case adminShutdownCode:
+			return "admin_shutdown"
+		}
+		return ""
+	}
+
+	var notSent interface{ SafeToRetry() bool }
+	if errors.As(err, &notSent) && notSent.SafeToRetry() {
+		return "not_sent"
+	}
+	if idempotent && (errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)) {
+		return "connection_reset"
+	}
+	return ""
+}
+
+func (r *retryStorage) do(ctx context.Context, operation string, idempotent bool, fn func() error) error {
+	span := otrace.SpanFromContext(ctx)
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt > r.retries {
+			return err
+		}
+		reason := retryReason(err, idempotent)
+		if reason == "" {
+			return err
+		}
+
+		wait := r.wait(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= wait {
+			return err
+		}
+		retriesTotal.WithLabelValues(operation, reason).Inc()
+		span.AddEvent("db.retry", otrace.WithAttributes(
+			attribute.String("db.operation", operation),
+			attribute.Int("db.retry.attempt", attempt),
+			attribute.String("db.retry.reason", reason),
+			attribute.String("db.retry.backoff", wait.String()),
+			attribute.String("exception.message", err.Error()),
+		))
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+	}
+}
+
+// wait draws the pause before attempt+1 from [0, backoff*2^(attempt-1)), capped at maxBackoff
+func (r *retryStorage) wait(attempt int) time.Duration {
+	ceiling := r.backoff << (attempt - 1)
+	if ceiling > r.maxBackoff || ceiling <= 0 {
+		ceiling = r.maxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(ceiling)) + 1)
+}
+
+func (r *retryStorage) Create(ctx context.Context, u *user.User) error {
+	// an insert that may have reached the server is not repeated, it could create the user twice
+	return r.do(ctx, "create", false, func() error { return r.next.Create(ctx, u) })
+}
+
+func (r *retryStorage) FindAll(ctx context.Context, limit, offset int64) (users []user.User, err error) {
+	err = r.do(ctx, "find_all", true, func() error {
+		users, err = r.next.FindAll(ctx, limit, offset)
+		return err
+	})
+	return users, err
+}
+
+func (r *retryStorage) FindOne(ctx context.Context, id string) (u user.User, err error) {
+	err = r.do(ctx, "find_one", true, func() error {
+		u, err = r.next.FindOne(ctx, id)
+		return err
+	})
+	return u, err
+}
+
+func (r *retryStorage) FindOneByNickName(ctx context.Context, nickname string) (u user.User, err error) {
+	err = r.do(ctx, "find_one_by_nickname", true, func() error {
+		u, err = r.next.FindOneByNickName(ctx, nickname)
+		return err
+	})
+	return u, err
+}
+
+func (r *retryStorage) Update(ctx context.Context, u *user.User) (prev user.User, err error) {
+	// an update that reached the server would return the new nickname as the old one the second
+	// time, the old nickname would then never be invalidated
+	err = r.do(ctx, "update", false, func() error {
+		prev, err = r.next.Update(ctx, u)
+		return err
+	})
+	return prev, err
+}
+
+func (r *retryStorage) Delete(ctx context.Context, id string) (prev user.User, err error) {
+	// a delete that reached the server would answer not found the second time
+	err = r.do(ctx, "delete", false, func() error {
+		prev, err = r.next.Delete(ctx, id)
+		return err
+	})
+	return prev, err
+}
+
+func (r *retryStorage) ReplacePassword(ctx context.Context, id int64, oldHash, newHash string) error {
+	// the update is conditional on the old hash, a repeat after a committed first try changes nothing
+	return r.do(ctx, "replace_password", true, func() error {
+		return r.next.ReplacePassword(ctx, id, oldHash, newHash)
+	})
+}
+
+func (r *retryStorage) PingPool(ctx context.Context) error {
+	return r.next.PingPool(ctx)
+}
+
+func (r *retryStorage) Close() {
+	r.next.Close()
+}
+
+func (r *retryStorage) KeepAlive(stop <-chan struct{}) {
+	r.next.KeepAlive(stop)
+}
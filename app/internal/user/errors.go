@@ -0,0 +1,94 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Domain errors returned by storage and cache adapters, handlers map them to HTTP status codes
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrInvalidInput = errors.New("invalid input")
+	ErrConflict     = errors.New("conflict")
+	ErrUnavailable  = errors.New("unavailable")
+	ErrTimeout      = errors.New("timeout")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+)
+
+var (
+	ErrMethodNotAllowed = errors.New("method not allowed")
+	ErrPayloadTooLarge  = errors.New("payload too large")
+)
+
+var ErrNicknameTaken = Wrap(ErrConflict, errors.New("nickname already taken"))
+
+// ErrStale comes with a valid result served from the last known good copy because
+// storage is unavailable, handlers render the result and flag it as stale
+var ErrStale = errors.New("stale")
+
+// ErrCachedNotFound is returned by the cache for users recently found absent in storage,
+// unlike a plain cache miss it is final and the storage is not asked again. Clients get the same
+// detail as for a user missing in storage.
+var ErrCachedNotFound = Internal(ErrNotFound, errors.New("user is cached as missing"))
+
+// ErrNoConnection is returned by adapters whose dependency was down at boot and not reached since,
+// callers treat it like any outage: the cache is skipped and storage answers 503
+var ErrNoConnection = Wrap(ErrUnavailable, errors.New("no connection established"))
+
+// Error attaches a domain kind to an underlying adapter error,
+// errors.Is matches both the kind and the wrapped error
+type Error struct {
+	Kind error
+	Err  error
+	// internal hides Err from clients, see Internal
+	internal bool
+}
+
+func Wrap(kind, err error) error {
+	return &Error{Kind: kind, Err: err}
+}
+
+// Internal attaches a kind to a driver error whose text must not reach clients,
+// error responses show the kind only while logs keep the whole error
+func Internal(kind, err error) error {
+	return &Error{Kind: kind, Err: err, internal: true}
+}
+
+func (e *Error) Error() string {
+	return e.Kind.Error() + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	return errors.Is(e.Kind, target)
+}
+
+// FieldError describes a single invalid request field
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError collects every offending field of a request and is an ErrInvalidInput
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Add(field, message string) {
+	e.Fields = append(e.Fields, FieldError{Field: field, Message: message})
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Fields) == 1 {
+		return fmt.Sprintf("validation failed: %s %s", e.Fields[0].Field, e.Fields[0].Message)
+	}
+	return fmt.Sprintf("validation failed for %d fields", len(e.Fields))
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidInput
+}
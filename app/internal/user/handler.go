@@ -1,8 +1,10 @@
 package user
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/gorilla/mux"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/httptrace/otelhttptrace"
@@ -10,11 +12,20 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
 	otrace "go.opentelemetry.io/otel/trace"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 )
 
+// encoding/json has no typed error for DisallowUnknownFields
+const unknownFieldPrefix = "json: unknown field "
+
+// statusClientClosedRequest is the nginx code for a client that went away before the reply
+const statusClientClosedRequest = 499
+
 const (
 	withParamsUserURL    = "/user/{id}"
 	withOutParamsUserURL = "/user"
@@ -25,10 +36,8 @@ var _ Handler = &userHandler{}
 
 type userHandler struct {
 	UserService Service
-}
-
-type AppError struct {
-	Message string `json:"error"`
+	// timeout bounds every request, storage and cache calls give up when it passes
+	timeout time.Duration
 }
 
 type Handler interface {
@@ -37,6 +46,7 @@ type Handler interface {
 
 type respData struct {
 	w          *http.ResponseWriter
+	r          *http.Request
 	span       otrace.Span
 	statusCode int
 	httpMethod string
@@ -49,9 +59,33 @@ func (h *userHandler) Register(router *mux.Router) {
 	router.HandleFunc(withOutParamsUserURL, h.findAllUsers).Methods(http.MethodGet)
 	router.HandleFunc(withParamsUserURL, h.findOneUser).Methods(http.MethodGet)
 	router.HandleFunc(withOutParamsUserURL, h.createUser).Methods(http.MethodPost)
-	router.HandleFunc(withParamsUserURL, h.updateUser).Methods(http.MethodPut)
-	router.HandleFunc(withParamsUserURL, h.deleteUser).Methods(http.MethodDelete)
+	router.HandleFunc(withParamsUserURL, h.updateUser).Methods(http.MethodPut).Name(updateUserRoute)
+	router.HandleFunc(withParamsUserURL, h.deleteUser).Methods(http.MethodDelete).Name(deleteUserRoute)
 	router.HandleFunc(searchURL, h.getUserByNickname).Methods(http.MethodGet)
+	router.HandleFunc(loginURL, h.login).Methods(http.MethodPost)
+	router.HandleFunc(logoutURL, h.logout).Methods(http.MethodPost)
+	router.NotFoundHandler = http.HandlerFunc(h.routeNotFound)
+	router.MethodNotAllowedHandler = http.HandlerFunc(h.methodNotAllowed)
+}
+
+func (h *userHandler) routeNotFound(w http.ResponseWriter, r *http.Request) {
+	h.handleErrorResponse(&respData{
+		w:          &w,
+		r:          r,
+		span:       otrace.SpanFromContext(r.Context()),
+		httpMethod: r.Method,
+		payload:    Wrap(ErrNotFound, errors.New("route "+r.URL.Path+" not found")),
+	})
+}
+
+func (h *userHandler) methodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	h.handleErrorResponse(&respData{
+		w:          &w,
+		r:          r,
+		span:       otrace.SpanFromContext(r.Context()),
+		httpMethod: r.Method,
+		payload:    Wrap(ErrMethodNotAllowed, errors.New("method "+r.Method+" not allowed on "+r.URL.Path)),
+	})
 }
 
 // Find All Users with SingleFlight
@@ -81,10 +115,10 @@ func (h *userHandler) findAllUsers(w http.ResponseWriter, r *http.Request) {
 	if err != nil && limitVar != "" {
 		h.handleErrorResponse(&respData{
 			w:          &w,
+			r:          r,
 			span:       span,
-			statusCode: http.StatusTeapot,
 			httpMethod: http.MethodGet,
-			payload:    err,
+			payload:    Wrap(ErrInvalidInput, err),
 		})
 		// after response increment prometheus metrics
 		getAllUsersRequestsError.Inc()
@@ -105,10 +139,10 @@ func (h *userHandler) findAllUsers(w http.ResponseWriter, r *http.Request) {
 	if err != nil && offsetVar != "" {
 		h.handleErrorResponse(&respData{
 			w:          &w,
+			r:          r,
 			span:       span,
-			statusCode: http.StatusTeapot,
 			httpMethod: http.MethodGet,
-			payload:    err,
+			payload:    Wrap(ErrInvalidInput, err),
 		})
 		// after response increment prometheus metrics
 		getAllUsersRequestsError.Inc()
@@ -120,18 +154,17 @@ func (h *userHandler) findAllUsers(w http.ResponseWriter, r *http.Request) {
 
 	callUserServiceCtx, userServiceCallSpan := tr.Start(parentCtx, "CallUserService", opts...)
 	defer userServiceCallSpan.End()
-	workHash := fmt.Sprintf("findAllUser:%d%d", limit, offset)
-	sflight := h.UserService.getSingleFlightGroup()
+	workHash := fmt.Sprintf("findAllUser:%d:%d", limit, offset)
 	//// call user service to get requested user from cache, if not found get from storage and place to cache
-	users, err, _ := sflight.Do(workHash, func() (interface{}, error) {
-		return h.UserService.findAll(int64(limit), int64(offset), callUserServiceCtx)
+	users, err := h.shared(callUserServiceCtx, workHash, func(ctx context.Context) (interface{}, error) {
+		return h.UserService.findAll(int64(limit), int64(offset), ctx)
 	})
 
 	if err != nil {
 		h.handleErrorResponse(&respData{
 			w:          &w,
+			r:          r,
 			span:       span,
-			statusCode: http.StatusNotFound,
 			httpMethod: http.MethodGet,
 			payload:    err,
 		})
@@ -158,7 +191,7 @@ func (h *userHandler) findAllUsers(w http.ResponseWriter, r *http.Request) {
 		span:       span,
 		statusCode: http.StatusOK,
 		httpMethod: http.MethodGet,
-		payload:    &users,
+		payload:    newUsersResponse(users.([]User)),
 	})
 }
 
@@ -183,13 +216,14 @@ func (h *userHandler) findOneUser(w http.ResponseWriter, r *http.Request) {
 
 	_, convertAtoiSpan := tr.Start(parentCtx, "StringToInt", opts...)
 
-	if _, err := strconv.Atoi(id); err != nil {
+	uid, err := strconv.Atoi(id)
+	if err != nil {
 		h.handleErrorResponse(&respData{
 			w:          &w,
+			r:          r,
 			span:       span,
-			statusCode: http.StatusTeapot,
 			httpMethod: http.MethodGet,
-			payload:    err,
+			payload:    Wrap(ErrInvalidInput, err),
 		})
 		// after response increment prometheus metrics
 		getUserRequestsError.Inc()
@@ -197,21 +231,29 @@ func (h *userHandler) findOneUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	convertAtoiSpan.End()
+	// "007" or "+7" name user 7 too, cache keys are built from the canonical form
+	id = strconv.FormatInt(int64(uid), 10)
 
 	callUserServiceCtx, userServiceCallSpan := tr.Start(parentCtx, "CallUserService", opts...)
 	defer userServiceCallSpan.End()
 	workHash := fmt.Sprintf("getUserByID:%s", id)
-	sflight := h.UserService.getSingleFlightGroup()
 	// call user service to get requested user from cache, if not found get from storage and place to cache
-	user, err, _ := sflight.Do(workHash, func() (interface{}, error) {
-		return h.UserService.findOne(id, callUserServiceCtx)
+	user, err := h.shared(callUserServiceCtx, workHash, func(ctx context.Context) (interface{}, error) {
+		return h.UserService.findOne(id, ctx)
 	})
 
+	if errors.Is(err, ErrStale) {
+		// storage is down and the user comes from the last known good copy
+		w.Header().Set("Warning", `110 - "Response is Stale"`)
+		w.Header().Set("X-Cache", "STALE")
+		err = nil
+	}
+
 	if err != nil {
 		h.handleErrorResponse(&respData{
 			w:          &w,
+			r:          r,
 			span:       span,
-			statusCode: http.StatusNotFound,
 			httpMethod: http.MethodGet,
 			payload:    err,
 		})
@@ -228,7 +270,7 @@ func (h *userHandler) findOneUser(w http.ResponseWriter, r *http.Request) {
 		span:       span,
 		statusCode: http.StatusOK,
 		httpMethod: http.MethodGet,
-		payload:    &user,
+		payload:    newUserResponse(user.(User)),
 	})
 }
 
@@ -248,23 +290,34 @@ func (h *userHandler) createUser(w http.ResponseWriter, r *http.Request) {
 
 	defer createUserRequestsTotal.Inc()
 
-	user := &User{}
-	parseBody(r, user)
+	req := &UserRequest{}
+	if err := parseUser(r, req); err != nil {
+		h.handleErrorResponse(&respData{
+			w:          &w,
+			r:          r,
+			span:       span,
+			httpMethod: http.MethodPost,
+			payload:    err,
+		})
+		// after response increment prometheus metrics
+		createUserRequestsError.Inc()
+		return
+	}
+	user := req.toUser()
 
 	callUserServiceCtx, userServiceCallSpan := tr.Start(parentCtx, "CallUserService", opts...)
 	defer userServiceCallSpan.End()
-	workHash := fmt.Sprintf("createUser:%s%s%s", user.FistName, user.LastName, user.NickName)
-	sflight := h.UserService.getSingleFlightGroup()
+	workHash := fmt.Sprintf("createUser:%q:%q:%q", user.FistName, user.LastName, user.NickName)
 	// call user service to get requested user from cache, if not found get from storage and place to cache
-	_, err, _ := sflight.Do(workHash, func() (interface{}, error) {
-		return nil, h.UserService.create(user, callUserServiceCtx)
+	_, err := h.shared(callUserServiceCtx, workHash, func(ctx context.Context) (interface{}, error) {
+		return nil, h.UserService.create(user, ctx)
 	})
 
 	if err != nil {
 		h.handleErrorResponse(&respData{
 			w:          &w,
+			r:          r,
 			span:       span,
-			statusCode: http.StatusBadRequest,
 			httpMethod: http.MethodPost,
 			payload:    err,
 		})
@@ -280,7 +333,7 @@ func (h *userHandler) createUser(w http.ResponseWriter, r *http.Request) {
 		span:       span,
 		statusCode: http.StatusCreated,
 		httpMethod: http.MethodPost,
-		payload:    &user,
+		payload:    newUserResponse(*user),
 	})
 }
 
@@ -304,13 +357,14 @@ func (h *userHandler) updateUser(w http.ResponseWriter, r *http.Request) {
 
 	_, convertAtoiSpan := tr.Start(parentCtx, "StringToInt", opts...)
 
-	if _, err := strconv.Atoi(id); err != nil {
+	uid, err := strconv.Atoi(id)
+	if err != nil {
 		h.handleErrorResponse(&respData{
 			w:          &w,
+			r:          r,
 			span:       span,
-			statusCode: http.StatusTeapot,
 			httpMethod: http.MethodPut,
-			payload:    err,
+			payload:    Wrap(ErrInvalidInput, err),
 		})
 		// after response increment prometheus metrics
 		updateUserRequestsError.Inc()
@@ -318,27 +372,38 @@ func (h *userHandler) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	convertAtoiSpan.End()
+	// "007" or "+7" name user 7 too, cache keys are built from the canonical form
+	id = strconv.FormatInt(int64(uid), 10)
 
-	user := &User{}
-	parseBody(r, user)
-	if uid, err := strconv.Atoi(id); err == nil {
-		user.Id = int64(uid)
+	req := &UserRequest{}
+	if err := parseUser(r, req); err != nil {
+		h.handleErrorResponse(&respData{
+			w:          &w,
+			r:          r,
+			span:       span,
+			httpMethod: http.MethodPut,
+			payload:    err,
+		})
+		// after response increment prometheus metrics
+		updateUserRequestsError.Inc()
+		return
 	}
+	user := req.toUser()
+	user.Id = int64(uid)
 
 	callUserServiceCtx, userServiceCallSpan := tr.Start(parentCtx, "CallUserService", opts...)
 	defer userServiceCallSpan.End()
 	workHash := fmt.Sprintf("updateUserByID:%s", id)
-	sflight := h.UserService.getSingleFlightGroup()
 	// call user service to get requested user from cache, if not found get from storage and place to cache
-	_, err, _ := sflight.Do(workHash, func() (interface{}, error) {
-		return nil, h.UserService.update(user, callUserServiceCtx)
+	_, err = h.shared(callUserServiceCtx, workHash, func(ctx context.Context) (interface{}, error) {
+		return nil, h.UserService.update(user, ctx)
 	})
 
 	if err != nil {
 		h.handleErrorResponse(&respData{
 			w:          &w,
+			r:          r,
 			span:       span,
-			statusCode: http.StatusNotFound,
 			httpMethod: http.MethodPut,
 			payload:    err,
 		})
@@ -354,7 +419,7 @@ func (h *userHandler) updateUser(w http.ResponseWriter, r *http.Request) {
 		span:       span,
 		statusCode: http.StatusOK,
 		httpMethod: http.MethodPut,
-		payload:    &user,
+		payload:    newUserResponse(*user),
 	})
 }
 
@@ -379,13 +444,14 @@ func (h *userHandler) deleteUser(w http.ResponseWriter, r *http.Request) {
 
 	_, convertAtoiSpan := tr.Start(parentCtx, "StringToInt", opts...)
 
-	if _, err := strconv.Atoi(id); err != nil {
+	uid, err := strconv.Atoi(id)
+	if err != nil {
 		h.handleErrorResponse(&respData{
 			w:          &w,
+			r:          r,
 			span:       span,
-			statusCode: http.StatusTeapot,
 			httpMethod: http.MethodDelete,
-			payload:    err,
+			payload:    Wrap(ErrInvalidInput, err),
 		})
 		// after response increment prometheus metrics
 		deleteUserRequestsError.Inc()
@@ -393,22 +459,23 @@ func (h *userHandler) deleteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	convertAtoiSpan.End()
+	// "007" or "+7" name user 7 too, cache keys are built from the canonical form
+	id = strconv.FormatInt(int64(uid), 10)
 
 	callUserServiceCtx, userServiceCallSpan := tr.Start(parentCtx, "CallUserService", opts...)
 	defer userServiceCallSpan.End()
 	workHash := fmt.Sprintf("deleteUser:%s", id)
-	sflight := h.UserService.getSingleFlightGroup()
 
 	// call user service to get requested user from cache, if not found get from storage and place to cache
-	_, err, _ := sflight.Do(workHash, func() (interface{}, error) {
-		return nil, h.UserService.delete(id, callUserServiceCtx)
+	_, err = h.shared(callUserServiceCtx, workHash, func(ctx context.Context) (interface{}, error) {
+		return nil, h.UserService.delete(id, ctx)
 	})
 
 	if err != nil {
 		h.handleErrorResponse(&respData{
 			w:          &w,
+			r:          r,
 			span:       span,
-			statusCode: http.StatusNotFound,
 			httpMethod: http.MethodDelete,
 			payload:    err,
 		})
@@ -424,7 +491,7 @@ func (h *userHandler) deleteUser(w http.ResponseWriter, r *http.Request) {
 		span:       span,
 		statusCode: http.StatusOK,
 		httpMethod: http.MethodDelete,
-		payload:    &User{},
+		payload:    UserResponse{},
 	})
 }
 
@@ -451,16 +518,15 @@ func (h *userHandler) getUserByNickname(w http.ResponseWriter, r *http.Request)
 	// call user service to get requested user from cache, if not found get from storage and place to cache
 	workHash := fmt.Sprintf("getUserByNickname:%s", nickname)
 
-	sflight := h.UserService.getSingleFlightGroup()
-	user, err, _ := sflight.Do(workHash, func() (interface{}, error) {
-		return h.UserService.findByNickname(nickname, reqCtx)
+	user, err := h.shared(reqCtx, workHash, func(ctx context.Context) (interface{}, error) {
+		return h.UserService.findByNickname(nickname, ctx)
 	})
 
 	if err != nil {
 		h.handleErrorResponse(&respData{
 			w:          &w,
+			r:          r,
 			span:       span,
-			statusCode: http.StatusNotFound,
 			httpMethod: http.MethodGet,
 			payload:    err,
 		})
@@ -475,10 +541,53 @@ func (h *userHandler) getUserByNickname(w http.ResponseWriter, r *http.Request)
 		span:       span,
 		statusCode: http.StatusOK,
 		httpMethod: http.MethodGet,
-		payload:    user,
+		payload:    newUserResponse(user.(User)),
 	})
 }
 
+// sharedResult carries the value of a shared call along with its span, so every caller that
+// joined the call can point to it
+type sharedResult struct {
+	value interface{}
+	span  otrace.SpanContext
+}
+
+// shared runs fn once for all concurrent callers of key. The call runs detached from the
+// request that started it, bounded by the handler timeout, so one client going away does not
+// fail the call for everyone waiting on it. Each caller still stops waiting once its own ctx
+// is done.
+func (h *userHandler) shared(ctx context.Context, key string, fn func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	link := otrace.Link{SpanContext: otrace.SpanContextFromContext(ctx)}
+	ch := h.UserService.getSingleFlightGroup().DoChan(key, func() (interface{}, error) {
+		sharedCtx, cancel := context.WithTimeout(context.Background(), h.timeout)
+		defer cancel()
+
+		tr := h.UserService.getTracer().Tracer("Handler.shared")
+		sharedCtx, span := tr.Start(sharedCtx, "SharedCall", otrace.WithLinks(link))
+		defer span.End()
+		span.SetAttributes(attribute.Key("work_hash").String(key))
+
+		value, err := fn(sharedCtx)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return sharedResult{value: value, span: span.SpanContext()}, err
+	})
+
+	select {
+	case res := <-ch:
+		result := res.Val.(sharedResult)
+		otrace.SpanFromContext(ctx).AddEvent("joined shared call", otrace.WithAttributes(
+			attribute.Key("shared").Bool(res.Shared),
+			attribute.Key("shared_trace_id").String(result.span.TraceID().String()),
+			attribute.Key("shared_span_id").String(result.span.SpanID().String()),
+		))
+		return result.value, res.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 func (h *userHandler) setSpanAttributes(span otrace.Span, r *http.Request) {
 	span.SetAttributes(attribute.Key("request_uri").String(r.RequestURI))
 	span.SetAttributes(attribute.Key("request_method").String(r.Method))
@@ -487,14 +596,50 @@ func (h *userHandler) setSpanAttributes(span otrace.Span, r *http.Request) {
 }
 
 func (h *userHandler) handleErrorResponse(he *respData) {
-	traceId := he.span.SpanContext().TraceID().String()
+	err := he.payload.(error)
+	he.statusCode = statusCodeFromError(err)
+	var traceId string
+	if sc := he.span.SpanContext(); sc.HasTraceID() {
+		traceId = sc.TraceID().String()
+	}
 	he.span.SetStatus(codes.Code(he.statusCode), "request processing ended with an error")
 	// after response increment prometheus metrics
 	httpStatusCodes.WithLabelValues(strconv.Itoa(he.statusCode), he.httpMethod).Inc()
 	//render result to client
-	renderJSON(*he.w, &AppError{Message: fmt.Sprintf("request processing ended with an error, "+
-		"contact support by passing them the request ID: %s", traceId)}, he.statusCode)
-	h.UserService.error(he.payload.(error))
+	renderProblem(*he.w, newProblem(err, he.statusCode, he.r.URL.RequestURI(), traceId))
+	if he.statusCode == statusClientClosedRequest {
+		// nobody reads the reply and nothing went wrong on our side
+		return
+	}
+	h.UserService.error(err)
+}
+
+// statusCodeFromError is the single place where domain errors turn into HTTP status codes
+func statusCodeFromError(err error) int {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return statusClientClosedRequest
+	case errors.Is(err, ErrInvalidInput):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrUnauthorized):
+		return http.StatusUnauthorized
+	case errors.Is(err, ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrMethodNotAllowed):
+		return http.StatusMethodNotAllowed
+	case errors.Is(err, ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, ErrPayloadTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, ErrUnavailable):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
 }
 
 func (h *userHandler) handleSuccessResponse(hs *respData) {
@@ -506,7 +651,7 @@ func (h *userHandler) handleSuccessResponse(hs *respData) {
 }
 
 func (h *userHandler) configTracer(r *http.Request) ([]otrace.SpanStartOption, context.CancelFunc, context.Context) {
-	ctx, cancel := context.WithCancel(r.Context())
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
 	opts := []otrace.SpanStartOption{
 		otrace.WithAttributes(semconv.NetAttributesFromHTTPRequest("tcp", r)...),
 		otrace.WithAttributes(semconv.EndUserAttributesFromHTTPRequest(r)...),
@@ -517,9 +662,12 @@ func (h *userHandler) configTracer(r *http.Request) ([]otrace.SpanStartOption, c
 	return opts, cancel, reqCtx
 }
 
-func GetHandler(userService Service) Handler {
+// GetHandler serves users within timeout per request, keep it below the server write timeout
+// so the error response still reaches the client
+func GetHandler(userService Service, timeout time.Duration) Handler {
 	h := userHandler{
 		UserService: userService,
+		timeout:     timeout,
 	}
 	return &h
 }
@@ -530,10 +678,51 @@ func renderJSON(w http.ResponseWriter, val interface{}, statusCode int) {
 	_ = json.NewEncoder(w).Encode(val)
 }
 
-func parseBody(r *http.Request, x interface{}) {
-	if body, err := ioutil.ReadAll(r.Body); err == nil {
-		if err := json.Unmarshal(body, x); err != nil {
-			return
-		}
+// maxBodySize limits user payloads, a single user is far below it
+const maxBodySize = 64 << 10
+
+// parseBody strictly decodes a single JSON object: unknown fields, trailing data
+// and bodies above maxBodySize are rejected
+func parseBody(r *http.Request, x interface{}) error {
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		return Wrap(ErrInvalidInput, err)
+	}
+	if len(body) > maxBodySize {
+		return Wrap(ErrPayloadTooLarge, fmt.Errorf("request body exceeds %d bytes", maxBodySize))
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(x); err != nil {
+		return decodeError(err)
+	}
+	if err := dec.Decode(&struct{}{}); err != io.EOF {
+		return Wrap(ErrInvalidInput, errors.New("request body must contain a single JSON object"))
+	}
+	return nil
+}
+
+func decodeError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	ve := &ValidationError{}
+	switch {
+	case errors.As(err, &typeErr):
+		ve.Add(typeErr.Field, "must be of type "+typeErr.Type.String())
+		return ve
+	case strings.HasPrefix(err.Error(), unknownFieldPrefix):
+		ve.Add(strings.Trim(strings.TrimPrefix(err.Error(), unknownFieldPrefix), `"`), "is not allowed")
+		return ve
+	case err == io.EOF:
+		return Wrap(ErrInvalidInput, errors.New("request body is empty"))
+	}
+	return Wrap(ErrInvalidInput, err)
+}
+
+// parseUser decodes and validates a user create or update payload
+func parseUser(r *http.Request, u *UserRequest) error {
+	if err := parseBody(r, u); err != nil {
+		return err
 	}
+	return u.Validate()
 }
@@ -0,0 +1,34 @@
+package user
+
+import (
+	"context"
+)
+
+// Invalidation names the users changed by a write on some instance. All asks to drop every
+// local copy, subscribers get it after a reconnect because events may have been missed meanwhile.
+type Invalidation struct {
+	Origin    string   `json:"origin"`
+	Ids       []int64  `json:"ids,omitempty"`
+	NickNames []string `json:"nicknames,omitempty"`
+	All       bool     `json:"all,omitempty"`
+}
+
+// InvalidationBus fans invalidations out to every instance of the service
+type InvalidationBus interface {
+	// PublishInvalidation stamps inv with the origin of this instance and sends it to all of them
+	PublishInvalidation(ctx context.Context, inv Invalidation) error
+	// SubscribeInvalidations calls handle for invalidations published by other instances
+	// and blocks, resubscribing after connection loss, until ctx is done
+	SubscribeInvalidations(ctx context.Context, handle func(Invalidation))
+}
+
+func newInvalidation(users ...User) Invalidation {
+	inv := Invalidation{}
+	for _, u := range users {
+		inv.Ids = append(inv.Ids, u.Id)
+		if u.NickName != "" {
+			inv.NickNames = append(inv.NickNames, u.NickName)
+		}
+	}
+	return inv
+}
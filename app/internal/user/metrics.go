@@ -41,6 +41,11 @@ var (
 		Name: "redis_cache_example_user_http_request_duration_seconds",
 		Help: "Duration of HTTP requests.",
 	}, []string{"path"})
+
+	degraded = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "redis_cache_example_user_degraded",
+		Help: "1 while the database is unavailable and users are served from cache.",
+	})
 )
 
 func init() {
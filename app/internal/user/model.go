@@ -1,6 +1,18 @@
 package user
 
+// User is the domain entity, Pass always holds a password hash and never leaves the service
 type User struct {
+	Id       int64  `json:"id,omitempty"`
+	NickName string `json:"nickname"`
+	FistName string `json:"firstname"`
+	LastName string `json:"lastname"`
+	Gender   string `json:"gender"`
+	Pass     string `json:"-"`
+	Status   uint8  `json:"status"`
+}
+
+// UserRequest is the write-only create and update payload carrying a plain text password
+type UserRequest struct {
 	Id       int64  `json:"id,omitempty"`
 	NickName string `json:"nickname"`
 	FistName string `json:"firstname"`
@@ -9,3 +21,44 @@ type User struct {
 	Pass     string `json:"pass"`
 	Status   uint8  `json:"status"`
 }
+
+// UserResponse is what clients get back, it has no credential fields
+type UserResponse struct {
+	Id       int64  `json:"id,omitempty"`
+	NickName string `json:"nickname"`
+	FistName string `json:"firstname"`
+	LastName string `json:"lastname"`
+	Gender   string `json:"gender"`
+	Status   uint8  `json:"status"`
+}
+
+func (r *UserRequest) toUser() *User {
+	return &User{
+		Id:       r.Id,
+		NickName: r.NickName,
+		FistName: r.FistName,
+		LastName: r.LastName,
+		Gender:   r.Gender,
+		Pass:     r.Pass,
+		Status:   r.Status,
+	}
+}
+
+func newUserResponse(u User) UserResponse {
+	return UserResponse{
+		Id:       u.Id,
+		NickName: u.NickName,
+		FistName: u.FistName,
+		LastName: u.LastName,
+		Gender:   u.Gender,
+		Status:   u.Status,
+	}
+}
+
+func newUsersResponse(users []User) []UserResponse {
+	res := make([]UserResponse, 0, len(users))
+	for _, u := range users {
+		res = append(res, newUserResponse(u))
+	}
+	return res
+}
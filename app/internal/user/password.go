@@ -0,0 +1,50 @@
+package user
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"regexp"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcrypt ignores everything past 72 bytes, longer passwords are rejected by validation
+const maxPasswordBytes = 72
+
+const minPasswordLength = 8
+
+func hashPassword(plain string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(plain), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     string
+)
+
+// legacyHashRe matches the unsalted md5 hex digests stored before passwords were hashed with bcrypt
+var legacyHashRe = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// checkPassword reports whether plain matches the stored hash and whether that hash is a legacy
+// md5 digest, callers replace a matching legacy hash with a bcrypt one
+func checkPassword(hash, plain string) (ok, legacy bool) {
+	if legacyHashRe.MatchString(hash) {
+		sum := md5.Sum([]byte(plain))
+		return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(hash)) == 1, true
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(plain)) == nil, false
+}
+
+// burnPasswordCheck spends the same time as a real check so unknown nicknames are not distinguishable
+func burnPasswordCheck(plain string) {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = hashPassword("dummy-password-for-timing")
+	})
+	checkPassword(dummyHash, plain)
+}
@@ -0,0 +1,70 @@
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const problemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 error response body
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail,omitempty"`
+	Instance string       `json:"instance,omitempty"`
+	TraceID  string       `json:"trace_id,omitempty"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+// newProblem uses about:blank as type, the status code and title tell the problems apart
+func newProblem(err error, statusCode int, instance, traceId string) *Problem {
+	p := &Problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(statusCode),
+		Status:   statusCode,
+		Instance: instance,
+		TraceID:  traceId,
+	}
+
+	// server side failures keep their internals out of the response
+	if statusCode >= http.StatusInternalServerError {
+		p.Detail = fmt.Sprintf("request processing ended with an error, "+
+			"contact support by passing them the request ID: %s", traceId)
+	} else {
+		p.Detail = publicDetail(err)
+	}
+
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		p.Detail = ve.Error()
+		p.Errors = ve.Fields
+	}
+	return p
+}
+
+func renderProblem(w http.ResponseWriter, p *Problem) {
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// publicDetail is the message of err with adapter errors cut down to their domain kind,
+// driver messages such as constraint names and SQLSTATE codes only reach the logs
+func publicDetail(err error) string {
+	var e *Error
+	for cause := err; errors.As(cause, &e); cause = e.Err {
+		if e.internal {
+			full, internal := err.Error(), e.Error()
+			if i := strings.Index(full, internal); i >= 0 {
+				return full[:i] + e.Kind.Error()
+			}
+			return e.Kind.Error()
+		}
+	}
+	return err.Error()
+}
@@ -0,0 +1,64 @@
+package user
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/heptiolabs/healthcheck"
+)
+
+// Readiness keeps an instance ready while the database is down but the cache answers,
+// users are then served from their last known good copies and the instance reports degraded
+type Readiness struct {
+	storage Storage
+	cache   Cache
+	timeout time.Duration
+
+	mu     sync.Mutex
+	reason error
+}
+
+func NewReadiness(storage Storage, cache Cache, timeout time.Duration) *Readiness {
+	return &Readiness{
+		storage: storage,
+		cache:   cache,
+		timeout: timeout,
+	}
+}
+
+// DatabaseCheck fails only when neither the database nor the cache answers
+func (r *Readiness) DatabaseCheck() healthcheck.Check {
+	return func() error {
+		err := DatabasePingCheck(r.storage, r.timeout)()
+		if err == nil {
+			r.setDegraded(nil)
+			return nil
+		}
+		if cacheErr := CachePingCheck(r.cache, r.timeout)(); cacheErr != nil {
+			r.setDegraded(nil)
+			return err
+		}
+		r.setDegraded(fmt.Errorf("database unavailable, serving from cache: %w", err))
+		return nil
+	}
+}
+
+// Degraded returns why the instance runs degraded, nil when it is fully operational
+func (r *Readiness) Degraded() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.reason
+}
+
+func (r *Readiness) setDegraded(reason error) {
+	r.mu.Lock()
+	r.reason = reason
+	r.mu.Unlock()
+
+	if reason != nil {
+		degraded.Set(1)
+	} else {
+		degraded.Set(0)
+	}
+}
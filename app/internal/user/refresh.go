@@ -0,0 +1,107 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	otrace "go.opentelemetry.io/otel/trace"
+)
+
+const backgroundRefreshTimeout = 5 * time.Second
+
+// loadTimer keeps a moving average of how long loading an entity from storage takes
+type loadTimer struct {
+	nanos int64
+}
+
+func (t *loadTimer) observe(d time.Duration) {
+	prev := atomic.LoadInt64(&t.nanos)
+	if prev == 0 {
+		atomic.StoreInt64(&t.nanos, int64(d))
+		return
+	}
+	// concurrent observations may overwrite each other, the average stays close enough
+	atomic.StoreInt64(&t.nanos, prev+(int64(d)-prev)/5)
+}
+
+func (t *loadTimer) average() time.Duration {
+	return time.Duration(atomic.LoadInt64(&t.nanos))
+}
+
+// refreshEarly implements XFetch: the closer an entry gets to going stale and the longer it
+// takes to load, the likelier a request refreshes it ahead of time, so hot keys are reloaded
+// by one request instead of all of them at expiry
+func refreshEarly(f Freshness, load time.Duration) bool {
+	if f.Beta <= 0 || load <= 0 {
+		return false
+	}
+	// 1-Float64 is in (0, 1], log never sees 0
+	gap := -float64(load) * f.Beta * math.Log(1-rand.Float64())
+	return time.Duration(gap) >= f.FreshFor
+}
+
+// refresher runs at most one background refresh per key in this process
+type refresher struct {
+	running sync.Map
+}
+
+// refreshInBackground reloads key detached from the request, linked to its span for tracing.
+// Hits arriving while it runs keep being served from cache.
+func (s *service) refreshInBackground(ctx context.Context, key string, refresh func(ctx context.Context) error) {
+	if _, running := s.refresher.running.LoadOrStore(key, struct{}{}); running {
+		return
+	}
+	link := otrace.Link{SpanContext: otrace.SpanContextFromContext(ctx)}
+
+	go func() {
+		defer s.refresher.running.Delete(key)
+
+		refreshCtx, cancel := context.WithTimeout(context.Background(), backgroundRefreshTimeout)
+		defer cancel()
+
+		tr := s.tracer.Tracer("Service.refresh")
+		refreshCtx, span := tr.Start(refreshCtx, "BackgroundRefresh", otrace.WithLinks(link))
+		defer span.End()
+
+		if err := refresh(refreshCtx); err != nil {
+			s.logger.Error("Background refresh of " + key + " failed: " + err.Error())
+		}
+	}()
+}
+
+// refreshUser reloads a user by id into the cache, an absent user is remembered as missing
+func (s *service) refreshUser(id string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		start := time.Now()
+		u, err := s.storage.FindOne(ctx, id)
+		if errors.Is(err, ErrNotFound) {
+			return s.cache.SetMissing(ctx, id)
+		}
+		if err != nil {
+			return err
+		}
+		s.userLoad.observe(time.Since(start))
+		return s.cache.Set(ctx, u)
+	}
+}
+
+// refreshNickname reloads a user by nickname into the cache, an absent user is remembered as missing
+func (s *service) refreshNickname(nickname string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		start := time.Now()
+		u, err := s.storage.FindOneByNickName(ctx, nickname)
+		if errors.Is(err, ErrNotFound) {
+			return s.cache.SetMissingByNickname(ctx, nickname)
+		}
+		if err != nil {
+			return err
+		}
+		s.nicknameLoad.observe(time.Since(start))
+		return s.cache.SetByNickname(ctx, u)
+	}
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/getsentry/sentry-go"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
@@ -9,16 +10,24 @@ import (
 	"golang.org/x/sync/singleflight"
 	"redis/pkg/logging"
 	"strconv"
+	"strings"
+	"time"
 )
 
 var _ Service = &service{}
 
 type service struct {
-	storage Storage
-	cache   Cache
-	logger  logging.Logger
-	tracer  *tracesdk.TracerProvider
-	sflight *singleflight.Group
+	storage  Storage
+	cache    Cache
+	sessions SessionStore
+	bus      InvalidationBus
+	logger   logging.Logger
+	tracer   *tracesdk.TracerProvider
+	sflight  *singleflight.Group
+
+	refresher    refresher
+	userLoad     loadTimer
+	nicknameLoad loadTimer
 }
 
 type Service interface {
@@ -28,18 +37,23 @@ type Service interface {
 	delete(id string, ctx context.Context) error
 	update(u *User, ctx context.Context) error
 	findByNickname(nickname string, ctx context.Context) (u User, err error)
+	login(nickname, pass string, ctx context.Context) (sess Session, err error)
+	logout(token string, ctx context.Context) error
+	resolveSession(token string, ctx context.Context) (sess Session, err error)
 	getTracer() (t *tracesdk.TracerProvider)
 	getSingleFlightGroup() (sfg *singleflight.Group)
 	error(err error)
 }
 
-func NewService(userStorage Storage, userCache Cache, appLogger logging.Logger, appTracer *tracesdk.TracerProvider) (Service, error) {
+func NewService(userStorage Storage, userCache Cache, userSessions SessionStore, userBus InvalidationBus, appLogger logging.Logger, appTracer *tracesdk.TracerProvider) (Service, error) {
 	return &service{
-		storage: userStorage,
-		cache:   userCache,
-		logger:  appLogger,
-		tracer:  appTracer,
-		sflight: &singleflight.Group{},
+		storage:  userStorage,
+		cache:    userCache,
+		sessions: userSessions,
+		bus:      userBus,
+		logger:   appLogger,
+		tracer:   appTracer,
+		sflight:  &singleflight.Group{},
 	}, nil
 }
 
@@ -63,15 +77,25 @@ func (s *service) findOne(id string, ctx context.Context) (u User, err error) {
 	defer trace(s.logger, fmt.Sprintf("findOne id: %s", id), &cstatus, traceId)()
 
 	parentCacheCtx, getFromCacheSpan := tr.Start(parentCtx, "getFromCache", opts...)
-	u, err = s.cache.Get(context.Background(), id)
+	u, fresh, err := s.cache.Get(parentCacheCtx, id)
 	if err == nil {
 		s.logger.Debug("Cache hit for user id: " + id)
 		cstatus = "HIT"
 
+		// a stale or soon stale copy is served while a single background refresh replaces it
+		if fresh.Stale() || refreshEarly(fresh, s.userLoad.average()) {
+			if fresh.Stale() {
+				cstatus = "STALE"
+			}
+			s.refreshInBackground(parentCacheCtx, "user:"+id, s.refreshUser(id))
+			getFromCacheSpan.End()
+			return u, nil
+		}
+
 		// after success get user from cache refresh expire time for him
-		_, setExpireInCache := tr.Start(parentCacheCtx, "setCacheExpiration", opts...)
+		expireCtx, setExpireInCache := tr.Start(parentCacheCtx, "setCacheExpiration", opts...)
 
-		err := s.cache.Expire(context.Background(), id)
+		err := s.cache.Expire(expireCtx, id)
 		if err != nil {
 			s.logger.Error("Set cache expiration failed for user id: " + id)
 			s.error(err)
@@ -83,17 +107,47 @@ func (s *service) findOne(id string, ctx context.Context) (u User, err error) {
 		return u, nil
 	}
 	getFromCacheSpan.End()
+	if errors.Is(err, ErrCachedNotFound) {
+		s.logger.Debug("Cache hit for missing user id: " + id)
+		cstatus = "HIT"
+		return User{}, fmt.Errorf("failed to get user by id=%s. error: %w", id, err)
+	}
 
 	cstatus = "MISS"
 	s.logger.Debug("Cache miss for user id: " + id)
 	parentDBCtx, getFromDBSpan := tr.Start(parentCtx, "getFromDB", opts...)
-	u, err = s.storage.FindOne(id)
+	start := time.Now()
+	u, err = s.storage.FindOne(parentDBCtx, id)
+	if err == nil {
+		s.userLoad.observe(time.Since(start))
+	}
+	if errors.Is(err, ErrNotFound) {
+		// remember the absence so repeated lookups stop at the cache
+		setInCacheCtx, setInCacheSpan := tr.Start(parentDBCtx, "setMissingInCache", opts...)
+		if err := s.cache.SetMissing(setInCacheCtx, id); err != nil {
+			s.logger.Error(err.Error())
+		}
+		setInCacheSpan.End()
+	}
+	if errors.Is(err, ErrUnavailable) || errors.Is(err, ErrTimeout) {
+		// storage is down, fall back to the last known good copy and flag it as stale
+		lastKnownCtx, lastKnownSpan := tr.Start(parentDBCtx, "getLastKnownFromCache", opts...)
+		lastKnown, lkErr := s.cache.GetLastKnown(lastKnownCtx, id)
+		lastKnownSpan.End()
+		if lkErr == nil {
+			s.logger.Debug("Serve last known copy of user id: " + id)
+			cstatus = "STALE"
+			getFromDBSpan.End()
+			return lastKnown, Wrap(ErrStale, err)
+		}
+	}
 	if err != nil {
+		getFromDBSpan.End()
 		return User{}, fmt.Errorf("failed to get user by id=%s. error: %w", id, err)
 	}
 	// after get user from storage place him to cache with ttl
-	_, setInCacheSpan := tr.Start(parentDBCtx, "setInCache", opts...)
-	err = s.cache.Set(context.Background(), u)
+	setInCacheCtx, setInCacheSpan := tr.Start(parentDBCtx, "setInCache", opts...)
+	err = s.cache.Set(setInCacheCtx, u)
 	if err != nil {
 		s.logger.Error(err.Error())
 		setInCacheSpan.End()
@@ -118,17 +172,20 @@ func (s *service) findAll(limit, offset int64, ctx context.Context) (users []Use
 	// log time duration for all operations steps without lock/unlock mutex and init prometheus metrics (clean time for get entity)
 	defer trace(s.logger, fmt.Sprintf("findAll limit, offset: %d , %d", limit, offset), &cstatus, traceId)()
 
+	// pages are cached per generation, a failed version lookup skips the cache entirely
 	parentCacheCtx, getFromCacheSpan := tr.Start(parentCtx, "getFromCache", opts...)
-	key := fmt.Sprintf("ALL_USERS_BY_LIMIT_OFFSET%d%d", limit, offset)
-	users, err = s.cache.GetAll(context.Background(), key)
-	if err == nil {
+	version, verErr := s.cache.PageVersion(parentCacheCtx)
+	if verErr == nil {
+		users, err = s.cache.GetAll(parentCacheCtx, version, limit, offset)
+	}
+	if verErr == nil && err == nil {
 		s.logger.Debug(fmt.Sprintf("Cache hit for users by offset: %d", offset))
 		cstatus = "HIT"
 
 		// after success get user from cache refresh expire time for him
-		_, setExpireInCache := tr.Start(parentCacheCtx, "setCacheExpiration", opts...)
+		expireCtx, setExpireInCache := tr.Start(parentCacheCtx, "setCacheExpiration", opts...)
 
-		err := s.cache.ExpireAll(context.Background(), key)
+		err := s.cache.ExpireAll(expireCtx, version, limit, offset)
 		if err != nil {
 			s.logger.Error(fmt.Sprintf("Set cache expiration failed for get all users offset: %d", offset))
 			s.error(err)
@@ -143,15 +200,22 @@ func (s *service) findAll(limit, offset int64, ctx context.Context) (users []Use
 	cstatus = "MISS"
 	s.logger.Debug(fmt.Sprintf("Cache miss for all users id: %d", offset))
 	parentDBCtx, getFromDBSpan := tr.Start(parentCtx, "getFromDB", opts...)
-	users, err = s.storage.FindAll(limit, offset)
+	users, err = s.storage.FindAll(parentDBCtx, limit, offset)
 	if err != nil {
 		return []User{}, fmt.Errorf("failed to get users. error: %w", err)
 	}
 
+	if verErr != nil {
+		s.logger.Error(verErr.Error())
+		getFromDBSpan.End()
+		return users, nil
+	}
+
 	//after get user from storage place him to cache with ttl
+	//under the version read before the query, a write in between already retired it
 
-	_, setInCacheSpan := tr.Start(parentDBCtx, "setInCache", opts...)
-	err = s.cache.SetAll(context.Background(), key, users)
+	setInCacheCtx, setInCacheSpan := tr.Start(parentDBCtx, "setInCache", opts...)
+	err = s.cache.SetAll(setInCacheCtx, version, limit, offset, users)
 	if err != nil {
 		s.logger.Error(err.Error())
 		setInCacheSpan.End()
@@ -163,6 +227,30 @@ func (s *service) findAll(limit, offset int64, ctx context.Context) (users []Use
 	return users, nil
 }
 
+// upgradePassword replaces the legacy md5 digest of a user who just logged in with a bcrypt hash,
+// a failure only postpones the upgrade to the next login
+func (s *service) upgradePassword(ctx context.Context, u User, pass string) {
+	tr := s.tracer.Tracer("Service.login")
+	ctx, span := tr.Start(ctx, "upgradePasswordHash", newTracerOpts()...)
+	defer span.End()
+
+	hash, err := hashPassword(pass)
+	if err == nil {
+		// only the hash changes, cached copies hold no password and stay valid
+		err = s.storage.ReplacePassword(ctx, u.Id, u.Pass, hash)
+	}
+	if errors.Is(err, ErrConflict) {
+		// the password was changed in the meantime, the new one is not ours to replace
+		s.logger.Info(fmt.Sprintf("Skipped upgrade of legacy password hash for user id: %d: %s", u.Id, err.Error()))
+		return
+	}
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("Upgrade of legacy password hash failed for user id: %d: %s", u.Id, err.Error()))
+		return
+	}
+	s.logger.Info(fmt.Sprintf("Upgraded legacy password hash of user id: %d", u.Id))
+}
+
 // Delete User from DB
 func (s *service) delete(id string, ctx context.Context) error {
 	opts := newTracerOpts()
@@ -178,20 +266,21 @@ func (s *service) delete(id string, ctx context.Context) error {
 	defer trace(s.logger, fmt.Sprintf("delete id: %s", id), &cstatus, traceId)()
 
 	parentDBCtx, deleteFromDBSpan := tr.Start(parentCtx, "deleteFromDB", opts...)
-	err := s.storage.Delete(id)
+	prev, err := s.storage.Delete(parentDBCtx, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete user by id=%s. error: %w", id, err)
 	}
 
-	// after get user from storage place him to cache with ttl
-	_, delInCacheSpan := tr.Start(parentDBCtx, "delInCache", opts...)
-	err = s.cache.Del(context.Background(), id)
+	// drop id and nickname entries and every list page that may contain the user
+	delInCacheCtx, delInCacheSpan := tr.Start(parentDBCtx, "delInCache", opts...)
+	err = s.cache.Invalidate(delInCacheCtx, prev)
 	if err != nil {
 		s.logger.Error(err.Error())
 		delInCacheSpan.End()
 	}
 	s.logger.Debug("Del from cache user by id: " + id)
 	delInCacheSpan.End()
+	s.publishInvalidation(parentDBCtx, prev)
 
 	deleteFromDBSpan.End()
 	return nil
@@ -211,21 +300,28 @@ func (s *service) create(u *User, ctx context.Context) error {
 	// log time duration for all operations steps without lock/unlock mutex and init prometheus metrics (clean time for get entity)
 	defer trace(s.logger, fmt.Sprintf("create id: %d", u.Id), &cstatus, traceId)()
 
+	hash, err := hashPassword(u.Pass)
+	if err != nil {
+		return fmt.Errorf("failed to hash password. error: %w", err)
+	}
+	u.Pass = hash
+
 	parentDBCtx, getFromDBSpan := tr.Start(parentCtx, "createInDB", opts...)
-	err := s.storage.Create(u)
+	err = s.storage.Create(parentDBCtx, u)
 	if err != nil {
 		return fmt.Errorf("failed to create user. error: %w", err)
 	}
 
-	// after get user from storage place him to cache with ttl
-	_, setInCacheSpan := tr.Start(parentDBCtx, "setInCache", opts...)
-	err = s.cache.Set(context.Background(), *u)
+	// the new user shifts list pages and replaces a cached 404, retire both. The user is not
+	// written back, the tombstone keeps out readers that still saw the old state.
+	invalidateCtx, invalidateSpan := tr.Start(parentDBCtx, "invalidateCache", opts...)
+	err = s.cache.Invalidate(invalidateCtx, *u)
 	if err != nil {
 		s.logger.Error(err.Error())
-		setInCacheSpan.End()
 	}
-	s.logger.Debug(fmt.Sprintf("Write to cache user by id: %d", u.Id))
-	setInCacheSpan.End()
+	s.logger.Debug(fmt.Sprintf("Invalidate cache for user id: %d", u.Id))
+	invalidateSpan.End()
+	s.publishInvalidation(parentDBCtx, *u)
 
 	getFromDBSpan.End()
 	return nil
@@ -248,23 +344,30 @@ func (s *service) update(u *User, ctx context.Context) error {
 
 	id := strconv.FormatInt(u.Id, 10)
 
+	hash, err := hashPassword(u.Pass)
+	if err != nil {
+		return fmt.Errorf("failed to hash password. error: %w", err)
+	}
+	u.Pass = hash
+
 	parentDBCtx, updateInDBSpan := tr.Start(parentCtx, "updateInDB", opts...)
-	err := s.storage.Update(u)
+	prev, err := s.storage.Update(parentDBCtx, u)
 
 	if err != nil {
 		return fmt.Errorf("failed to update user. error: %w", err)
 	}
 
-	// after get user from storage place him to cache with ttl
-
-	_, setInCacheSpan := tr.Start(parentDBCtx, "setInCache", opts...)
-	err = s.cache.Set(context.Background(), *u)
+	// tombstone entries under the old and the new nickname and retire list pages. The user is not
+	// written back, a reader that loaded the old row before the update could overwrite it, the next
+	// read after the tombstone expires loads the new row.
+	invalidateCtx, invalidateSpan := tr.Start(parentDBCtx, "invalidateCache", opts...)
+	err = s.cache.Invalidate(invalidateCtx, prev, *u)
 	if err != nil {
 		s.logger.Error(err.Error())
-		setInCacheSpan.End()
 	}
-	s.logger.Debug("Write to cache user by id: " + id)
-	setInCacheSpan.End()
+	s.logger.Debug("Invalidate cache for user id: " + id)
+	invalidateSpan.End()
+	s.publishInvalidation(parentDBCtx, prev, *u)
 
 	updateInDBSpan.End()
 	return nil
@@ -284,32 +387,57 @@ func (s *service) findByNickname(nickname string, ctx context.Context) (u User,
 	defer trace(s.logger, nickname, &cstatus, traceId)()
 	parentCacheCtx, getFromCacheSpan := tr.Start(parentCtx, "getFromCache", opts...)
 	defer getFromCacheSpan.End()
-	u, err = s.cache.Get(context.Background(), nickname)
+	u, fresh, err := s.cache.GetByNickname(parentCacheCtx, nickname)
 	if err == nil {
 		cstatus = "HIT"
+
+		// a stale or soon stale copy is served while a single background refresh replaces it
+		if fresh.Stale() || refreshEarly(fresh, s.nicknameLoad.average()) {
+			if fresh.Stale() {
+				cstatus = "STALE"
+			}
+			s.refreshInBackground(parentCacheCtx, "nickname:"+strings.ToLower(nickname), s.refreshNickname(nickname))
+			return u, nil
+		}
 		// after success get user from cache refresh expire time for him
-		_, setExpireInCache := tr.Start(parentCacheCtx, "setCacheExpiration", opts...)
+		expireCtx, setExpireInCache := tr.Start(parentCacheCtx, "setCacheExpiration", opts...)
 		defer setExpireInCache.End()
-		err := s.cache.Expire(context.Background(), nickname)
+		err := s.cache.ExpireByNickname(expireCtx, nickname)
 		if err != nil {
 			s.logger.Error("Set cache expiration failed for user nickname: " + nickname)
 			s.error(err)
 		}
 		return u, nil
 	}
+	if errors.Is(err, ErrCachedNotFound) {
+		cstatus = "HIT"
+		return User{}, fmt.Errorf("failed to get user by nickname=%s. error: %w", nickname, err)
+	}
 	cstatus = "MISS"
 	s.logger.Debug("Cache miss for user nickname: " + nickname)
 	parentDBCtx, getFromDBSpan := tr.Start(parentCtx, "getFromDB", opts...)
 	defer getFromDBSpan.End()
-	u, err = s.storage.FindOneByNickName(nickname)
+	start := time.Now()
+	u, err = s.storage.FindOneByNickName(parentDBCtx, nickname)
+	if err == nil {
+		s.nicknameLoad.observe(time.Since(start))
+	}
+	if errors.Is(err, ErrNotFound) {
+		// remember the absence so repeated lookups stop at the cache
+		setInCacheCtx, setInCacheSpan := tr.Start(parentDBCtx, "setMissingInCache", opts...)
+		if err := s.cache.SetMissingByNickname(setInCacheCtx, nickname); err != nil {
+			s.logger.Error(err.Error())
+		}
+		setInCacheSpan.End()
+	}
 	if err != nil {
-		return User{}, fmt.Errorf("failed to get user by id=%s. error: %w", nickname, err)
+		return User{}, fmt.Errorf("failed to get user by nickname=%s. error: %w", nickname, err)
 	}
 	// after get user from storage place him to cache with ttl
 
-	_, setInCacheSpan := tr.Start(parentDBCtx, "setInCache", opts...)
+	setInCacheCtx, setInCacheSpan := tr.Start(parentDBCtx, "setInCache", opts...)
 	defer setInCacheSpan.End()
-	err = s.cache.SetByNickname(context.Background(), u)
+	err = s.cache.SetByNickname(setInCacheCtx, u)
 	if err != nil {
 		s.logger.Error(err.Error())
 	}
@@ -318,6 +446,104 @@ func (s *service) findByNickname(nickname string, ctx context.Context) (u User,
 	return u, nil
 }
 
+// Login verifies the stored password hash and opens a new session
+func (s *service) login(nickname, pass string, ctx context.Context) (sess Session, err error) {
+	opts := newTracerOpts()
+
+	tr := s.tracer.Tracer("Service.login")
+	parentCtx, span := tr.Start(ctx, "Login", opts...)
+	defer span.End()
+	cstatus := "NOUSE"
+
+	traceId := span.SpanContext().TraceID().String()
+
+	// log time duration for all operations steps without lock/unlock mutex and init prometheus metrics (clean time for get entity)
+	defer trace(s.logger, "login nickname: "+nickname, &cstatus, traceId)()
+
+	// credentials always come from storage, the cache holds no password hashes
+	getFromDBCtx, getFromDBSpan := tr.Start(parentCtx, "getFromDB", opts...)
+	u, err := s.storage.FindOneByNickName(getFromDBCtx, nickname)
+	getFromDBSpan.End()
+	if errors.Is(err, ErrNotFound) {
+		burnPasswordCheck(pass)
+		return Session{}, Wrap(ErrUnauthorized, errors.New("invalid nickname or password"))
+	}
+	if err != nil {
+		return Session{}, fmt.Errorf("failed to get user by nickname=%s. error: %w", nickname, err)
+	}
+	ok, legacy := checkPassword(u.Pass, pass)
+	if !ok {
+		return Session{}, Wrap(ErrUnauthorized, errors.New("invalid nickname or password"))
+	}
+	if legacy {
+		s.upgradePassword(parentCtx, u, pass)
+	}
+
+	token, err := newSessionToken()
+	if err != nil {
+		return Session{}, fmt.Errorf("failed to generate session token. error: %w", err)
+	}
+	sess = Session{
+		Token:     token,
+		UserId:    u.Id,
+		NickName:  u.NickName,
+		CreatedAt: time.Now(),
+	}
+
+	setInCacheCtx, setInCacheSpan := tr.Start(parentCtx, "createSession", opts...)
+	defer setInCacheSpan.End()
+	if err := s.sessions.CreateSession(setInCacheCtx, &sess); err != nil {
+		return Session{}, fmt.Errorf("failed to create session for user id=%d. error: %w", u.Id, err)
+	}
+	return sess, nil
+}
+
+// Logout drops the session behind token
+func (s *service) logout(token string, ctx context.Context) error {
+	opts := newTracerOpts()
+
+	tr := s.tracer.Tracer("Service.logout")
+	parentCtx, span := tr.Start(ctx, "Logout", opts...)
+	defer span.End()
+
+	delInCacheCtx, delInCacheSpan := tr.Start(parentCtx, "deleteSession", opts...)
+	defer delInCacheSpan.End()
+	if err := s.sessions.DeleteSession(delInCacheCtx, token); err != nil {
+		return fmt.Errorf("failed to delete session. error: %w", err)
+	}
+	return nil
+}
+
+// Resolve session by token and slide its expiration
+func (s *service) resolveSession(token string, ctx context.Context) (sess Session, err error) {
+	opts := newTracerOpts()
+
+	tr := s.tracer.Tracer("Service.resolveSession")
+	parentCtx, span := tr.Start(ctx, "ResolveSession", opts...)
+	defer span.End()
+
+	sess, err = s.sessions.GetSession(parentCtx, token)
+	if errors.Is(err, ErrNotFound) {
+		return Session{}, Wrap(ErrUnauthorized, errors.New("session expired or unknown"))
+	}
+	if err != nil {
+		return Session{}, fmt.Errorf("failed to resolve session. error: %w", err)
+	}
+	return sess, nil
+}
+
+// publishInvalidation tells the other instances to drop their local copies of users,
+// a failure is only logged, their copies expire with the local ttl anyway
+func (s *service) publishInvalidation(ctx context.Context, users ...User) {
+	tr := s.tracer.Tracer("Service.publishInvalidation")
+	pubCtx, span := tr.Start(ctx, "publishInvalidation", newTracerOpts()...)
+	defer span.End()
+
+	if err := s.bus.PublishInvalidation(pubCtx, newInvalidation(users...)); err != nil {
+		s.logger.Error(err.Error())
+	}
+}
+
 func (s *service) error(err error) {
 	sentry.CaptureException(err)
 	// TODO: disable flush migrate to syncHTTPTransport https://docs.sentry.io/platforms/go/guides/http/configuration/transports/
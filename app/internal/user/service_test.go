@@ -0,0 +1,514 @@
+package user
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"redis/pkg/logging"
+)
+
+// fakeStorage keeps users in memory, afterRead runs between reading a row and returning it
+type fakeStorage struct {
+	mu        sync.Mutex
+	users     map[int64]User
+	afterRead func()
+}
+
+func newFakeStorage(users ...User) *fakeStorage {
+	s := &fakeStorage{users: map[int64]User{}}
+	for _, u := range users {
+		s.users[u.Id] = u
+	}
+	return s
+}
+
+func (s *fakeStorage) Create(ctx context.Context, u *User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[u.Id] = *u
+	return nil
+}
+
+func (s *fakeStorage) FindAll(ctx context.Context, limit, offset int64) ([]User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	users := make([]User, 0, len(s.users))
+	for _, u := range s.users {
+		users = append(users, u)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Id < users[j].Id })
+	if offset > int64(len(users)) {
+		offset = int64(len(users))
+	}
+	users = users[offset:]
+	if limit < int64(len(users)) {
+		users = users[:limit]
+	}
+	return users, nil
+}
+
+func (s *fakeStorage) FindOne(ctx context.Context, id string) (User, error) {
+	i, _ := strconv.ParseInt(id, 10, 64)
+	s.mu.Lock()
+	u, ok := s.users[i]
+	s.mu.Unlock()
+	if s.afterRead != nil {
+		s.afterRead()
+	}
+	if !ok {
+		return User{}, ErrNotFound
+	}
+	return u, nil
+}
+
+func (s *fakeStorage) FindOneByNickName(ctx context.Context, nickname string) (User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, u := range s.users {
+		if strings.EqualFold(u.NickName, nickname) {
+			return u, nil
+		}
+	}
+	return User{}, ErrNotFound
+}
+
+func (s *fakeStorage) Update(ctx context.Context, u *User) (User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, ok := s.users[u.Id]
+	if !ok {
+		return User{}, ErrNotFound
+	}
+	s.users[u.Id] = *u
+	return prev, nil
+}
+
+func (s *fakeStorage) Delete(ctx context.Context, id string) (User, error) {
+	i, _ := strconv.ParseInt(id, 10, 64)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, ok := s.users[i]
+	if !ok {
+		return User{}, ErrNotFound
+	}
+	delete(s.users, i)
+	return prev, nil
+}
+
+func (s *fakeStorage) ReplacePassword(ctx context.Context, id int64, oldHash, newHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.users[id]
+	if !ok || u.Pass != oldHash {
+		return ErrConflict
+	}
+	u.Pass = newHash
+	s.users[id] = u
+	return nil
+}
+
+func (s *fakeStorage) PingPool(ctx context.Context) error { return nil }
+func (s *fakeStorage) Close()                             {}
+func (s *fakeStorage) KeepAlive(stop <-chan struct{})     {}
+
+// fakeEntry is a cached user, an absent user or a tombstone left by Invalidate
+type fakeEntry struct {
+	user      User
+	missing   bool
+	tombstone bool
+}
+
+// fakeCache behaves like the redis adapter: Set and SetMissing never replace a tombstone,
+// tombstones live until expireTombstones and pages are keyed by the page generation
+type fakeCache struct {
+	mu      sync.Mutex
+	entries map[string]fakeEntry
+	pages   map[string][]User
+	version int64
+}
+
+func newFakeCache() *fakeCache {
+	return &fakeCache{entries: map[string]fakeEntry{}, pages: map[string][]User{}}
+}
+
+func fakeIdKey(id string) string             { return "id:" + id }
+func fakeNicknameKey(nickname string) string { return "nick:" + strings.ToLower(nickname) }
+func fakePageKey(version, limit, offset int64) string {
+	return strconv.FormatInt(version, 10) + ":" + strconv.FormatInt(limit, 10) + ":" + strconv.FormatInt(offset, 10)
+}
+
+func (c *fakeCache) get(key string) (User, Freshness, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	switch {
+	case !ok || e.tombstone:
+		return User{}, Freshness{}, ErrNotFound
+	case e.missing:
+		return User{}, Freshness{}, ErrCachedNotFound
+	}
+	return e.user, Freshness{FreshFor: time.Hour}, nil
+}
+
+func (c *fakeCache) fill(key string, e fakeEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[key].tombstone {
+		return
+	}
+	c.entries[key] = e
+}
+
+func (c *fakeCache) expireTombstones() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, e := range c.entries {
+		if e.tombstone {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *fakeCache) entry(key string) (fakeEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	return e, ok
+}
+
+func (c *fakeCache) Get(ctx context.Context, id string) (User, Freshness, error) {
+	return c.get(fakeIdKey(id))
+}
+
+func (c *fakeCache) GetByNickname(ctx context.Context, nickname string) (User, Freshness, error) {
+	return c.get(fakeNicknameKey(nickname))
+}
+
+func (c *fakeCache) GetAll(ctx context.Context, version, limit, offset int64) ([]User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	users, ok := c.pages[fakePageKey(version, limit, offset)]
+	if !ok {
+		return []User{}, ErrNotFound
+	}
+	return users, nil
+}
+
+func (c *fakeCache) GetLastKnown(ctx context.Context, id string) (User, error) {
+	return User{}, ErrNotFound
+}
+
+func (c *fakeCache) Set(ctx context.Context, u User) error {
+	u.Pass = ""
+	c.fill(fakeIdKey(strconv.FormatInt(u.Id, 10)), fakeEntry{user: u})
+	return nil
+}
+
+func (c *fakeCache) SetByNickname(ctx context.Context, u User) error {
+	u.Pass = ""
+	c.fill(fakeNicknameKey(u.NickName), fakeEntry{user: u})
+	return nil
+}
+
+func (c *fakeCache) SetAll(ctx context.Context, version, limit, offset int64, val []User) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pages[fakePageKey(version, limit, offset)] = val
+	return nil
+}
+
+func (c *fakeCache) SetMissing(ctx context.Context, id string) error {
+	c.fill(fakeIdKey(id), fakeEntry{missing: true})
+	return nil
+}
+
+func (c *fakeCache) SetMissingByNickname(ctx context.Context, nickname string) error {
+	c.fill(fakeNicknameKey(nickname), fakeEntry{missing: true})
+	return nil
+}
+
+func (c *fakeCache) Del(ctx context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, fakeIdKey(id))
+	return nil
+}
+
+func (c *fakeCache) Expire(ctx context.Context, id string) error                 { return nil }
+func (c *fakeCache) ExpireByNickname(ctx context.Context, nickname string) error { return nil }
+func (c *fakeCache) ExpireAll(ctx context.Context, version, limit, offset int64) error {
+	return nil
+}
+
+func (c *fakeCache) PageVersion(ctx context.Context) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.version, nil
+}
+
+func (c *fakeCache) Invalidate(ctx context.Context, users ...User) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, u := range users {
+		c.entries[fakeIdKey(strconv.FormatInt(u.Id, 10))] = fakeEntry{tombstone: true}
+		if u.NickName != "" {
+			c.entries[fakeNicknameKey(u.NickName)] = fakeEntry{tombstone: true}
+		}
+	}
+	c.version++
+	return nil
+}
+
+func (c *fakeCache) Evict(inv Invalidation)               {}
+func (c *fakeCache) PingClient(ctx context.Context) error { return nil }
+func (c *fakeCache) Close() error                         { return nil }
+func (c *fakeCache) KeepAlive(stop <-chan struct{})       {}
+
+type fakeBus struct{}
+
+func (fakeBus) PublishInvalidation(ctx context.Context, inv Invalidation) error { return nil }
+func (fakeBus) SubscribeInvalidations(ctx context.Context, handle func(Invalidation)) {
+	<-ctx.Done()
+}
+
+func newTestService(t *testing.T, storage Storage, cache Cache) *service {
+	t.Helper()
+	s, err := NewService(storage, cache, nil, fakeBus{}, logging.GetLogger(), tracesdk.NewTracerProvider())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s.(*service)
+}
+
+var alice = User{Id: 1, NickName: "alice", FistName: "Alice", LastName: "Liddell", Gender: "female"}
+
+var bob = User{Id: 2, NickName: "bob", FistName: "Bob", LastName: "Builder", Gender: "male"}
+
+// warm reads alice by id and nickname and the first page so every kind of entry is cached
+func warm(t *testing.T, s *service) {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := s.findOne("1", ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.findByNickname("alice", ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.findAll(10, 0, ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func nicknames(users []User) []string {
+	res := make([]string, len(users))
+	for i, u := range users {
+		res[i] = u.NickName
+	}
+	return res
+}
+
+// checkReads reads twice, the first time from storage behind the tombstones and the second
+// time after they expired, so whatever the reads put back into the cache is checked too
+func checkReads(t *testing.T, c *fakeCache, check func(t *testing.T)) {
+	t.Helper()
+	check(t)
+	c.expireTombstones()
+	check(t)
+	check(t)
+}
+
+func TestUpdateIsVisibleToEveryRead(t *testing.T) {
+	ctx := context.Background()
+	c := newFakeCache()
+	s := newTestService(t, newFakeStorage(alice, bob), c)
+	warm(t, s)
+
+	renamed := alice
+	renamed.NickName = "alicia"
+	renamed.LastName = "Hargreaves"
+	if err := s.update(&renamed, ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	checkReads(t, c, func(t *testing.T) {
+		u, err := s.findOne("1", ctx)
+		if err != nil || u.NickName != "alicia" || u.LastName != "Hargreaves" {
+			t.Errorf("findOne after update = %+v, %v", u, err)
+		}
+		if u, err := s.findByNickname("alice", ctx); !errors.Is(err, ErrNotFound) {
+			t.Errorf("findByNickname of the old nickname = %+v, %v, want not found", u, err)
+		}
+		u, err = s.findByNickname("alicia", ctx)
+		if err != nil || u.Id != 1 || u.LastName != "Hargreaves" {
+			t.Errorf("findByNickname of the new nickname = %+v, %v", u, err)
+		}
+		users, err := s.findAll(10, 0, ctx)
+		if got := strings.Join(nicknames(users), ","); err != nil || got != "alicia,bob" {
+			t.Errorf("findAll after update = %s, %v", got, err)
+		}
+	})
+}
+
+func TestDeleteIsVisibleToEveryRead(t *testing.T) {
+	ctx := context.Background()
+	c := newFakeCache()
+	s := newTestService(t, newFakeStorage(alice, bob), c)
+	warm(t, s)
+
+	if err := s.delete("1", ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	checkReads(t, c, func(t *testing.T) {
+		if u, err := s.findOne("1", ctx); !errors.Is(err, ErrNotFound) {
+			t.Errorf("findOne after delete = %+v, %v, want not found", u, err)
+		}
+		if u, err := s.findByNickname("alice", ctx); !errors.Is(err, ErrNotFound) {
+			t.Errorf("findByNickname after delete = %+v, %v, want not found", u, err)
+		}
+		users, err := s.findAll(10, 0, ctx)
+		if got := strings.Join(nicknames(users), ","); err != nil || got != "bob" {
+			t.Errorf("findAll after delete = %s, %v", got, err)
+		}
+	})
+}
+
+func TestCreateReplacesCachedMissingUser(t *testing.T) {
+	ctx := context.Background()
+	c := newFakeCache()
+	s := newTestService(t, newFakeStorage(bob), c)
+
+	if _, err := s.findOne("1", ctx); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("findOne before create = %v, want not found", err)
+	}
+	if _, err := s.findByNickname("alice", ctx); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("findByNickname before create = %v, want not found", err)
+	}
+
+	created := alice
+	if err := s.create(&created, ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	checkReads(t, c, func(t *testing.T) {
+		if u, err := s.findOne("1", ctx); err != nil || u.NickName != "alice" {
+			t.Errorf("findOne after create = %+v, %v", u, err)
+		}
+		if u, err := s.findByNickname("alice", ctx); err != nil || u.Id != 1 {
+			t.Errorf("findByNickname after create = %+v, %v", u, err)
+		}
+	})
+}
+
+// A read that loaded the row before an update finishes after the update invalidated the cache,
+// its old copy must not land in the cache
+func TestReadBeforeUpdateIsNotWrittenBack(t *testing.T) {
+	ctx := context.Background()
+	storage := newFakeStorage(alice)
+	c := newFakeCache()
+	s := newTestService(t, storage, c)
+
+	read, release := make(chan struct{}), make(chan struct{})
+	var once sync.Once
+	storage.afterRead = func() {
+		once.Do(func() {
+			close(read)
+			<-release
+		})
+	}
+
+	done := make(chan User)
+	go func() {
+		u, err := s.findOne("1", ctx)
+		if err != nil {
+			t.Error(err)
+		}
+		done <- u
+	}()
+
+	<-read
+	renamed := alice
+	renamed.NickName = "alicia"
+	if err := s.update(&renamed, ctx); err != nil {
+		t.Fatal(err)
+	}
+	close(release)
+	if u := <-done; u.NickName != "alice" {
+		t.Fatalf("slow read returned %+v, want the row it read before the update", u)
+	}
+
+	if e, _ := c.entry(fakeIdKey("1")); !e.tombstone {
+		t.Fatalf("cache entry after the slow read = %+v, want the tombstone left by the update", e)
+	}
+	if u, err := s.findOne("1", ctx); err != nil || u.NickName != "alicia" {
+		t.Errorf("findOne after the slow read = %+v, %v", u, err)
+	}
+}
+
+// A legacy hash upgraded at login replaces the password only, a write that committed after the
+// login read keeps its fields and a password changed in between is left alone
+func TestUpgradePasswordKeepsConcurrentUpdate(t *testing.T) {
+	ctx := context.Background()
+	legacy := alice
+	sum := md5.Sum([]byte("secret"))
+	legacy.Pass = hex.EncodeToString(sum[:])
+	storage := newFakeStorage(legacy)
+	s := newTestService(t, storage, newFakeCache())
+
+	read, err := storage.FindOneByNickName(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocked := legacy
+	blocked.Status = 1
+	if _, err := storage.Update(ctx, &blocked); err != nil {
+		t.Fatal(err)
+	}
+	s.upgradePassword(ctx, read, "secret")
+
+	got := storage.users[alice.Id]
+	if got.Status != 1 {
+		t.Errorf("status after the upgrade = %d, want the concurrent write kept", got.Status)
+	}
+	if ok, isLegacy := checkPassword(got.Pass, "secret"); !ok || isLegacy {
+		t.Errorf("password after the upgrade: ok=%v legacy=%v, want a matching bcrypt hash", ok, isLegacy)
+	}
+
+	// an update through the service sets a new password, the upgrade must not undo it
+	storage.users[alice.Id] = legacy
+	renamed := alice
+	renamed.NickName = "alicia"
+	renamed.Pass = "changed"
+	if err := s.update(&renamed, ctx); err != nil {
+		t.Fatal(err)
+	}
+	s.upgradePassword(ctx, read, "secret")
+	got = storage.users[alice.Id]
+	if ok, _ := checkPassword(got.Pass, "changed"); !ok || got.NickName != "alicia" {
+		t.Errorf("upgrade reverted the update made since the login read: %+v", got)
+	}
+}
+
+// A missing user answers with the same detail whether storage or the missing marker says so
+func TestCachedMissingUserHasStorageDetail(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t, newFakeStorage(), newFakeCache())
+
+	_, fromStorage := s.findOne("7", ctx)
+	_, fromCache := s.findOne("7", ctx)
+	if !errors.Is(fromCache, ErrCachedNotFound) {
+		t.Fatalf("second lookup = %v, want the missing marker", fromCache)
+	}
+	if got, want := publicDetail(fromCache), publicDetail(fromStorage); got != want {
+		t.Errorf("detail from the missing marker = %q, want %q as from storage", got, want)
+	}
+}
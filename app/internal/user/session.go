@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+)
+
+const sessionTokenBytes = 32
+
+// Session is an authenticated login resolved from an opaque token
+type Session struct {
+	Token     string
+	UserId    int64
+	NickName  string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// SessionStore keeps sessions with a sliding TTL, every successful Get extends it
+type SessionStore interface {
+	CreateSession(ctx context.Context, s *Session) error
+	GetSession(ctx context.Context, token string) (Session, error)
+	DeleteSession(ctx context.Context, token string) error
+}
+
+type sessionCtxKey struct{}
+
+// SessionFromContext returns the session resolved by SessionMiddleware
+func SessionFromContext(ctx context.Context) (Session, bool) {
+	s, ok := ctx.Value(sessionCtxKey{}).(Session)
+	return s, ok
+}
+
+func contextWithSession(ctx context.Context, s Session) context.Context {
+	return context.WithValue(ctx, sessionCtxKey{}, s)
+}
+
+func newSessionToken() (string, error) {
+	b := make([]byte, sessionTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -3,13 +3,18 @@ package user
 import "context"
 
 type Storage interface {
-	FindOneByNickName(nickname string) (u User, err error)
+	FindOneByNickName(ctx context.Context, nickname string) (u User, err error)
 	PingPool(ctx context.Context) error
 	Close()
-	KeepAlive()
-	Create(u *User) error
-	FindAll(limit, offset int64) (users []User, err error)
-	FindOne(id string) (User, error)
-	Update(u *User) error
-	Delete(id string) error
+	// KeepAlive reconnects in the background until stop is closed
+	KeepAlive(stop <-chan struct{})
+	Create(ctx context.Context, u *User) error
+	FindAll(ctx context.Context, limit, offset int64) (users []User, err error)
+	FindOne(ctx context.Context, id string) (User, error)
+	// Update and Delete return the row as it was before the write so callers can invalidate by old values
+	Update(ctx context.Context, u *User) (prev User, err error)
+	Delete(ctx context.Context, id string) (prev User, err error)
+	// ReplacePassword swaps the hash only while the stored one is still oldHash, leaving the rest
+	// of the row to concurrent updates
+	ReplacePassword(ctx context.Context, id int64, oldHash, newHash string) error
 }
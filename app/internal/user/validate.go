@@ -0,0 +1,76 @@
+package user
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxFieldLength matches the varchar(200) columns of the users table
+const maxFieldLength = 200
+
+const (
+	StatusInactive uint8 = iota
+	StatusActive
+)
+
+var allowedGenders = []string{"Male", "Female"}
+
+var nicknameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// Validate checks a create or update payload and reports every offending field at once,
+// gender is accepted in any case and rewritten to its canonical spelling
+func (u *UserRequest) Validate() error {
+	ve := &ValidationError{}
+
+	requireText(ve, "nickname", u.NickName)
+	if u.NickName != "" && !nicknameRe.MatchString(u.NickName) {
+		ve.Add("nickname", "must start with a letter or digit and contain only letters, digits, '_', '.' or '-'")
+	}
+	requireText(ve, "firstname", u.FistName)
+	requireText(ve, "lastname", u.LastName)
+	switch {
+	case u.Pass == "":
+		ve.Add("pass", "is required")
+	case utf8.RuneCountInString(u.Pass) < minPasswordLength:
+		ve.Add("pass", "must be at least 8 characters")
+	case len(u.Pass) > maxPasswordBytes:
+		ve.Add("pass", "must be at most 72 bytes")
+	}
+
+	if u.Gender == "" {
+		ve.Add("gender", "is required")
+	} else if gender, ok := canonical(allowedGenders, u.Gender); ok {
+		u.Gender = gender
+	} else {
+		ve.Add("gender", "must be one of: "+strings.Join(allowedGenders, ", "))
+	}
+
+	if u.Status != StatusInactive && u.Status != StatusActive {
+		ve.Add("status", "must be 0 (inactive) or 1 (active)")
+	}
+
+	if len(ve.Fields) > 0 {
+		return ve
+	}
+	return nil
+}
+
+func requireText(ve *ValidationError, field, val string) {
+	switch {
+	case strings.TrimSpace(val) == "":
+		ve.Add(field, "is required")
+	case utf8.RuneCountInString(val) > maxFieldLength:
+		ve.Add(field, "must be at most 200 characters")
+	}
+}
+
+// canonical returns the entry of list matching val regardless of case
+func canonical(list []string, val string) (string, bool) {
+	for _, v := range list {
+		if strings.EqualFold(v, val) {
+			return v, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS users (
+    id          serial PRIMARY KEY,
+    nickname    varchar(200) NOT NULL,
+    firstname   varchar(200) NOT NULL,
+    lastname    varchar(200) NOT NULL,
+    gender      varchar(200) NOT NULL,
+    pass        varchar(200) NOT NULL,
+    status      integer NOT NULL
+);
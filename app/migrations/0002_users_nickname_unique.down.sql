@@ -0,0 +1 @@
+DROP INDEX IF EXISTS users_nickname_lower_key;
@@ -0,0 +1,24 @@
+-- refuse to run over case-insensitive duplicates instead of renaming users behind their back,
+-- resolve the listed nicknames by hand and apply the migration again
+DO $$
+DECLARE
+    duplicates text;
+BEGIN
+    SELECT string_agg(nickname, ', ') INTO duplicates
+    FROM (
+        SELECT lower(nickname) AS nickname
+        FROM users
+        GROUP BY lower(nickname)
+        HAVING count(*) > 1
+        ORDER BY 1
+        LIMIT 20
+    ) d;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'users hold case-insensitive duplicate nicknames: %', duplicates
+            USING HINT = 'rename the duplicates, e.g. UPDATE users SET nickname = nickname || ''_'' || id WHERE id = ...';
+    END IF;
+END
+$$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_nickname_lower_key ON users (lower(nickname));
@@ -0,0 +1,9 @@
+package migrations
+
+import "embed"
+
+// FS holds versioned sql migrations shipped inside the binary.
+// File names follow the NNNN_name.up.sql / NNNN_name.down.sql pattern.
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,210 @@
+package breaker
+
+import (
+	"errors"
+	"redis/pkg/env"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+type State int
+
+// State values are exported as they are by the state gauge
+const (
+	Closed State = iota
+	Open
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	}
+	return "closed"
+}
+
+const (
+	defaultFailures         = 5
+	defaultOpenTimeout      = 10 * time.Second
+	defaultHalfOpenRequests = 1
+)
+
+// ErrOpen is returned without calling through while the breaker is open
+// or all half-open probes are in flight
+var ErrOpen = errors.New("circuit breaker open")
+
+var stateGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "redis_cache_example_circuit_breaker_state",
+	Help: "Circuit breaker state by dependency: 0 closed, 1 open, 2 half-open.",
+}, []string{"dependency"})
+
+// breakers holds every breaker by name for States, like the state gauge does
+var breakers sync.Map
+
+type Settings struct {
+	// Name labels the state gauge, e.g. redis or postgresql
+	Name string
+	// Failures in a row that open the breaker, 0 disables it
+	Failures int
+	// OpenTimeout is how long the breaker fails fast before letting probes through
+	OpenTimeout time.Duration
+	// HalfOpenRequests probes have to succeed to close the breaker again
+	HalfOpenRequests int
+	// IsFailure tells outages apart from errors that say nothing about the dependency health
+	IsFailure func(err error) bool
+	// OnStateChange is called outside the breaker lock on every transition
+	OnStateChange func(from, to State)
+}
+
+// SettingsFromEnv reads <prefix>_BREAKER_FAILURES and <prefix>_BREAKER_HALF_OPEN_REQUESTS as
+// integers and <prefix>_BREAKER_OPEN_TIMEOUT as a go duration
+func SettingsFromEnv(prefix string) Settings {
+	return Settings{
+		Failures:         env.Int(prefix+"_BREAKER_FAILURES", defaultFailures),
+		OpenTimeout:      env.Duration(prefix+"_BREAKER_OPEN_TIMEOUT", defaultOpenTimeout),
+		HalfOpenRequests: env.Int(prefix+"_BREAKER_HALF_OPEN_REQUESTS", defaultHalfOpenRequests),
+	}
+}
+
+// Breaker fails fast after Failures consecutive failures, lets HalfOpenRequests probes through
+// once OpenTimeout passed and closes when all of them succeed
+type Breaker struct {
+	settings Settings
+
+	mu        sync.Mutex
+	state     State
+	failures  int
+	probes    int
+	successes int
+	openedAt  time.Time
+	// generation changes on every transition, results of calls started before are ignored
+	generation uint64
+}
+
+func New(s Settings) *Breaker {
+	if s.HalfOpenRequests <= 0 {
+		s.HalfOpenRequests = defaultHalfOpenRequests
+	}
+	if s.IsFailure == nil {
+		s.IsFailure = func(err error) bool { return err != nil }
+	}
+	stateGauge.WithLabelValues(s.Name).Set(float64(Closed))
+	b := &Breaker{settings: s}
+	breakers.Store(s.Name, b)
+	return b
+}
+
+// Outages returns an IsFailure that counts errors matching any of kinds. Everything else,
+// not found entities, conflicts and canceled requests, says nothing about the dependency health.
+func Outages(kinds ...error) func(err error) bool {
+	return func(err error) bool {
+		for _, kind := range kinds {
+			if errors.Is(err, kind) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// States returns the current state of every breaker by name
+func States() map[string]State {
+	states := map[string]State{}
+	breakers.Range(func(name, b interface{}) bool {
+		states[name.(string)] = b.(*Breaker).State()
+		return true
+	})
+	return states
+}
+
+// Do runs fn unless the breaker is open and records its outcome
+func (b *Breaker) Do(fn func() error) error {
+	generation, err := b.allow()
+	if err != nil {
+		return err
+	}
+	err = fn()
+	b.done(generation, b.settings.IsFailure(err))
+	return err
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+func (b *Breaker) allow() (uint64, error) {
+	b.mu.Lock()
+	from := b.state
+	if b.state == Open && time.Since(b.openedAt) >= b.settings.OpenTimeout {
+		b.setState(HalfOpen)
+	}
+	switch b.state {
+	case Open:
+		b.mu.Unlock()
+		return 0, ErrOpen
+	case HalfOpen:
+		if b.probes >= b.settings.HalfOpenRequests {
+			b.mu.Unlock()
+			b.notify(from, HalfOpen)
+			return 0, ErrOpen
+		}
+		b.probes++
+	}
+	generation, to := b.generation, b.state
+	b.mu.Unlock()
+
+	b.notify(from, to)
+	return generation, nil
+}
+
+func (b *Breaker) done(generation uint64, failed bool) {
+	b.mu.Lock()
+	if generation != b.generation {
+		b.mu.Unlock()
+		return
+	}
+	from := b.state
+	switch {
+	case failed && b.state == HalfOpen:
+		b.setState(Open)
+	case failed:
+		if b.failures++; b.failures >= b.settings.Failures {
+			b.setState(Open)
+		}
+	case b.state == HalfOpen:
+		if b.successes++; b.successes >= b.settings.HalfOpenRequests {
+			b.setState(Closed)
+		}
+	default:
+		b.failures = 0
+	}
+	to := b.state
+	b.mu.Unlock()
+
+	b.notify(from, to)
+}
+
+// setState must be called with mu held
+func (b *Breaker) setState(s State) {
+	b.state = s
+	b.generation++
+	b.failures, b.probes, b.successes = 0, 0, 0
+	if s == Open {
+		b.openedAt = time.Now()
+	}
+	stateGauge.WithLabelValues(b.settings.Name).Set(float64(s))
+}
+
+func (b *Breaker) notify(from, to State) {
+	if from != to && b.settings.OnStateChange != nil {
+		b.settings.OnStateChange(from, to)
+	}
+}
@@ -0,0 +1,49 @@
+// Package env reads optional settings from environment variables,
+// an unset or invalid value yields the default
+package env
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// Duration reads key as a positive go duration such as 250ms or 1m
+func Duration(key string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil && d > 0 {
+		return d
+	}
+	return def
+}
+
+// DurationOrZero reads key like Duration but also accepts 0 to switch a feature off
+func DurationOrZero(key string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil && d >= 0 {
+		return d
+	}
+	return def
+}
+
+// Int reads key as a non-negative integer
+func Int(key string, def int) int {
+	if i, err := strconv.Atoi(os.Getenv(key)); err == nil && i >= 0 {
+		return i
+	}
+	return def
+}
+
+// Float reads key as a non-negative float
+func Float(key string, def float64) float64 {
+	if f, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil && f >= 0 {
+		return f
+	}
+	return def
+}
+
+// Bool reads key as anything strconv.ParseBool accepts, e.g. true, false, 1 or 0
+func Bool(key string, def bool) bool {
+	if b, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return b
+	}
+	return def
+}
@@ -0,0 +1,27 @@
+package monitoring
+
+import (
+	"net/http"
+
+	"github.com/heptiolabs/healthcheck"
+)
+
+const degradedHeader = "X-Health-Status"
+
+type degradedHandler struct {
+	healthcheck.Handler
+	degraded func() error
+}
+
+// WithDegraded marks readiness responses with "X-Health-Status: degraded" while degraded
+// returns an error, the status code still comes from the readiness checks of hc
+func WithDegraded(hc healthcheck.Handler, degraded func() error) healthcheck.Handler {
+	return &degradedHandler{Handler: hc, degraded: degraded}
+}
+
+func (h *degradedHandler) ReadyEndpoint(w http.ResponseWriter, r *http.Request) {
+	if h.degraded() != nil {
+		w.Header().Set(degradedHeader, "degraded")
+	}
+	h.Handler.ReadyEndpoint(w, r)
+}
@@ -0,0 +1,49 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/heptiolabs/healthcheck"
+)
+
+type reportHandler struct {
+	healthcheck.Handler
+	reports func() map[string]string
+}
+
+// WithReports lists the entries returned by reports next to the readiness checks of hc in
+// the /ready?full=1 body, such as circuit breaker states. They never change the status code,
+// an open breaker the instance can serve without shows up without failing readiness.
+func WithReports(hc healthcheck.Handler, reports func() map[string]string) healthcheck.Handler {
+	return &reportHandler{Handler: hc, reports: reports}
+}
+
+func (h *reportHandler) ReadyEndpoint(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("full") != "1" {
+		h.Handler.ReadyEndpoint(w, r)
+		return
+	}
+
+	rec := httptest.NewRecorder()
+	h.Handler.ReadyEndpoint(rec, r)
+	for key, values := range rec.Header() {
+		w.Header()[key] = values
+	}
+
+	results := map[string]string{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &results); err != nil {
+		// not a check listing, e.g. a method not allowed reply
+		w.WriteHeader(rec.Code)
+		_, _ = w.Write(rec.Body.Bytes())
+		return
+	}
+	for name, report := range h.reports() {
+		results[name] = report
+	}
+	w.WriteHeader(rec.Code)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "    ")
+	_ = encoder.Encode(results)
+}
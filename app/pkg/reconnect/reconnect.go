@@ -0,0 +1,164 @@
+package reconnect
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"redis/pkg/logging"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	minBackoff  = 500 * time.Millisecond
+	maxBackoff  = 30 * time.Second
+	pingTimeout = 5 * time.Second
+	dialTimeout = 10 * time.Second
+)
+
+var (
+	errNoConnection = errors.New("no connection")
+	errClosed       = errors.New("connection manager closed")
+)
+
+var attemptsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "redis_cache_example_reconnect_attempts_total",
+	Help: "Reconnect attempts by dependency and result.",
+}, []string{"dependency", "result"})
+
+// Options tell a Manager how to handle the connection it keeps, conn values are passed around as
+// interface{} and asserted back to the client type by the adapter
+type Options struct {
+	// Name labels logs and metrics, e.g. redis or postgresql
+	Name string
+	// Period between health checks while the connection is healthy
+	Period time.Duration
+	Dial   func(ctx context.Context) (interface{}, error)
+	Ping   func(ctx context.Context, conn interface{}) error
+	Close  func(conn interface{})
+}
+
+// holder lets atomic.Value store clients of different concrete types and no client at all
+type holder struct {
+	conn interface{}
+}
+
+// Manager owns a connection that request goroutines read while Run replaces it in the background.
+// A replaced connection is closed once the new one is in place.
+type Manager struct {
+	opts   Options
+	logger *logging.Logger
+	conn   atomic.Value
+
+	// mu serialises writers, readers only Load
+	mu     sync.Mutex
+	closed bool
+}
+
+// New manages conn, which is nil when the first dial failed
+func New(conn interface{}, opts Options, logger *logging.Logger) *Manager {
+	m := &Manager{opts: opts, logger: logger}
+	m.conn.Store(holder{conn: conn})
+	return m
+}
+
+// Load returns the current connection, nil while none is established
+func (m *Manager) Load() interface{} {
+	return m.conn.Load().(holder).conn
+}
+
+// Run checks the connection every Period and redials with exponential backoff once a check fails,
+// it returns when stop is closed
+func (m *Manager) Run(stop <-chan struct{}) {
+	wait := m.opts.Period
+	if m.Load() == nil {
+		// down since boot, do not wait a whole period for the first attempt
+		wait = minBackoff
+	}
+	backoff := minBackoff
+	attempt := 0
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(wait):
+		}
+
+		if err := m.check(); err == nil {
+			wait, backoff, attempt = m.opts.Period, minBackoff, 0
+			continue
+		}
+
+		attempt++
+		m.logger.Info("Reconnect to "+m.opts.Name+"...", m.logger.Int("attempt", attempt))
+		if err := m.redial(); err != nil {
+			attemptsTotal.WithLabelValues(m.opts.Name, "failure").Inc()
+			wait = withJitter(backoff)
+			m.logger.Info("Reconnect to "+m.opts.Name+" failed: "+err.Error(),
+				m.logger.Int("attempt", attempt), m.logger.Duration("retry_in", wait))
+			if backoff *= 2; backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+			continue
+		}
+		attemptsTotal.WithLabelValues(m.opts.Name, "success").Inc()
+		m.logger.Info("Reconnected to "+m.opts.Name, m.logger.Int("attempt", attempt))
+		wait, backoff, attempt = m.opts.Period, minBackoff, 0
+	}
+}
+
+// Close closes the current connection, a redial still in flight is closed as soon as it completes
+func (m *Manager) Close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.closed = true
+	if conn := m.Load(); conn != nil {
+		m.opts.Close(conn)
+	}
+}
+
+func (m *Manager) check() error {
+	conn := m.Load()
+	if conn == nil {
+		return errNoConnection
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+	return m.opts.Ping(ctx, conn)
+}
+
+// redial swaps in a new connection and closes the replaced one
+func (m *Manager) redial() error {
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
+	conn, err := m.opts.Dial(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		m.opts.Close(conn)
+		return errClosed
+	}
+	prev := m.Load()
+	m.conn.Store(holder{conn: conn})
+	m.mu.Unlock()
+
+	// requests still holding prev finish or fail on it, new ones get conn
+	if prev != nil {
+		m.opts.Close(prev)
+	}
+	return nil
+}
+
+// withJitter spreads retries of many instances over [d/2, d)
+func withJitter(d time.Duration) time.Duration {
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
@@ -0,0 +1,181 @@
+package reconnect
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"redis/pkg/logging"
+)
+
+type fakeConn struct {
+	closed int32
+}
+
+// fakeDialer hands out fakeConns and remembers them to count how often each was closed
+type fakeDialer struct {
+	mu    sync.Mutex
+	conns []*fakeConn
+	fail  bool
+}
+
+func (d *fakeDialer) options() Options {
+	return Options{
+		Name:   "fake",
+		Period: time.Hour,
+		Dial: func(ctx context.Context) (interface{}, error) {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+			if d.fail {
+				return nil, errors.New("connection refused")
+			}
+			conn := &fakeConn{}
+			d.conns = append(d.conns, conn)
+			return conn, nil
+		},
+		Ping: func(ctx context.Context, conn interface{}) error {
+			return errors.New("ping failed")
+		},
+		Close: func(conn interface{}) {
+			atomic.AddInt32(&conn.(*fakeConn).closed, 1)
+		},
+	}
+}
+
+func (d *fakeDialer) dialed() []*fakeConn {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]*fakeConn(nil), d.conns...)
+}
+
+func newTestManager(t *testing.T, d *fakeDialer) *Manager {
+	t.Helper()
+	first, err := d.options().Dial(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := logging.GetLogger()
+	return New(first, d.options(), &logger)
+}
+
+func TestLoadDuringRedialAndClose(t *testing.T) {
+	d := &fakeDialer{}
+	m := newTestManager(t, d)
+
+	stop := make(chan struct{})
+	var readers sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if _, ok := m.Load().(*fakeConn); !ok {
+					t.Error("Load returned no connection while one was established")
+					return
+				}
+			}
+		}()
+	}
+
+	var redials sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		redials.Add(1)
+		go func() {
+			defer redials.Done()
+			for j := 0; j < 50; j++ {
+				if err := m.redial(); err != nil && !errors.Is(err, errClosed) {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	m.Close()
+	redials.Wait()
+	close(stop)
+	readers.Wait()
+
+	// every connection is closed exactly once: replaced ones by redial, the current one by
+	// Close and the ones dialed after Close by redial again
+	for i, conn := range d.dialed() {
+		if closed := atomic.LoadInt32(&conn.closed); closed != 1 {
+			t.Errorf("connection %d closed %d times, want 1", i, closed)
+		}
+	}
+	if err := m.redial(); !errors.Is(err, errClosed) {
+		t.Errorf("redial after Close = %v, want %v", err, errClosed)
+	}
+}
+
+func TestRedialClosesReplacedConnection(t *testing.T) {
+	d := &fakeDialer{}
+	m := newTestManager(t, d)
+	prev := m.Load().(*fakeConn)
+
+	if err := m.redial(); err != nil {
+		t.Fatal(err)
+	}
+	if m.Load() == prev {
+		t.Fatal("redial kept the previous connection")
+	}
+	if closed := atomic.LoadInt32(&prev.closed); closed != 1 {
+		t.Errorf("replaced connection closed %d times, want 1", closed)
+	}
+
+	// a failed dial keeps the current connection
+	d.fail = true
+	current := m.Load()
+	if err := m.redial(); err == nil {
+		t.Fatal("redial succeeded with a failing dialer")
+	}
+	if m.Load() != current {
+		t.Error("a failed redial replaced the connection")
+	}
+}
+
+func TestRunReturnsWhenStopCloses(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		conn   bool
+		period time.Duration
+	}{
+		{name: "waiting for the next check", conn: true, period: time.Hour},
+		{name: "backing off after failed redials", conn: true, period: time.Millisecond},
+		{name: "down since boot", conn: false, period: time.Hour},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var conn interface{}
+			if tc.conn {
+				conn = &fakeConn{}
+			}
+			// every check fails and so does every redial
+			opts := (&fakeDialer{fail: true}).options()
+			opts.Period = tc.period
+			logger := logging.GetLogger()
+			m := New(conn, opts, &logger)
+
+			stop := make(chan struct{})
+			done := make(chan struct{})
+			go func() {
+				m.Run(stop)
+				close(done)
+			}()
+			time.Sleep(20 * time.Millisecond)
+			close(stop)
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("Run did not return after stop was closed")
+			}
+		})
+	}
+}
@@ -8,6 +8,10 @@ CREATE TABLE IF NOT EXISTS users (
     status      integer NOT NULL
     );
 
+-- nicknames are unique regardless of case, same index as migration 0002
+CREATE UNIQUE INDEX IF NOT EXISTS users_nickname_lower_key ON users (lower(nickname));
+
+-- the sample data repeats nicknames, repeated ones carry the row id as suffix so ids stay 1..5339
 INSERT INTO
     users (nickname, firstname, lastname, gender, pass, status)
 VALUES
@@ -87,7 +91,7 @@ VALUES
     ( 'mark5', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
     ( 'jenny0994', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
     ( 'morris53', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
-    ( 'paul68', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
+    ( 'paul68_77', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
     ( 'brooks86', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
     ( 'james54', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
     ( 'rogers58', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
@@ -126,7 +130,7 @@ VALUES
     ( 'james65', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
     ( 'john98', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
     ( 'paul80', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
-    ( 'john92', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
+    ( 'john92_116', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
     ( 'wright69', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
     ( 'bell68', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
     ( 'brooks97', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
@@ -141,8 +145,8 @@ VALUES
     ( 'david59', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
     ( 'morris73', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
     ( 'michael77', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
-    ( 'mark5', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
-    ( 'james65', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
+    ( 'mark5_131', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
+    ( 'james65_132', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
     ( 'maria39', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
     ( 'michael37', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
     ( 'sanders1', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
@@ -211,7 +215,7 @@ VALUES
     ( 'rogers80', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
     ( 'wright81', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
     ( 'michael21', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
-    ( 'daniel56', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
+    ( 'daniel56_201', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
     ( 'ross3', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
     ( 'chrishaydon72', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
     ( 'sanders66', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
@@ -225,7 +229,7 @@ VALUES
     ( 'wright91', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
     ( 'jenny0922', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
     ( 'rivera35', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
-    ( 'rogers53', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
+    ( 'rogers53_215', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
     ( 'ross50', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
     ( 'ross41', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
     ( 'rivera78', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
@@ -236,7 +240,7 @@ VALUES
     ( 'maria62', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
     ( 'mark85', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
     ( 'wright3', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
-    ( 'brooks37', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
+    ( 'brooks37_226', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
     ( 'paul66', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
     ( 'rivera36', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
     ( 'paul42', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
@@ -250,7 +254,7 @@ VALUES
     ( 'morris63', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
     ( 'michael25', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
     ( 'smith89', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
-    ( 'john97', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
+    ( 'john97_240', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
     ( 'jenny0985', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
     ( 'wright84', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
     ( 'maria53', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
@@ -262,12 +266,12 @@ VALUES
     ( 'chrishaydon100', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
     ( 'mark97', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
     ( 'brooks91', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
-    ( 'jenny0929', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
+    ( 'jenny0929_252', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
     ( 'morgan37', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
     ( 'daniel43', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
     ( 'mike98', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
     ( 'john48', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
-    ( 'brooks65', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
+    ( 'brooks65_257', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
     ( 'cooper18', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
     ( 'chrishaydon54', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
     ( 'john67', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
@@ -301,22 +305,22 @@ VALUES
     ( 'morgan20', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
     ( 'miller29', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
     ( 'ross56', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
-    ( 'chrishaydon63', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
+    ( 'chrishaydon63_291', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
     ( 'morris54', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
     ( 'bell20', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
-    ( 'paul41', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
+    ( 'paul41_294', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
     ( 'morgan42', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
     ( 'ross100', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
     ( 'wright1', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
     ( 'jenny0921', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
     ( 'paul37', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
-    ( 'michael92', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
+    ( 'michael92_300', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
     ( 'chrishaydon14', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
     ( 'miller40', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
     ( 'wright43', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
     ( 'smith15', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
     ( 'miller49', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
-    ( 'morris21', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
+    ( 'morris21_306', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
     ( 'ross27', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
     ( 'rivera16', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
     ( 'maria73', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
@@ -326,13 +330,13 @@ VALUES
     ( 'paul27', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
     ( 'jenny0911', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
     ( 'miller79', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
-    ( 'mark14', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
+    ( 'mark14_316', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
     ( 'ross87', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
     ( 'paul47', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
     ( 'sanders61', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
     ( 'rivera13', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
     ( 'chrishaydon22', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
-    ( 'sanders42', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
+    ( 'sanders42_322', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
     ( 'david78', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
     ( 'ross60', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
     ( 'morris24', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
@@ -348,11 +352,11 @@ VALUES
     ( 'david33', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
     ( 'morris65', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
     ( 'michael23', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
-    ( 'john96', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
+    ( 'john96_338', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
     ( 'wright64', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
     ( 'david91', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
     ( 'daniel87', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
-    ( 'ross60', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
+    ( 'ross60_342', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
     ( 'morris60', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
     ( 'rivera50', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
     ( 'maria77', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
@@ -364,15 +368,15 @@ VALUES
     ( 'brooks95', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
     ( 'sanders92', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
     ( 'cooper53', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
-    ( 'ross100', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
+    ( 'ross100_354', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
     ( 'michael91', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
     ( 'brooks51', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
     ( 'ross26', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
     ( 'morris58', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
-    ( 'morris58', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
+    ( 'morris58_359', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
     ( 'ross12', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
     ( 'brooks60', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
-    ( 'mark29', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
+    ( 'mark29_362', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
     ( 'john14', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
     ( 'rivera26', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
     ( 'jenny0949', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
@@ -392,16 +396,16 @@ VALUES
     ( 'miller10', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
     ( 'jenny0939', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
     ( 'paul100', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
-    ( 'smith82', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
+    ( 'smith82_382', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
     ( 'morris6', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
     ( 'paul76', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
-    ( 'mark97', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
+    ( 'mark97_385', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
     ( 'john74', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
     ( 'miller78', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
     ( 'miller54', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
     ( 'chrishaydon9', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
     ( 'daniel4', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
-    ( 'john24', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
+    ( 'john24_391', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
     ( 'smith31', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
     ( 'smith77', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
     ( 'maria29', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
@@ -429,71 +433,71 @@ VALUES
     ( 'rogers22', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
     ( 'jenny0963', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
     ( 'mark42', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
-    ( 'mark7', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
+    ( 'mark7_419', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
     ( 'john1', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
-    ( 'chrishaydon3', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
+    ( 'chrishaydon3_421', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
     ( 'smith11', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
     ( 'john22', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
     ( 'rivera23', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
     ( 'james32', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
     ( 'daniel58', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
-    ( 'james18', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
+    ( 'james18_427', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
     ( 'brown93', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
     ( 'bell89', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
     ( 'miller82', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
     ( 'paul21', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
     ( 'chrishaydon88', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
     ( 'rivera44', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
-    ( 'bell89', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
+    ( 'bell89_434', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
     ( 'bell66', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
     ( 'rogers31', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
     ( 'bell47', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
     ( 'sanders58', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
     ( 'mark26', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
     ( 'miller22', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
-    ( 'daniel46', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
+    ( 'daniel46_441', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
     ( 'morgan100', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
     ( 'ross75', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
     ( 'david68', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
-    ( 'rivera35', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
+    ( 'rivera35_445', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
     ( 'daniel63', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
     ( 'ross37', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
-    ( 'michael23', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
-    ( 'mike48', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
-    ( 'david57', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
+    ( 'michael23_448', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
+    ( 'mike48_449', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
+    ( 'david57_450', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
     ( 'morris67', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
     ( 'rogers49', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
-    ( 'morris14', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
+    ( 'morris14_453', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
     ( 'brooks74', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
     ( 'mike71', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
     ( 'mark18', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
     ( 'mark9', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
     ( 'brooks21', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
-    ( 'bell4', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
+    ( 'bell4_459', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
     ( 'brown17', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
     ( 'jenny0923', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
-    ( 'smith66', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
-    ( 'daniel53', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
+    ( 'smith66_462', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
+    ( 'daniel53_463', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
     ( 'brown33', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
     ( 'mark55', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
     ( 'rivera83', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
     ( 'paul72', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
     ( 'david25', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
-    ( 'paul47', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
+    ( 'paul47_469', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
     ( 'james61', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
     ( 'paul90', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
     ( 'david95', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
     ( 'paul52', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
     ( 'wright82', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
-    ( 'james61', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
+    ( 'james61_475', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
     ( 'michael89', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
     ( 'wright44', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
-    ( 'chrishaydon60', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
-    ( 'john30', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
+    ( 'chrishaydon60_478', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
+    ( 'john30_479', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
     ( 'rivera2', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
     ( 'wright17', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
     ( 'rivera30', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
-    ( 'daniel63', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
+    ( 'daniel63_483', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
     ( 'brooks56', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
     ( 'wright29', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
     ( 'morris52', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
@@ -502,8 +506,8 @@ VALUES
     ( 'mike9', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
     ( 'michael69', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
     ( 'david18', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
-    ( 'daniel41', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
-    ( 'david59', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
+    ( 'daniel41_492', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
+    ( 'david59_493', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
     ( 'daniel17', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
     ( 'john45', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
     ( 'morgan17', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
@@ -511,11 +515,11 @@ VALUES
     ( 'rogers50', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
     ( 'smith35', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
     ( 'mark56', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
-    ( 'chrishaydon12', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
+    ( 'chrishaydon12_501', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
     ( 'mark90', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
-    ( 'maria85', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
+    ( 'maria85_503', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
     ( 'rogers42', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
-    ( 'brooks95', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
+    ( 'brooks95_505', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
     ( 'brooks38', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
     ( 'morris49', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
     ( 'david86', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
@@ -526,7 +530,7 @@ VALUES
     ( 'cooper68', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
     ( 'paul87', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
     ( 'brooks59', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
-    ( 'rivera36', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
+    ( 'rivera36_516', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
     ( 'cooper25', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
     ( 'mike3', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
     ( 'rivera24', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
@@ -534,9 +538,9 @@ VALUES
     ( 'cooper12', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
     ( 'paul32', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
     ( 'wright11', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
-    ( 'maria51', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
+    ( 'maria51_524', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
     ( 'morris85', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
-    ( 'chrishaydon88', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
+    ( 'chrishaydon88_526', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
     ( 'brown49', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
     ( 'mike55', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
     ( 'paul6', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
@@ -555,7 +559,7 @@ VALUES
     ( 'brown2', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
     ( 'maria94', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
     ( 'smith95', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
-    ( 'ross3', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
+    ( 'ross3_545', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
     ( 'smith24', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
     ( 'john19', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
     ( 'michael93', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
@@ -566,8 +570,8 @@ VALUES
     ( 'smith12', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
     ( 'maria61', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
     ( 'daniel3', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
-    ( 'jenny0923', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
-    ( 'morris5', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
+    ( 'jenny0923_556', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
+    ( 'morris5_557', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
     ( 'ross58', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
     ( 'michael48', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
     ( 'brown92', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
@@ -575,49 +579,49 @@ VALUES
     ( 'sanders87', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
     ( 'paul54', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
     ( 'cooper59', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
-    ( 'brooks16', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
-    ( 'rivera44', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
+    ( 'brooks16_565', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
+    ( 'rivera44_566', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
     ( 'sanders60', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
     ( 'morgan82', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
     ( 'john69', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
     ( 'david36', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
     ( 'mike88', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
-    ( 'david9', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
+    ( 'david9_572', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
     ( 'david34', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
     ( 'sanders20', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
     ( 'mike22', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
     ( 'maria63', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
     ( 'miller94', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
     ( 'chrishaydon17', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
-    ( 'brooks60', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
+    ( 'brooks60_579', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
     ( 'bell34', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
     ( 'chrishaydon65', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
     ( 'paul70', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
     ( 'jenny0972', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
-    ( 'john41', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
+    ( 'john41_584', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
     ( 'smith78', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
     ( 'morgan55', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
     ( 'smith81', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
-    ( 'jenny0988', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
+    ( 'jenny0988_588', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
     ( 'chrishaydon49', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
     ( 'morris31', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
     ( 'mike15', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
     ( 'smith61', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
     ( 'chrishaydon42', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
     ( 'john62', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
-    ( 'maria40', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
-    ( 'james50', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
+    ( 'maria40_595', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
+    ( 'james50_596', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
     ( 'wright56', 'ross', 'chrishaydon', 'Male', '279910d191730d428742ff2b010c30be', 1),
-    ( 'brown93', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
-    ( 'maria85', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
+    ( 'brown93_598', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
+    ( 'maria85_599', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
     ( 'miller63', 'morgan', 'morris', 'Female', 'ee33d4596af639660986c67f04e6ff92', 1),
     ( 'smith50', 'brown', 'brown', 'Female', 'bd1e4b23c510e1eae5fa69baa618f3e2', 1),
     ( 'mike83', 'smith', 'cooper', 'Female', '4a3309bacad462ce5af197a808839446', 1),
-    ( 'mark90', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
+    ( 'mark90_603', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
     ( 'morris71', 'rogers', 'sanders', 'Male', 'a7fd9a391ed17cc9be339bcb87af3451', 1),
-    ( 'brown70', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
+    ( 'brown70_605', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
     ( 'jenny0930', 'bell', 'rivera', 'Female', 'c25f2f612dde293ec2155a5dfe7901f2', 1),
-    ( 'smith60', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
+    ( 'smith60_607', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
     ( 'cooper56', 'ross', 'mike', 'Female', '81b32a932116fed6d09aaa2c2bc522df', 1),
     ( 'chrishaydon67', 'wright', 'mark', 'Female', 'a4808f35e9eca8fd90326ad5632ff220', 1),
     ( 'cooper90', 'ross', 'rivera', 'Male', '077380568f1971f1003978046142baeb', 1),
@@ -625,71 +629,71 @@ VALUES
     ( 'morgan3', 'sanders', 'mark', 'Male', '4d5a20ef5496dae22ea2bbfa40defc12', 1),
     ( 'daniel25', 'michael', 'ross', 'Female', '6794c36a5638c0c0df58155493512e60', 1),
     ( 'rivera64', 'morgan', 'mike', 'Female', '041dcc147d542af1dc6bf50192c1b10f', 1),
-    ( 'bell41', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
+    ( 'bell41_615', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
     ( 'rivera34', 'bell', 'paul', 'Male', '7d4a65a26f424093d93857b14104585e', 1),
-    ( 'chrishaydon22', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
-    ( 'miller53', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
+    ( 'chrishaydon22_617', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
+    ( 'miller53_618', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
     ( 'miller58', 'sanders', 'paul', 'Male', '3fa66dc95e8f6e524cd5afbf4abfebdf', 1),
     ( 'wright41', 'smith', 'sanders', 'Female', '7ba55de0a483cd485e1629176c9fa5ac', 1),
-    ( 'john69', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
+    ( 'john69_621', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
     ( 'smith6', 'smith', 'paul', 'Female', '69b7b0f8981d3f462bddcd10e83d73b8', 1),
     ( 'rogers88', 'miller', 'rivera', 'Male', '18b2b0e6b66ce69949cfd500bdbd547b', 1),
-    ( 'john98', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
+    ( 'john98_624', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
     ( 'paul69', 'john', 'morgan', 'Male', '98bdeac0dbd9377cd342ab3e2ed67002', 1),
     ( 'morris55', 'wright', 'brown', 'Male', 'e808e39b0369890ca613a24d1bdbe80e', 1),
-    ( 'paul3', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
+    ( 'paul3_627', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
     ( 'rogers28', 'brown', 'brown', 'Male', 'cb1b08d86f6053ce02919717671bd81f', 1),
     ( 'brown16', 'james', 'chrishaydon', 'Female', 'ef70b7e590eb6ef3d09bdb05084610e4', 1),
-    ( 'david68', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
+    ( 'david68_630', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
     ( 'michael8', 'paul', 'morgan', 'Female', '6691fbe0b3d4d260b0ada3cd9c0b78f5', 1),
     ( 'sanders67', 'brooks', 'paul', 'Male', 'ddf8e1b6ed97cdf30cb35d0f9e47517b', 1),
     ( 'rivera75', 'brooks', 'brooks', 'Male', 'fb77723ac6a75b2dfa7636213fc12803', 1),
     ( 'miller81', 'ross', 'david', 'Female', 'e5e1fa5d4c971a980e6d087016507eb7', 1),
-    ( 'jenny0952', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
+    ( 'jenny0952_635', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
     ( 'rivera53', 'bell', 'smith', 'Female', '1e3e64c56121038797a7e50b655464c6', 1),
     ( 'morgan33', 'wright', 'brown', 'Male', '8e5a808681a0070beaf455c2cabb782b', 1),
-    ( 'ross27', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
-    ( 'miller94', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
+    ( 'ross27_638', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
+    ( 'miller94_639', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
     ( 'michael14', 'paul', 'sanders', 'Female', '9462cebb50643d2a7731b89c5b1b8fe3', 1),
     ( 'rogers60', 'mark', 'smith', 'Male', 'bef7a008c13dcc9dd7947e5f248a1cfd', 1),
     ( 'maria50', 'rivera', 'miller', 'Male', '64b7a989ccd1e83d881215cddbeafb72', 1),
-    ( 'morgan96', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
+    ( 'morgan96_643', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
     ( 'ross49', 'sanders', 'jenny09', 'Female', '53083ad87812f62ccd391f01069e77b5', 1),
     ( 'wright37', 'miller', 'chrishaydon', 'Male', '0f8b225730e5be91f0f4cbb29156cf44', 1),
     ( 'brown38', 'ross', 'morgan', 'Male', '07a841d9d548265d67ac8897f60f1b06', 1),
     ( 'michael50', 'mike', 'rogers', 'Female', 'a366cae9b72e785998b0ce78a2284bdb', 1),
-    ( 'wright93', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
+    ( 'wright93_648', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
     ( 'miller91', 'mike', 'chrishaydon', 'Male', 'edb8e845ed0dc8c888f7225fa43eb2a6', 1),
     ( 'daniel21', 'cooper', 'sanders', 'Female', 'df3e462d93781ea422c125a4c2b5566a', 1),
     ( 'bell44', 'ross', 'smith', 'Male', 'a49601f3e6a85c0387539bba4bcdbe6c', 1),
     ( 'brooks58', 'ross', 'john', 'Male', '6e5b2a0375b4d4d500ee07e62cad6d6c', 1),
     ( 'chrishaydon86', 'sanders', 'david', 'Female', 'c3bf2214f10104ec078bed756539f9af', 1),
     ( 'jenny0910', 'paul', 'smith', 'Male', '58eea070ea61891bfea5954c38cb9336', 1),
-    ( 'rivera53', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
+    ( 'rivera53_655', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
     ( 'brown75', 'sanders', 'rivera', 'Female', '7c5cef8a2e387abe6908ee2f3fbc534a', 1),
-    ( 'maria40', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
+    ( 'maria40_657', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
     ( 'john12', 'sanders', 'sanders', 'Male', 'cba0f422fbd342f81feb1dd78a43cad6', 1),
     ( 'sanders88', 'paul', 'miller', 'Male', 'b6153be014b1af1811c85aa770c4cc46', 1),
     ( 'morris98', 'morgan', 'brooks', 'Female', 'd793df005919fa5c4f45a61a7f9f8e87', 1),
     ( 'miller17', 'daniel', 'brown', 'Female', 'a11bf4bd7d2f8c8162d97c7447ab07f8', 1),
     ( 'cooper84', 'miller', 'miller', 'Male', '941752ab63605763d87142453aa35988', 1),
-    ( 'ross49', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
-    ( 'morgan54', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
+    ( 'ross49_663', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
+    ( 'morgan54_664', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
     ( 'chrishaydon29', 'wright', 'cooper', 'Female', 'b6ed3804fd6ad8ccd1c85c0a7deda4e3', 1),
-    ( 'paul3', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
-    ( 'morris98', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
+    ( 'paul3_666', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
+    ( 'morris98_667', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
     ( 'cooper55', 'mark', 'ross', 'Female', '6c794b04248c5d6120dd84b8e94967a7', 1),
     ( 'rivera85', 'brown', 'brooks', 'Female', '0ca4d10e4c787cc061e18feb815f747b', 1),
     ( 'rogers43', 'jenny09', 'mike', 'Female', 'aa98ad5a318401147dc9580a9e134a7e', 1),
     ( 'ross42', 'mark', 'michael', 'Female', '1507a0565d69991ff963cd3489b1c491', 1),
-    ( 'james29', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
-    ( 'chrishaydon20', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
-    ( 'wright68', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
+    ( 'james29_672', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
+    ( 'chrishaydon20_673', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
+    ( 'wright68_674', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
     ( 'cooper47', 'ross', 'paul', 'Male', '524f2968fabb85f0fdb2fa63110f6690', 1),
     ( 'morgan73', 'chrishaydon', 'cooper', 'Male', '33b7c4b03c90ac30be52d86c3229577b', 1),
     ( 'maria82', 'daniel', 'daniel', 'Male', '9a83d41058fafd2a50b9fcce02811361', 1),
-    ( 'john8', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
-    ( 'miller63', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
+    ( 'john8_678', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
+    ( 'miller63_679', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
     ( 'morgan26', 'michael', 'rogers', 'Male', 'e4a653c83b7fa702099b4c780e5fa68d', 1),
     ( 'rivera69', 'brown', 'david', 'Female', 'b58472e08b58b46875487093c2867ec6', 1),
     ( 'daniel31', 'john', 'rogers', 'Male', '9d84f6219123fb1920cf2e7494a44d77', 1),
@@ -698,19 +702,19 @@ VALUES
     ( 'smith56', 'david', 'paul', 'Male', 'baaf6963e059f92921d196b5fef6669d', 1),
     ( 'morris93', 'chrishaydon', 'james', 'Female', 'dba13ee70b381ea5b9ceac0ece3d850f', 1),
     ( 'michael45', 'michael', 'paul', 'Female', 'd9f0344646b6ba7f224d9d3a0986cc7e', 1),
-    ( 'mike88', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
-    ( 'morgan96', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
+    ( 'mike88_688', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
+    ( 'morgan96_689', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
     ( 'james5', 'brooks', 'mike', 'Female', 'ac332ec30f36d30d5bf9221fe1b32bf0', 1),
     ( 'morris83', 'sanders', 'maria', 'Male', 'fcb81feea910ea24117d4f26171991f8', 1),
     ( 'rivera19', 'rivera', 'bell', 'Female', '0ba1e8782ffcf3e71c079e1e54c0a41c', 1),
     ( 'daniel57', 'paul', 'bell', 'Female', '62d04b1633be93a4b4f93256a56e28d0', 1),
     ( 'maria10', 'wright', 'sanders', 'Female', '9c8c23c03a74a7a320d742aa95cf08e3', 1),
     ( 'david71', 'jenny09', 'david', 'Male', '16a061f8fdf5c93e9e77631dfb7d471a', 1),
-    ( 'sanders88', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
+    ( 'sanders88_696', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
     ( 'rogers37', 'chrishaydon', 'brown', 'Male', '822141a673303a54b9387d8fc6a0c425', 1),
     ( 'chrishaydon10', 'chrishaydon', 'john', 'Male', '1b7d631cf551c86b671fd6bc2ec9d0dc', 1),
     ( 'sanders27', 'bell', 'morris', 'Male', '576352444376fe3069d256ded463666f', 1),
-    ( 'brown17', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
+    ( 'brown17_700', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
     ( 'morris86', 'rogers', 'ross', 'Male', '896b690b9566f53a875e03a3a324c091', 1),
     ( 'mark79', 'bell', 'sanders', 'Male', 'cee8dbd9130bccbe4d945c9cfc41f330', 1),
     ( 'david58', 'david', 'morgan', 'Female', 'ca391efb9f114371dced52c8eb18a412', 1),
@@ -722,22 +726,22 @@ VALUES
     ( 'chrishaydon43', 'miller', 'chrishaydon', 'Female', 'e63ca65668b82c9fd07eef6b719ad4aa', 1),
     ( 'rogers19', 'mike', 'john', 'Male', '59843bfb2ca080a23bcbbc5278fa6982', 1),
     ( 'brooks29', 'rivera', 'rivera', 'Female', 'f151014abd62636e598dbad4a5b43aa3', 1),
-    ( 'chrishaydon74', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
+    ( 'chrishaydon74_712', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
     ( 'brooks43', 'morgan', 'cooper', 'Male', '669e54b4368a4a99595fe4a56b9bf6ff', 1),
     ( 'ross44', 'morgan', 'smith', 'Female', 'a84cf2d984ed2eb7948b9fda85275a7f', 1),
     ( 'bell63', 'chrishaydon', 'james', 'Male', '863d1292a4a36cdc9134a7f84e8070c9', 1),
     ( 'rivera41', 'maria', 'mark', 'Male', 'a9dd9815cf33560650fc661a97f41547', 1),
-    ( 'wright17', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
+    ( 'wright17_717', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
     ( 'michael15', 'david', 'mark', 'Female', 'a167a5d9cf8157d98d45c1d0e32b72f4', 1),
     ( 'ross53', 'morris', 'daniel', 'Female', 'b4a6baa51bd47cf407213f6ba9254f71', 1),
     ( 'cooper65', 'brooks', 'mark', 'Female', '26f039d32dad1e54e8f74b015ea9ec68', 1),
-    ( 'rivera2', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
-    ( 'james32', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
-    ( 'mark97', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
-    ( 'smith93', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
+    ( 'rivera2_721', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
+    ( 'james32_722', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
+    ( 'mark97_723', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
+    ( 'smith93_724', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
     ( 'chrishaydon84', 'james', 'brown', 'Male', '5957038b6a3f0a0f305123af998bc437', 1),
     ( 'smith30', 'maria', 'wright', 'Male', '62d191a19bda44ba011ebd37976b5a23', 1),
-    ( 'maria51', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
+    ( 'maria51_727', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
     ( 'rivera52', 'cooper', 'michael', 'Male', 'c15a7058e0c14b26f6863fbd7549a1e7', 1),
     ( 'chrishaydon48', 'david', 'cooper', 'Female', 'e51d84e0afa82e704755b26fe59f566e', 1),
     ( 'morgan90', 'mike', 'maria', 'Female', 'e78f1e49da6ebf2fe756ec38b646ba36', 1),
@@ -746,8 +750,8 @@ VALUES
     ( 'daniel100', 'paul', 'cooper', 'Male', '1ca7a74ac2257ad3c0f5885cb90099f2', 1),
     ( 'wright13', 'brown', 'mark', 'Male', '35e1bd4961d74f12ed2e2399f219aaa9', 1),
     ( 'morgan86', 'brown', 'daniel', 'Female', 'ba7d8e424270cfe9f48eb09c99ff68a1', 1),
-    ( 'wright17', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
-    ( 'morris93', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
+    ( 'wright17_736', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
+    ( 'morris93_737', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
     ( 'james40', 'rogers', 'rogers', 'Male', 'b44bc9d82406f9a445889a66b255f271', 1),
     ( 'ross47', 'sanders', 'chrishaydon', 'Male', '4592e91917d68abf8835aeae27f38cfc', 1),
     ( 'wright8', 'bell', 'cooper', 'Male', 'e30161a98a0e9e3933dbb856e6af4719', 1),
@@ -757,4601 +761,4602 @@ VALUES
     ( 'smith28', 'brown', 'john', 'Female', '2e3a6218c8c4652563470fd23b5c9004', 1),
     ( 'morris2', 'bell', 'jenny09', 'Male', '9248ee5ee8d1ff997d0009348e2ea662', 1),
     ( 'morris4', 'rogers', 'brooks', 'Male', 'd9290c1d84c18de491112fab16fc0172', 1),
-    ( 'jenny0926', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
+    ( 'jenny0926_747', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
     ( 'morgan21', 'john', 'cooper', 'Male', 'c1c1328643f85dca460e8ead1600c650', 1),
     ( 'david3', 'michael', 'miller', 'Male', '6c5c00ec2728ed2459e821dce4a85349', 1),
-    ( 'ross58', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
-    ( 'jenny0949', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
-    ( 'john96', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
+    ( 'ross58_750', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
+    ( 'jenny0949_751', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
+    ( 'john96_752', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
     ( 'paul5', 'rivera', 'ross', 'Male', '13a3756c2cb7b486e32054a08271d3f1', 1),
     ( 'sanders80', 'michael', 'james', 'Female', '73f63edc5f6f3b571886fbb1316c43bc', 1),
     ( 'smith65', 'brooks', 'bell', 'Male', '7ba86a4101f3074f458df9a6e43e3f5a', 1),
-    ( 'rogers26', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
+    ( 'rogers26_756', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
     ( 'maria43', 'miller', 'bell', 'Male', '0c879f0908f637ebe8cc0db994ae0832', 1),
     ( 'david99', 'maria', 'jenny09', 'Female', 'b38a8c25edada7483420c20a76b9d4b0', 1),
     ( 'wright20', 'smith', 'rogers', 'Female', 'e11d269be9b74106223b357b06f2da33', 1),
-    ( 'brooks87', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
+    ( 'brooks87_760', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
     ( 'mike43', 'morgan', 'ross', 'Male', '024142778439c88b675219780cf1c578', 1),
     ( 'ross30', 'mark', 'david', 'Male', 'c5fb92d8af4e45581d8bb7c71a5c0a1c', 1),
     ( 'jenny0981', 'morgan', 'brooks', 'Female', '34ae1e72198b2ccb37610a3269368fa7', 1),
     ( 'cooper11', 'james', 'james', 'Female', '243cffae81569cca39840aec1e9e2852', 1),
-    ( 'rogers97', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
-    ( 'wright84', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
-    ( 'jenny0932', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
+    ( 'rogers97_765', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
+    ( 'wright84_766', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
+    ( 'jenny0932_767', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
     ( 'sanders40', 'paul', 'sanders', 'Female', 'be92492fa64d6126726a71584a75a878', 1),
     ( 'john80', 'daniel', 'ross', 'Female', '8a608ab5ea3865ae688ac2f8b1cc3b61', 1),
-    ( 'brooks58', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
+    ( 'brooks58_770', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
     ( 'miller37', 'brooks', 'bell', 'Female', '62c1ace1a0aa8e798169f547586457c8', 1),
     ( 'james93', 'chrishaydon', 'james', 'Male', '7ef00ef6e7f47472db040222dae2dc8e', 1),
     ( 'bell16', 'bell', 'james', 'Male', '0d5e1a6eab996a7d1d32a0e2ed22ff2a', 1),
     ( 'smith96', 'mike', 'bell', 'Female', '68f2fb17fd3f13d8a0a394de396ce447', 1),
     ( 'ross94', 'david', 'bell', 'Male', '84c62bc5b6f1dab11a1ca3d8d7a5e29e', 1),
-    ( 'ross95', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
+    ( 'ross95_776', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
     ( 'chrishaydon82', 'jenny09', 'maria', 'Female', '8093f823794b8fa03c379c035300fd0b', 1),
     ( 'michael3', 'mike', 'jenny09', 'Male', 'fc13f6e30b38a8529a57add8a59b5ec0', 1),
     ( 'jenny0918', 'rogers', 'brown', 'Male', '8b9e34ac36a2fd947614e2616f3d6826', 1),
-    ( 'rogers43', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
+    ( 'rogers43_780', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
     ( 'morris91', 'paul', 'rivera', 'Male', '613be5cffa8a578c1816a1baf6bb8501', 1),
     ( 'john4', 'james', 'rivera', 'Female', '76ebe07cd3c27e769f71251f294d4c94', 1),
     ( 'cooper5', 'wright', 'morris', 'Male', '8105c71bb32ba7bf731c4162ff9c1f21', 1),
-    ( 'chrishaydon20', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
+    ( 'chrishaydon20_784', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
     ( 'chrishaydon44', 'mark', 'smith', 'Female', 'a54db1865fb0fa3a66861d93e037741d', 1),
     ( 'daniel13', 'brooks', 'brown', 'Female', '91270e97491a918496e6569c9fcff808', 1),
     ( 'chrishaydon37', 'brown', 'mark', 'Male', 'a3efe2fadf9e33423b80532af0f95c33', 1),
     ( 'david31', 'wright', 'paul', 'Female', 'b8e0691f01b08a810fade049028d9c3e', 1),
-    ( 'mark21', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
-    ( 'wright81', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
+    ( 'mark21_789', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
+    ( 'wright81_790', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
     ( 'michael13', 'morris', 'cooper', 'Female', '9d450e0529ca7328d07679163206f732', 1),
-    ( 'rogers63', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
-    ( 'mike28', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
-    ( 'rivera92', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
-    ( 'ross95', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
-    ( 'paul85', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
-    ( 'smith34', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
-    ( 'james84', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
-    ( 'daniel53', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
-    ( 'brooks80', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
-    ( 'morgan65', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
-    ( 'sanders84', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
-    ( 'maria40', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
-    ( 'brown71', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
-    ( 'james63', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
-    ( 'jenny0993', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
-    ( 'john96', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
-    ( 'miller64', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
-    ( 'mark46', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
-    ( 'jenny0988', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
-    ( 'mark80', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
-    ( 'morris72', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
-    ( 'wright39', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
-    ( 'paul68', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
-    ( 'smith60', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
-    ( 'bell43', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
-    ( 'rogers79', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
-    ( 'daniel56', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
-    ( 'brooks85', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
-    ( 'mike30', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
-    ( 'paul92', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
-    ( 'bell96', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
-    ( 'john8', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
-    ( 'chrishaydon12', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
-    ( 'morgan13', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
-    ( 'james83', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
-    ( 'chrishaydon8', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
-    ( 'ross85', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
-    ( 'ross46', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
-    ( 'smith4', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
-    ( 'paul4', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
-    ( 'daniel26', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
-    ( 'chrishaydon2', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
-    ( 'david82', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
-    ( 'john97', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
-    ( 'david57', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
-    ( 'rivera100', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
-    ( 'bell13', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
-    ( 'brooks65', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
-    ( 'daniel40', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
-    ( 'cooper100', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
-    ( 'morris14', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
-    ( 'smith82', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
-    ( 'cooper35', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
-    ( 'morgan94', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
-    ( 'michael92', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
-    ( 'sanders48', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
-    ( 'brown76', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
-    ( 'james16', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
-    ( 'michael26', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
-    ( 'wright57', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
-    ( 'wright68', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
-    ( 'brooks1', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
-    ( 'bell2', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
-    ( 'miller100', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
-    ( 'rogers53', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
-    ( 'mike1', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
-    ( 'cooper57', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
-    ( 'daniel38', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
-    ( 'mark2', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
-    ( 'daniel79', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
-    ( 'wright4', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
-    ( 'brown84', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
-    ( 'paul41', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
-    ( 'mark5', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
-    ( 'jenny0994', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
-    ( 'morris53', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
-    ( 'paul68', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
-    ( 'brooks86', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
-    ( 'james54', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
-    ( 'rogers58', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
-    ( 'maria31', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
-    ( 'david5', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
-    ( 'mark21', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
-    ( 'jenny0932', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
-    ( 'john92', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
-    ( 'rogers98', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
-    ( 'rogers95', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
-    ( 'james50', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
-    ( 'miller80', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
-    ( 'mark29', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
-    ( 'cooper77', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
-    ( 'john24', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
-    ( 'chrishaydon32', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
-    ( 'bell41', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
-    ( 'ross99', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
-    ( 'smith9', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
-    ( 'miller73', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
-    ( 'michael44', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
-    ( 'michael36', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
-    ( 'smith93', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
-    ( 'morgan38', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
-    ( 'brown70', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
-    ( 'mike14', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
-    ( 'daniel72', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
-    ( 'jenny0974', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
-    ( 'maria51', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
-    ( 'michael97', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
-    ( 'chrishaydon33', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
-    ( 'sanders42', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
-    ( 'brooks87', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
-    ( 'bell4', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
-    ( 'jenny0945', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
-    ( 'james65', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
-    ( 'john98', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
-    ( 'paul80', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
-    ( 'john92', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
-    ( 'wright69', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
-    ( 'bell68', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
-    ( 'brooks97', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
-    ( 'mark14', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
-    ( 'david60', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
-    ( 'rivera68', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
-    ( 'brooks32', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
-    ( 'brown15', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
-    ( 'bell88', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
-    ( 'john26', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
-    ( 'paul55', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
-    ( 'david59', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
-    ( 'morris73', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
-    ( 'michael77', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
-    ( 'mark5', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
-    ( 'james65', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
-    ( 'maria39', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
-    ( 'michael37', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
-    ( 'sanders1', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
-    ( 'daniel30', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
-    ( 'brown53', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
-    ( 'john52', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
-    ( 'wright73', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
-    ( 'mark63', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
-    ( 'james29', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
-    ( 'mark23', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
-    ( 'cooper22', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
-    ( 'miller19', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
-    ( 'jenny0913', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
-    ( 'maria100', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
-    ( 'chrishaydon51', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
-    ( 'david9', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
-    ( 'mike52', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
-    ( 'wright5', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
-    ( 'brown21', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
-    ( 'daniel46', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
-    ( 'brown86', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
-    ( 'chrishaydon85', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
-    ( 'john41', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
-    ( 'mark72', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
-    ( 'michael67', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
-    ( 'jenny0943', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
-    ( 'daniel61', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
-    ( 'morgan34', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
-    ( 'jenny097', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
-    ( 'miller11', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
-    ( 'daniel99', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
-    ( 'miller20', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
-    ( 'sanders29', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
-    ( 'mark43', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
-    ( 'james86', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
-    ( 'chrishaydon74', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
-    ( 'brooks37', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
-    ( 'michael78', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
-    ( 'paul9', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
-    ( 'james57', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
-    ( 'mark91', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
-    ( 'wright30', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
-    ( 'rogers97', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
-    ( 'daniel8', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
-    ( 'mark66', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
-    ( 'daniel6', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
-    ( 'bell72', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
-    ( 'morgan77', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
-    ( 'bell24', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
-    ( 'michael31', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
-    ( 'cooper98', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
-    ( 'daniel41', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
-    ( 'michael1', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
-    ( 'chrishaydon20', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
-    ( 'daniel66', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
-    ( 'cooper52', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
-    ( 'chrishaydon99', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
-    ( 'smith66', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
-    ( 'mike48', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
-    ( 'rivera80', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
-    ( 'morgan35', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
-    ( 'brooks16', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
-    ( 'mike78', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
-    ( 'michael38', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
-    ( 'mark7', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
-    ( 'rogers80', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
-    ( 'wright81', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
-    ( 'michael21', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
-    ( 'daniel56', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
-    ( 'ross3', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
-    ( 'chrishaydon72', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
-    ( 'sanders66', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
-    ( 'michael4', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
-    ( 'brown66', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
-    ( 'wright26', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
-    ( 'james81', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
-    ( 'morris21', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
-    ( 'john30', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
-    ( 'jenny0929', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
-    ( 'wright91', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
-    ( 'jenny0922', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
-    ( 'rivera35', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
-    ( 'rogers53', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
-    ( 'ross50', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
-    ( 'ross41', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
-    ( 'rivera78', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
-    ( 'miller43', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
-    ( 'morgan59', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
-    ( 'chrishaydon63', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
-    ( 'wright93', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
-    ( 'maria62', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
-    ( 'mark85', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
-    ( 'wright3', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
-    ( 'brooks37', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
-    ( 'paul66', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
-    ( 'rivera36', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
-    ( 'paul42', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
-    ( 'morgan93', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
-    ( 'rivera43', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
-    ( 'daniel71', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
-    ( 'mike81', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
-    ( 'daniel83', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
-    ( 'smith54', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
-    ( 'sanders38', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
-    ( 'morris63', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
-    ( 'michael25', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
-    ( 'smith89', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
-    ( 'john97', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
-    ( 'jenny0985', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
-    ( 'wright84', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
-    ( 'maria53', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
-    ( 'brooks72', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
-    ( 'brown61', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
-    ( 'paul95', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
-    ( 'brooks92', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
-    ( 'james44', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
-    ( 'chrishaydon100', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
-    ( 'mark97', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
-    ( 'brooks91', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
-    ( 'jenny0929', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
-    ( 'morgan37', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
-    ( 'daniel43', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
-    ( 'mike98', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
-    ( 'john48', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
-    ( 'brooks65', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
-    ( 'cooper18', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
-    ( 'chrishaydon54', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
-    ( 'john67', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
-    ( 'morris27', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
-    ( 'ross45', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
-    ( 'rogers85', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
-    ( 'sanders96', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
-    ( 'smith70', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
-    ( 'wright31', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
-    ( 'paul10', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
-    ( 'sanders2', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
-    ( 'rogers14', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
-    ( 'morgan79', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
-    ( 'morris87', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
-    ( 'james6', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
-    ( 'wright33', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
-    ( 'maria85', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
-    ( 'ross31', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
-    ( 'chrishaydon75', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
-    ( 'david54', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
-    ( 'chrishaydon50', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
-    ( 'miller24', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
-    ( 'maria9', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
-    ( 'smith91', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
-    ( 'rivera11', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
-    ( 'james72', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
-    ( 'miller36', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
-    ( 'jenny0991', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
-    ( 'jenny0959', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
-    ( 'mike41', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
-    ( 'morgan20', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
-    ( 'miller29', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
-    ( 'ross56', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
-    ( 'chrishaydon63', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
-    ( 'morris54', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
-    ( 'bell20', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
-    ( 'paul41', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
-    ( 'morgan42', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
-    ( 'ross100', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
-    ( 'wright1', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
-    ( 'jenny0921', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
-    ( 'paul37', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
-    ( 'michael92', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
-    ( 'chrishaydon14', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
-    ( 'miller40', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
-    ( 'wright43', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
-    ( 'smith15', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
-    ( 'miller49', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
-    ( 'morris21', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
-    ( 'ross27', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
-    ( 'rivera16', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
-    ( 'maria73', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
-    ( 'chrishaydon60', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
-    ( 'david40', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
-    ( 'michael66', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
-    ( 'paul27', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
-    ( 'jenny0911', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
-    ( 'miller79', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
-    ( 'mark14', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
-    ( 'ross87', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
-    ( 'paul47', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
-    ( 'sanders61', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
-    ( 'rivera13', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
-    ( 'chrishaydon22', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
-    ( 'sanders42', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
-    ( 'david78', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
-    ( 'ross60', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
-    ( 'morris24', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
-    ( 'jenny0952', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
-    ( 'rogers72', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
-    ( 'daniel70', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
-    ( 'cooper76', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
-    ( 'jenny0942', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
-    ( 'daniel39', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
-    ( 'morris59', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
-    ( 'mike70', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
-    ( 'rogers20', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
-    ( 'david33', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
-    ( 'morris65', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
-    ( 'michael23', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
-    ( 'john96', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
-    ( 'wright64', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
-    ( 'david91', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
-    ( 'daniel87', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
-    ( 'ross60', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
-    ( 'morris60', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
-    ( 'rivera50', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
-    ( 'maria77', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
-    ( 'morgan64', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
-    ( 'miller41', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
-    ( 'david65', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
-    ( 'morgan96', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
-    ( 'ross73', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
-    ( 'brooks95', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
-    ( 'sanders92', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
-    ( 'cooper53', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
-    ( 'ross100', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
-    ( 'michael91', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
-    ( 'brooks51', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
-    ( 'ross26', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
-    ( 'morris58', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
-    ( 'morris58', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
-    ( 'ross12', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
-    ( 'brooks60', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
-    ( 'mark29', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
-    ( 'john14', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
-    ( 'rivera26', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
-    ( 'jenny0949', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
-    ( 'rogers96', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
-    ( 'david55', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
-    ( 'chrishaydon3', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
-    ( 'ross81', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
-    ( 'john70', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
-    ( 'miller34', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
-    ( 'bell26', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
-    ( 'ross92', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
-    ( 'rogers34', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
-    ( 'james51', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
-    ( 'ross54', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
-    ( 'brown59', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
-    ( 'michael83', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
-    ( 'miller10', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
-    ( 'jenny0939', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
-    ( 'paul100', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
-    ( 'smith82', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
-    ( 'morris6', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
-    ( 'paul76', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
-    ( 'mark97', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
-    ( 'john74', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
-    ( 'miller78', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
-    ( 'miller54', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
-    ( 'chrishaydon9', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
-    ( 'daniel4', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
-    ( 'john24', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
-    ( 'smith31', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
-    ( 'smith77', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
-    ( 'maria29', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
-    ( 'michael76', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
-    ( 'mark64', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
-    ( 'morris5', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
-    ( 'brown69', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
-    ( 'miller53', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
-    ( 'wright94', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
-    ( 'rogers26', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
-    ( 'jenny0977', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
-    ( 'daniel23', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
-    ( 'cooper66', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
-    ( 'chrishaydon61', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
-    ( 'maria95', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
-    ( 'james17', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
-    ( 'james18', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
-    ( 'mike47', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
-    ( 'john57', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
-    ( 'mark54', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
-    ( 'bell38', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
-    ( 'morgan31', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
-    ( 'miller16', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
-    ( 'rivera14', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
-    ( 'rogers22', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
-    ( 'jenny0963', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
-    ( 'mark42', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
-    ( 'mark7', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
-    ( 'john1', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
-    ( 'chrishaydon3', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
-    ( 'smith11', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
-    ( 'john22', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
-    ( 'rivera23', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
-    ( 'james32', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
-    ( 'daniel58', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
-    ( 'james18', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
-    ( 'brown93', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
-    ( 'bell89', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
-    ( 'miller82', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
-    ( 'paul21', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
-    ( 'chrishaydon88', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
-    ( 'rivera44', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
-    ( 'bell89', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
-    ( 'bell66', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
-    ( 'rogers31', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
-    ( 'bell47', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
-    ( 'sanders58', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
-    ( 'mark26', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
-    ( 'miller22', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
-    ( 'daniel46', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
-    ( 'morgan100', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
-    ( 'ross75', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
-    ( 'david68', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
-    ( 'rivera35', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
-    ( 'daniel63', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
-    ( 'ross37', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
-    ( 'michael23', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
-    ( 'mike48', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
-    ( 'david57', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
-    ( 'morris67', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
-    ( 'rogers49', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
-    ( 'morris14', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
-    ( 'brooks74', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
-    ( 'mike71', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
-    ( 'mark18', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
-    ( 'mark9', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
-    ( 'brooks21', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
-    ( 'bell4', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
-    ( 'brown17', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
-    ( 'jenny0923', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
-    ( 'smith66', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
-    ( 'daniel53', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
-    ( 'brown33', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
-    ( 'mark55', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
-    ( 'rivera83', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
-    ( 'paul72', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
-    ( 'david25', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
-    ( 'paul47', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
-    ( 'james61', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
-    ( 'paul90', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
-    ( 'david95', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
-    ( 'paul52', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
-    ( 'wright82', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
-    ( 'james61', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
-    ( 'michael89', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
-    ( 'wright44', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
-    ( 'chrishaydon60', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
-    ( 'john30', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
-    ( 'rivera2', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
-    ( 'wright17', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
-    ( 'rivera30', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
-    ( 'daniel63', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
-    ( 'brooks56', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
-    ( 'wright29', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
-    ( 'morris52', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
-    ( 'morgan10', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
-    ( 'sanders55', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
-    ( 'mike9', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
-    ( 'michael69', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
-    ( 'david18', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
-    ( 'daniel41', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
-    ( 'david59', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
-    ( 'daniel17', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
-    ( 'john45', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
-    ( 'morgan17', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
-    ( 'john42', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
-    ( 'rogers50', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
-    ( 'smith35', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
-    ( 'mark56', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
-    ( 'chrishaydon12', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
-    ( 'mark90', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
-    ( 'maria85', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
-    ( 'rogers42', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
-    ( 'brooks95', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
-    ( 'brooks38', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
-    ( 'morris49', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
-    ( 'david86', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
-    ( 'wright15', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
-    ( 'wright75', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
-    ( 'sanders78', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
-    ( 'jenny092', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
-    ( 'cooper68', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
-    ( 'paul87', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
-    ( 'brooks59', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
-    ( 'rivera36', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
-    ( 'cooper25', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
-    ( 'mike3', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
-    ( 'rivera24', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
-    ( 'wright89', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
-    ( 'cooper12', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
-    ( 'paul32', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
-    ( 'wright11', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
-    ( 'maria51', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
-    ( 'morris85', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
-    ( 'chrishaydon88', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
-    ( 'brown49', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
-    ( 'mike55', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
-    ( 'paul6', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
-    ( 'james19', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
-    ( 'brown35', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
-    ( 'chrishaydon15', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
-    ( 'jenny0962', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
-    ( 'brooks57', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
-    ( 'chrishaydon7', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
-    ( 'morgan15', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
-    ( 'chrishaydon47', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
-    ( 'brooks41', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
-    ( 'mark61', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
-    ( 'brooks82', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
-    ( 'maria6', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
-    ( 'brown2', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
-    ( 'maria94', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
-    ( 'smith95', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
-    ( 'ross3', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
-    ( 'smith24', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
-    ( 'john19', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
-    ( 'michael93', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
-    ( 'bell35', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
-    ( 'david80', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
-    ( 'paul3', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
-    ( 'michael27', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
-    ( 'smith12', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
-    ( 'maria61', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
-    ( 'daniel3', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
-    ( 'jenny0923', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
-    ( 'morris5', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
-    ( 'ross58', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
-    ( 'michael48', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
-    ( 'brown92', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
-    ( 'morgan54', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
-    ( 'sanders87', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
-    ( 'paul54', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
-    ( 'cooper59', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
-    ( 'brooks16', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
-    ( 'rivera44', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
-    ( 'sanders60', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
-    ( 'morgan82', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
-    ( 'john69', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
-    ( 'david36', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
-    ( 'mike88', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
-    ( 'david9', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
-    ( 'david34', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
-    ( 'sanders20', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
-    ( 'mike22', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
-    ( 'maria63', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
-    ( 'miller94', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
-    ( 'chrishaydon17', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
-    ( 'brooks60', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
-    ( 'bell34', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
-    ( 'chrishaydon65', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
-    ( 'paul70', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
-    ( 'jenny0972', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
-    ( 'john41', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
-    ( 'smith78', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
-    ( 'morgan55', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
-    ( 'smith81', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
-    ( 'jenny0988', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
-    ( 'chrishaydon49', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
-    ( 'morris31', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
-    ( 'mike15', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
-    ( 'smith61', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
-    ( 'chrishaydon42', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
-    ( 'john62', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
-    ( 'maria40', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
-    ( 'james50', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
-    ( 'ross63', 'morgan', 'mike', 'Female', '70d70c6d340b463fc4b3fde8d133de6d', 1),
-    ( 'brown93', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
-    ( 'maria85', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
-    ( 'miller63', 'morgan', 'morris', 'Female', 'ee33d4596af639660986c67f04e6ff92', 1),
-    ( 'smith50', 'brown', 'brown', 'Female', 'bd1e4b23c510e1eae5fa69baa618f3e2', 1),
-    ( 'mike83', 'smith', 'cooper', 'Female', '4a3309bacad462ce5af197a808839446', 1),
-    ( 'mark90', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
-    ( 'morris71', 'rogers', 'sanders', 'Male', 'a7fd9a391ed17cc9be339bcb87af3451', 1),
-    ( 'brown70', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
-    ( 'jenny0930', 'bell', 'rivera', 'Female', 'c25f2f612dde293ec2155a5dfe7901f2', 1),
-    ( 'smith60', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
-    ( 'cooper56', 'ross', 'mike', 'Female', '81b32a932116fed6d09aaa2c2bc522df', 1),
-    ( 'chrishaydon67', 'wright', 'mark', 'Female', 'a4808f35e9eca8fd90326ad5632ff220', 1),
-    ( 'cooper90', 'ross', 'rivera', 'Male', '077380568f1971f1003978046142baeb', 1),
-    ( 'morris82', 'ross', 'miller', 'Female', '8d863a559ac2d9fba0dfa98649e55637', 1),
-    ( 'morgan3', 'sanders', 'mark', 'Male', '4d5a20ef5496dae22ea2bbfa40defc12', 1),
-    ( 'daniel25', 'michael', 'ross', 'Female', '6794c36a5638c0c0df58155493512e60', 1),
-    ( 'rivera64', 'morgan', 'mike', 'Female', '041dcc147d542af1dc6bf50192c1b10f', 1),
-    ( 'bell41', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
-    ( 'rivera34', 'bell', 'paul', 'Male', '7d4a65a26f424093d93857b14104585e', 1),
-    ( 'chrishaydon22', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
-    ( 'miller53', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
-    ( 'miller58', 'sanders', 'paul', 'Male', '3fa66dc95e8f6e524cd5afbf4abfebdf', 1),
-    ( 'wright41', 'smith', 'sanders', 'Female', '7ba55de0a483cd485e1629176c9fa5ac', 1),
-    ( 'john69', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
-    ( 'smith6', 'smith', 'paul', 'Female', '69b7b0f8981d3f462bddcd10e83d73b8', 1),
-    ( 'rogers88', 'miller', 'rivera', 'Male', '18b2b0e6b66ce69949cfd500bdbd547b', 1),
-    ( 'john98', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
-    ( 'paul69', 'john', 'morgan', 'Male', '98bdeac0dbd9377cd342ab3e2ed67002', 1),
-    ( 'morris55', 'wright', 'brown', 'Male', 'e808e39b0369890ca613a24d1bdbe80e', 1),
-    ( 'paul3', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
-    ( 'rogers28', 'brown', 'brown', 'Male', 'cb1b08d86f6053ce02919717671bd81f', 1),
-    ( 'brown16', 'james', 'chrishaydon', 'Female', 'ef70b7e590eb6ef3d09bdb05084610e4', 1),
-    ( 'david68', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
-    ( 'michael8', 'paul', 'morgan', 'Female', '6691fbe0b3d4d260b0ada3cd9c0b78f5', 1),
-    ( 'sanders67', 'brooks', 'paul', 'Male', 'ddf8e1b6ed97cdf30cb35d0f9e47517b', 1),
-    ( 'rivera75', 'brooks', 'brooks', 'Male', 'fb77723ac6a75b2dfa7636213fc12803', 1),
-    ( 'miller81', 'ross', 'david', 'Female', 'e5e1fa5d4c971a980e6d087016507eb7', 1),
-    ( 'jenny0952', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
-    ( 'rivera53', 'bell', 'smith', 'Female', '1e3e64c56121038797a7e50b655464c6', 1),
-    ( 'morgan33', 'wright', 'brown', 'Male', '8e5a808681a0070beaf455c2cabb782b', 1),
-    ( 'ross27', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
-    ( 'miller94', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
-    ( 'michael14', 'paul', 'sanders', 'Female', '9462cebb50643d2a7731b89c5b1b8fe3', 1),
-    ( 'rogers60', 'mark', 'smith', 'Male', 'bef7a008c13dcc9dd7947e5f248a1cfd', 1),
-    ( 'maria50', 'rivera', 'miller', 'Male', '64b7a989ccd1e83d881215cddbeafb72', 1),
-    ( 'morgan96', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
-    ( 'ross49', 'sanders', 'jenny09', 'Female', '53083ad87812f62ccd391f01069e77b5', 1),
-    ( 'wright37', 'miller', 'chrishaydon', 'Male', '0f8b225730e5be91f0f4cbb29156cf44', 1),
-    ( 'brown38', 'ross', 'morgan', 'Male', '07a841d9d548265d67ac8897f60f1b06', 1),
-    ( 'michael50', 'mike', 'rogers', 'Female', 'a366cae9b72e785998b0ce78a2284bdb', 1),
-    ( 'wright93', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
-    ( 'miller91', 'mike', 'chrishaydon', 'Male', 'edb8e845ed0dc8c888f7225fa43eb2a6', 1),
-    ( 'daniel21', 'cooper', 'sanders', 'Female', 'df3e462d93781ea422c125a4c2b5566a', 1),
-    ( 'bell44', 'ross', 'smith', 'Male', 'a49601f3e6a85c0387539bba4bcdbe6c', 1),
-    ( 'brooks58', 'ross', 'john', 'Male', '6e5b2a0375b4d4d500ee07e62cad6d6c', 1),
-    ( 'chrishaydon86', 'sanders', 'david', 'Female', 'c3bf2214f10104ec078bed756539f9af', 1),
-    ( 'jenny0910', 'paul', 'smith', 'Male', '58eea070ea61891bfea5954c38cb9336', 1),
-    ( 'rivera53', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
-    ( 'brown75', 'sanders', 'rivera', 'Female', '7c5cef8a2e387abe6908ee2f3fbc534a', 1),
-    ( 'maria40', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
-    ( 'john12', 'sanders', 'sanders', 'Male', 'cba0f422fbd342f81feb1dd78a43cad6', 1),
-    ( 'sanders88', 'paul', 'miller', 'Male', 'b6153be014b1af1811c85aa770c4cc46', 1),
-    ( 'morris98', 'morgan', 'brooks', 'Female', 'd793df005919fa5c4f45a61a7f9f8e87', 1),
-    ( 'miller17', 'daniel', 'brown', 'Female', 'a11bf4bd7d2f8c8162d97c7447ab07f8', 1),
-    ( 'cooper84', 'miller', 'miller', 'Male', '941752ab63605763d87142453aa35988', 1),
-    ( 'ross49', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
-    ( 'morgan54', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
-    ( 'chrishaydon29', 'wright', 'cooper', 'Female', 'b6ed3804fd6ad8ccd1c85c0a7deda4e3', 1),
-    ( 'paul3', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
-    ( 'morris98', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
-    ( 'cooper55', 'mark', 'ross', 'Female', '6c794b04248c5d6120dd84b8e94967a7', 1),
-    ( 'rivera85', 'brown', 'brooks', 'Female', '0ca4d10e4c787cc061e18feb815f747b', 1),
-    ( 'rogers43', 'jenny09', 'mike', 'Female', 'aa98ad5a318401147dc9580a9e134a7e', 1),
-    ( 'ross42', 'mark', 'michael', 'Female', '1507a0565d69991ff963cd3489b1c491', 1),
-    ( 'james29', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
-    ( 'chrishaydon20', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
-    ( 'wright68', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
-    ( 'cooper47', 'ross', 'paul', 'Male', '524f2968fabb85f0fdb2fa63110f6690', 1),
-    ( 'morgan73', 'chrishaydon', 'cooper', 'Male', '33b7c4b03c90ac30be52d86c3229577b', 1),
-    ( 'maria82', 'daniel', 'daniel', 'Male', '9a83d41058fafd2a50b9fcce02811361', 1),
-    ( 'john8', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
-    ( 'miller63', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
-    ( 'morgan26', 'michael', 'rogers', 'Male', 'e4a653c83b7fa702099b4c780e5fa68d', 1),
-    ( 'rivera69', 'brown', 'david', 'Female', 'b58472e08b58b46875487093c2867ec6', 1),
-    ( 'daniel31', 'john', 'rogers', 'Male', '9d84f6219123fb1920cf2e7494a44d77', 1),
-    ( 'sanders100', 'michael', 'john', 'Male', '349fda3c9d90de7e62c9ada6c0550a8b', 1),
-    ( 'mark84', 'morris', 'chrishaydon', 'Female', '8f9307568ba555323b0599ce0c715b1a', 1),
-    ( 'smith56', 'david', 'paul', 'Male', 'baaf6963e059f92921d196b5fef6669d', 1),
-    ( 'morris93', 'chrishaydon', 'james', 'Female', 'dba13ee70b381ea5b9ceac0ece3d850f', 1),
-    ( 'michael45', 'michael', 'paul', 'Female', 'd9f0344646b6ba7f224d9d3a0986cc7e', 1),
-    ( 'mike88', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
-    ( 'morgan96', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
-    ( 'james5', 'brooks', 'mike', 'Female', 'ac332ec30f36d30d5bf9221fe1b32bf0', 1),
-    ( 'morris83', 'sanders', 'maria', 'Male', 'fcb81feea910ea24117d4f26171991f8', 1),
-    ( 'rivera19', 'rivera', 'bell', 'Female', '0ba1e8782ffcf3e71c079e1e54c0a41c', 1),
-    ( 'daniel57', 'paul', 'bell', 'Female', '62d04b1633be93a4b4f93256a56e28d0', 1),
-    ( 'maria10', 'wright', 'sanders', 'Female', '9c8c23c03a74a7a320d742aa95cf08e3', 1),
-    ( 'david71', 'jenny09', 'david', 'Male', '16a061f8fdf5c93e9e77631dfb7d471a', 1),
-    ( 'sanders88', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
-    ( 'rogers37', 'chrishaydon', 'brown', 'Male', '822141a673303a54b9387d8fc6a0c425', 1),
-    ( 'chrishaydon10', 'chrishaydon', 'john', 'Male', '1b7d631cf551c86b671fd6bc2ec9d0dc', 1),
-    ( 'sanders27', 'bell', 'morris', 'Male', '576352444376fe3069d256ded463666f', 1),
-    ( 'brown17', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
-    ( 'morris86', 'rogers', 'ross', 'Male', '896b690b9566f53a875e03a3a324c091', 1),
-    ( 'mark79', 'bell', 'sanders', 'Male', 'cee8dbd9130bccbe4d945c9cfc41f330', 1),
-    ( 'david58', 'david', 'morgan', 'Female', 'ca391efb9f114371dced52c8eb18a412', 1),
-    ( 'daniel95', 'wright', 'ross', 'Male', '4458808784017babe1bb3ed79c61f0f7', 1),
-    ( 'brooks49', 'sanders', 'morgan', 'Female', 'dbd61cc2c16fa763662c8a29d63215e0', 1),
-    ( 'jenny0926', 'wright', 'david', 'Female', 'd2d4451b918dbb9730bb7e010a50ab60', 1),
-    ( 'bell37', 'morgan', 'john', 'Female', '9019a44759e7bdece498cc5dce570446', 1),
-    ( 'john46', 'miller', 'john', 'Female', 'c618d2bfb0bf88f1de98313820f226a8', 1),
-    ( 'chrishaydon43', 'miller', 'chrishaydon', 'Female', 'e63ca65668b82c9fd07eef6b719ad4aa', 1),
-    ( 'rogers19', 'mike', 'john', 'Male', '59843bfb2ca080a23bcbbc5278fa6982', 1),
-    ( 'brooks29', 'rivera', 'rivera', 'Female', 'f151014abd62636e598dbad4a5b43aa3', 1),
-    ( 'chrishaydon74', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
-    ( 'brooks43', 'morgan', 'cooper', 'Male', '669e54b4368a4a99595fe4a56b9bf6ff', 1),
-    ( 'ross44', 'morgan', 'smith', 'Female', 'a84cf2d984ed2eb7948b9fda85275a7f', 1),
-    ( 'bell63', 'chrishaydon', 'james', 'Male', '863d1292a4a36cdc9134a7f84e8070c9', 1),
-    ( 'rivera41', 'maria', 'mark', 'Male', 'a9dd9815cf33560650fc661a97f41547', 1),
-    ( 'wright17', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
-    ( 'michael15', 'david', 'mark', 'Female', 'a167a5d9cf8157d98d45c1d0e32b72f4', 1),
-    ( 'ross53', 'morris', 'daniel', 'Female', 'b4a6baa51bd47cf407213f6ba9254f71', 1),
-    ( 'cooper65', 'brooks', 'mark', 'Female', '26f039d32dad1e54e8f74b015ea9ec68', 1),
-    ( 'rivera2', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
-    ( 'james32', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
-    ( 'mark97', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
-    ( 'smith93', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
-    ( 'chrishaydon84', 'james', 'brown', 'Male', '5957038b6a3f0a0f305123af998bc437', 1),
-    ( 'smith30', 'maria', 'wright', 'Male', '62d191a19bda44ba011ebd37976b5a23', 1),
-    ( 'maria51', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
-    ( 'rivera52', 'cooper', 'michael', 'Male', 'c15a7058e0c14b26f6863fbd7549a1e7', 1),
-    ( 'chrishaydon48', 'david', 'cooper', 'Female', 'e51d84e0afa82e704755b26fe59f566e', 1),
-    ( 'morgan90', 'mike', 'maria', 'Female', 'e78f1e49da6ebf2fe756ec38b646ba36', 1),
-    ( 'sanders53', 'bell', 'mark', 'Female', '15d2a8035ab8f89a8d2388049cdb1ee9', 1),
-    ( 'john47', 'sanders', 'sanders', 'Female', 'd3945958288a3f00f1d5fe7230670061', 1),
-    ( 'daniel100', 'paul', 'cooper', 'Male', '1ca7a74ac2257ad3c0f5885cb90099f2', 1),
-    ( 'wright13', 'brown', 'mark', 'Male', '35e1bd4961d74f12ed2e2399f219aaa9', 1),
-    ( 'morgan86', 'brown', 'daniel', 'Female', 'ba7d8e424270cfe9f48eb09c99ff68a1', 1),
-    ( 'wright17', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
-    ( 'morris93', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
-    ( 'james40', 'rogers', 'rogers', 'Male', 'b44bc9d82406f9a445889a66b255f271', 1),
-    ( 'ross47', 'sanders', 'chrishaydon', 'Male', '4592e91917d68abf8835aeae27f38cfc', 1),
-    ( 'wright8', 'bell', 'cooper', 'Male', 'e30161a98a0e9e3933dbb856e6af4719', 1),
-    ( 'ross83', 'morris', 'sanders', 'Female', '2ec9470fb5a92621648ad5c708b050f8', 1),
-    ( 'ross9', 'bell', 'sanders', 'Female', '85b8a1781fe0eda5fcdb86d950c2e239', 1),
-    ( 'miller47', 'morris', 'david', 'Female', '2fb2bd61613de4adc3ab2fc7fc05fe88', 1),
-    ( 'smith28', 'brown', 'john', 'Female', '2e3a6218c8c4652563470fd23b5c9004', 1),
-    ( 'morris2', 'bell', 'jenny09', 'Male', '9248ee5ee8d1ff997d0009348e2ea662', 1),
-    ( 'morris4', 'rogers', 'brooks', 'Male', 'd9290c1d84c18de491112fab16fc0172', 1),
-    ( 'jenny0926', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
-    ( 'morgan21', 'john', 'cooper', 'Male', 'c1c1328643f85dca460e8ead1600c650', 1),
-    ( 'david3', 'michael', 'miller', 'Male', '6c5c00ec2728ed2459e821dce4a85349', 1),
-    ( 'ross58', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
-    ( 'jenny0949', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
-    ( 'john96', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
-    ( 'paul5', 'rivera', 'ross', 'Male', '13a3756c2cb7b486e32054a08271d3f1', 1),
-    ( 'sanders80', 'michael', 'james', 'Female', '73f63edc5f6f3b571886fbb1316c43bc', 1),
-    ( 'smith65', 'brooks', 'bell', 'Male', '7ba86a4101f3074f458df9a6e43e3f5a', 1),
-    ( 'rogers26', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
-    ( 'maria43', 'miller', 'bell', 'Male', '0c879f0908f637ebe8cc0db994ae0832', 1),
-    ( 'david99', 'maria', 'jenny09', 'Female', 'b38a8c25edada7483420c20a76b9d4b0', 1),
-    ( 'wright20', 'smith', 'rogers', 'Female', 'e11d269be9b74106223b357b06f2da33', 1),
-    ( 'brooks87', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
-    ( 'mike43', 'morgan', 'ross', 'Male', '024142778439c88b675219780cf1c578', 1),
-    ( 'ross30', 'mark', 'david', 'Male', 'c5fb92d8af4e45581d8bb7c71a5c0a1c', 1),
-    ( 'jenny0981', 'morgan', 'brooks', 'Female', '34ae1e72198b2ccb37610a3269368fa7', 1),
-    ( 'cooper11', 'james', 'james', 'Female', '243cffae81569cca39840aec1e9e2852', 1),
-    ( 'rogers97', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
-    ( 'wright84', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
-    ( 'jenny0932', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
-    ( 'sanders40', 'paul', 'sanders', 'Female', 'be92492fa64d6126726a71584a75a878', 1),
-    ( 'john80', 'daniel', 'ross', 'Female', '8a608ab5ea3865ae688ac2f8b1cc3b61', 1),
-    ( 'brooks58', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
-    ( 'miller37', 'brooks', 'bell', 'Female', '62c1ace1a0aa8e798169f547586457c8', 1),
-    ( 'james93', 'chrishaydon', 'james', 'Male', '7ef00ef6e7f47472db040222dae2dc8e', 1),
-    ( 'bell16', 'bell', 'james', 'Male', '0d5e1a6eab996a7d1d32a0e2ed22ff2a', 1),
-    ( 'smith96', 'mike', 'bell', 'Female', '68f2fb17fd3f13d8a0a394de396ce447', 1),
-    ( 'ross94', 'david', 'bell', 'Male', '84c62bc5b6f1dab11a1ca3d8d7a5e29e', 1),
-    ( 'ross95', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
-    ( 'chrishaydon82', 'jenny09', 'maria', 'Female', '8093f823794b8fa03c379c035300fd0b', 1),
-    ( 'michael3', 'mike', 'jenny09', 'Male', 'fc13f6e30b38a8529a57add8a59b5ec0', 1),
-    ( 'jenny0918', 'rogers', 'brown', 'Male', '8b9e34ac36a2fd947614e2616f3d6826', 1),
-    ( 'rogers43', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
-    ( 'morris91', 'paul', 'rivera', 'Male', '613be5cffa8a578c1816a1baf6bb8501', 1),
-    ( 'john4', 'james', 'rivera', 'Female', '76ebe07cd3c27e769f71251f294d4c94', 1),
-    ( 'cooper5', 'wright', 'morris', 'Male', '8105c71bb32ba7bf731c4162ff9c1f21', 1),
-    ( 'chrishaydon20', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
-    ( 'chrishaydon44', 'mark', 'smith', 'Female', 'a54db1865fb0fa3a66861d93e037741d', 1),
-    ( 'daniel13', 'brooks', 'brown', 'Female', '91270e97491a918496e6569c9fcff808', 1),
-    ( 'chrishaydon37', 'brown', 'mark', 'Male', 'a3efe2fadf9e33423b80532af0f95c33', 1),
-    ( 'david31', 'wright', 'paul', 'Female', 'b8e0691f01b08a810fade049028d9c3e', 1),
-    ( 'mark21', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
-    ( 'wright81', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
-    ( 'michael13', 'morris', 'cooper', 'Female', '9d450e0529ca7328d07679163206f732', 1),
-    ( 'rogers63', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
-    ( 'mike28', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
-    ( 'rivera92', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
-    ( 'ross95', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
-    ( 'paul85', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
-    ( 'smith34', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
-    ( 'james84', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
-    ( 'daniel53', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
-    ( 'brooks80', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
-    ( 'morgan65', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
-    ( 'sanders84', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
-    ( 'maria40', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
-    ( 'brown71', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
-    ( 'james63', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
-    ( 'jenny0993', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
-    ( 'john96', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
-    ( 'miller64', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
-    ( 'mark46', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
-    ( 'jenny0988', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
-    ( 'mark80', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
-    ( 'morris72', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
-    ( 'wright39', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
-    ( 'paul68', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
-    ( 'smith60', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
-    ( 'bell43', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
-    ( 'rogers79', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
-    ( 'daniel56', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
-    ( 'brooks85', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
-    ( 'mike30', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
-    ( 'paul92', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
-    ( 'bell96', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
-    ( 'john8', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
-    ( 'chrishaydon12', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
-    ( 'morgan13', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
-    ( 'james83', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
-    ( 'chrishaydon8', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
-    ( 'ross85', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
-    ( 'ross46', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
-    ( 'smith4', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
-    ( 'paul4', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
-    ( 'daniel26', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
-    ( 'chrishaydon2', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
-    ( 'david82', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
-    ( 'john97', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
-    ( 'david57', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
-    ( 'rivera100', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
-    ( 'bell13', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
-    ( 'brooks65', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
-    ( 'daniel40', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
-    ( 'cooper100', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
-    ( 'morris14', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
-    ( 'smith82', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
-    ( 'cooper35', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
-    ( 'morgan94', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
-    ( 'michael92', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
-    ( 'sanders48', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
-    ( 'brown76', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
-    ( 'james16', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
-    ( 'michael26', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
-    ( 'wright57', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
-    ( 'wright68', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
-    ( 'brooks1', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
-    ( 'bell2', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
-    ( 'miller100', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
-    ( 'rogers53', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
-    ( 'mike1', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
-    ( 'cooper57', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
-    ( 'daniel38', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
-    ( 'mark2', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
-    ( 'daniel79', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
-    ( 'wright4', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
-    ( 'brown84', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
-    ( 'paul41', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
-    ( 'mark5', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
-    ( 'jenny0994', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
-    ( 'morris53', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
-    ( 'paul68', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
-    ( 'brooks86', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
-    ( 'james54', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
-    ( 'rogers58', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
-    ( 'maria31', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
-    ( 'david5', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
-    ( 'mark21', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
-    ( 'jenny0932', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
-    ( 'john92', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
-    ( 'rogers98', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
-    ( 'rogers95', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
-    ( 'james50', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
-    ( 'miller80', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
-    ( 'mark29', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
-    ( 'cooper77', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
-    ( 'john24', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
-    ( 'chrishaydon32', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
-    ( 'bell41', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
-    ( 'ross99', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
-    ( 'smith9', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
-    ( 'miller73', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
-    ( 'michael44', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
-    ( 'michael36', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
-    ( 'smith93', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
-    ( 'morgan38', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
-    ( 'brown70', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
-    ( 'mike14', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
-    ( 'daniel72', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
-    ( 'jenny0974', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
-    ( 'maria51', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
-    ( 'michael97', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
-    ( 'chrishaydon33', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
-    ( 'sanders42', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
-    ( 'brooks87', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
-    ( 'bell4', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
-    ( 'jenny0945', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
-    ( 'james65', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
-    ( 'john98', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
-    ( 'paul80', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
-    ( 'john92', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
-    ( 'wright69', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
-    ( 'bell68', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
-    ( 'brooks97', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
-    ( 'mark14', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
-    ( 'david60', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
-    ( 'rivera68', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
-    ( 'brooks32', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
-    ( 'brown15', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
-    ( 'bell88', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
-    ( 'john26', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
-    ( 'paul55', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
-    ( 'david59', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
-    ( 'morris73', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
-    ( 'michael77', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
-    ( 'mark5', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
-    ( 'james65', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
-    ( 'maria39', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
-    ( 'michael37', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
-    ( 'sanders1', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
-    ( 'daniel30', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
-    ( 'brown53', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
-    ( 'john52', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
-    ( 'wright73', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
-    ( 'mark63', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
-    ( 'james29', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
-    ( 'mark23', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
-    ( 'cooper22', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
-    ( 'miller19', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
-    ( 'jenny0913', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
-    ( 'maria100', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
-    ( 'chrishaydon51', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
-    ( 'david9', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
-    ( 'mike52', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
-    ( 'wright5', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
-    ( 'brown21', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
-    ( 'daniel46', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
-    ( 'brown86', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
-    ( 'chrishaydon85', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
-    ( 'john41', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
-    ( 'mark72', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
-    ( 'michael67', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
-    ( 'jenny0943', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
-    ( 'daniel61', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
-    ( 'morgan34', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
-    ( 'jenny097', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
-    ( 'miller11', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
-    ( 'daniel99', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
-    ( 'miller20', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
-    ( 'sanders29', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
-    ( 'mark43', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
-    ( 'james86', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
-    ( 'chrishaydon74', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
-    ( 'brooks37', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
-    ( 'michael78', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
-    ( 'paul9', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
-    ( 'james57', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
-    ( 'mark91', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
-    ( 'wright30', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
-    ( 'rogers97', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
-    ( 'daniel8', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
-    ( 'mark66', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
-    ( 'daniel6', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
-    ( 'bell72', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
-    ( 'morgan77', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
-    ( 'bell24', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
-    ( 'michael31', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
-    ( 'cooper98', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
-    ( 'daniel41', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
-    ( 'michael1', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
-    ( 'chrishaydon20', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
-    ( 'daniel66', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
-    ( 'cooper52', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
-    ( 'chrishaydon99', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
-    ( 'smith66', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
-    ( 'mike48', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
-    ( 'rivera80', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
-    ( 'morgan35', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
-    ( 'brooks16', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
-    ( 'mike78', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
-    ( 'michael38', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
-    ( 'mark7', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
-    ( 'rogers80', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
-    ( 'wright81', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
-    ( 'michael21', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
-    ( 'daniel56', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
-    ( 'ross3', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
-    ( 'chrishaydon72', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
-    ( 'sanders66', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
-    ( 'michael4', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
-    ( 'brown66', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
-    ( 'wright26', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
-    ( 'james81', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
-    ( 'morris21', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
-    ( 'john30', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
-    ( 'jenny0929', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
-    ( 'wright91', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
-    ( 'jenny0922', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
-    ( 'rivera35', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
-    ( 'rogers53', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
-    ( 'ross50', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
-    ( 'ross41', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
-    ( 'rivera78', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
-    ( 'miller43', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
-    ( 'morgan59', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
-    ( 'chrishaydon63', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
-    ( 'wright93', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
-    ( 'maria62', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
-    ( 'mark85', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
-    ( 'wright3', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
-    ( 'brooks37', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
-    ( 'paul66', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
-    ( 'rivera36', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
-    ( 'paul42', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
-    ( 'morgan93', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
-    ( 'rivera43', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
-    ( 'daniel71', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
-    ( 'mike81', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
-    ( 'daniel83', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
-    ( 'smith54', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
-    ( 'sanders38', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
-    ( 'morris63', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
-    ( 'michael25', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
-    ( 'smith89', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
-    ( 'john97', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
-    ( 'jenny0985', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
-    ( 'wright84', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
-    ( 'maria53', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
-    ( 'brooks72', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
-    ( 'brown61', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
-    ( 'paul95', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
-    ( 'brooks92', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
-    ( 'james44', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
-    ( 'chrishaydon100', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
-    ( 'mark97', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
-    ( 'brooks91', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
-    ( 'jenny0929', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
-    ( 'morgan37', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
-    ( 'daniel43', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
-    ( 'mike98', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
-    ( 'john48', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
-    ( 'brooks65', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
-    ( 'cooper18', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
-    ( 'chrishaydon54', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
-    ( 'john67', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
-    ( 'morris27', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
-    ( 'ross45', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
-    ( 'rogers85', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
-    ( 'sanders96', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
-    ( 'smith70', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
-    ( 'wright31', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
-    ( 'paul10', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
-    ( 'sanders2', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
-    ( 'rogers14', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
-    ( 'morgan79', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
-    ( 'morris87', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
-    ( 'james6', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
-    ( 'wright33', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
-    ( 'maria85', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
-    ( 'ross31', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
-    ( 'chrishaydon75', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
-    ( 'david54', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
-    ( 'chrishaydon50', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
-    ( 'miller24', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
-    ( 'maria9', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
-    ( 'smith91', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
-    ( 'rivera11', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
-    ( 'james72', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
-    ( 'miller36', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
-    ( 'jenny0991', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
-    ( 'jenny0959', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
-    ( 'mike41', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
-    ( 'morgan20', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
-    ( 'miller29', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
-    ( 'ross56', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
-    ( 'chrishaydon63', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
-    ( 'morris54', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
-    ( 'bell20', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
-    ( 'paul41', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
-    ( 'morgan42', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
-    ( 'ross100', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
-    ( 'wright1', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
-    ( 'jenny0921', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
-    ( 'paul37', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
-    ( 'michael92', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
-    ( 'chrishaydon14', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
-    ( 'miller40', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
-    ( 'wright43', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
-    ( 'smith15', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
-    ( 'miller49', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
-    ( 'morris21', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
-    ( 'ross27', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
-    ( 'rivera16', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
-    ( 'maria73', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
-    ( 'chrishaydon60', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
-    ( 'david40', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
-    ( 'michael66', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
-    ( 'paul27', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
-    ( 'jenny0911', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
-    ( 'miller79', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
-    ( 'mark14', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
-    ( 'ross87', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
-    ( 'paul47', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
-    ( 'sanders61', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
-    ( 'rivera13', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
-    ( 'chrishaydon22', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
-    ( 'sanders42', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
-    ( 'david78', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
-    ( 'ross60', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
-    ( 'morris24', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
-    ( 'jenny0952', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
-    ( 'rogers72', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
-    ( 'daniel70', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
-    ( 'cooper76', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
-    ( 'jenny0942', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
-    ( 'daniel39', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
-    ( 'morris59', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
-    ( 'mike70', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
-    ( 'rogers20', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
-    ( 'david33', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
-    ( 'morris65', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
-    ( 'michael23', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
-    ( 'john96', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
-    ( 'wright64', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
-    ( 'david91', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
-    ( 'daniel87', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
-    ( 'ross60', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
-    ( 'morris60', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
-    ( 'rivera50', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
-    ( 'maria77', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
-    ( 'morgan64', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
-    ( 'miller41', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
-    ( 'david65', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
-    ( 'morgan96', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
-    ( 'ross73', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
-    ( 'brooks95', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
-    ( 'sanders92', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
-    ( 'cooper53', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
-    ( 'ross100', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
-    ( 'michael91', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
-    ( 'brooks51', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
-    ( 'ross26', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
-    ( 'morris58', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
-    ( 'morris58', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
-    ( 'ross12', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
-    ( 'brooks60', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
-    ( 'mark29', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
-    ( 'john14', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
-    ( 'rivera26', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
-    ( 'jenny0949', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
-    ( 'rogers96', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
-    ( 'david55', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
-    ( 'chrishaydon3', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
-    ( 'ross81', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
-    ( 'john70', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
-    ( 'miller34', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
-    ( 'bell26', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
-    ( 'ross92', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
-    ( 'rogers34', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
-    ( 'james51', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
-    ( 'ross54', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
-    ( 'brown59', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
-    ( 'michael83', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
-    ( 'miller10', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
-    ( 'jenny0939', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
-    ( 'paul100', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
-    ( 'smith82', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
-    ( 'morris6', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
-    ( 'paul76', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
-    ( 'mark97', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
-    ( 'john74', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
-    ( 'miller78', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
-    ( 'miller54', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
-    ( 'chrishaydon9', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
-    ( 'daniel4', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
-    ( 'john24', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
-    ( 'smith31', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
-    ( 'smith77', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
-    ( 'maria29', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
-    ( 'michael76', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
-    ( 'mark64', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
-    ( 'morris5', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
-    ( 'brown69', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
-    ( 'miller53', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
-    ( 'wright94', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
-    ( 'rogers26', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
-    ( 'jenny0977', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
-    ( 'daniel23', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
-    ( 'cooper66', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
-    ( 'chrishaydon61', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
-    ( 'maria95', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
-    ( 'james17', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
-    ( 'james18', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
-    ( 'mike47', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
-    ( 'john57', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
-    ( 'mark54', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
-    ( 'bell38', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
-    ( 'morgan31', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
-    ( 'miller16', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
-    ( 'rivera14', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
-    ( 'rogers22', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
-    ( 'jenny0963', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
-    ( 'mark42', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
-    ( 'mark7', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
-    ( 'john1', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
-    ( 'chrishaydon3', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
-    ( 'smith11', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
-    ( 'john22', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
-    ( 'rivera23', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
-    ( 'james32', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
-    ( 'daniel58', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
-    ( 'james18', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
-    ( 'brown93', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
-    ( 'bell89', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
-    ( 'miller82', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
-    ( 'paul21', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
-    ( 'chrishaydon88', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
-    ( 'rivera44', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
-    ( 'bell89', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
-    ( 'bell66', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
-    ( 'rogers31', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
-    ( 'bell47', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
-    ( 'sanders58', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
-    ( 'mark26', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
-    ( 'miller22', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
-    ( 'daniel46', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
-    ( 'morgan100', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
-    ( 'ross75', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
-    ( 'david68', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
-    ( 'rivera35', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
-    ( 'daniel63', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
-    ( 'ross37', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
-    ( 'michael23', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
-    ( 'mike48', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
-    ( 'david57', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
-    ( 'morris67', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
-    ( 'rogers49', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
-    ( 'morris14', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
-    ( 'brooks74', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
-    ( 'mike71', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
-    ( 'mark18', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
-    ( 'mark9', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
-    ( 'brooks21', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
-    ( 'bell4', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
-    ( 'brown17', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
-    ( 'jenny0923', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
-    ( 'smith66', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
-    ( 'daniel53', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
-    ( 'brown33', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
-    ( 'mark55', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
-    ( 'rivera83', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
-    ( 'paul72', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
-    ( 'david25', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
-    ( 'paul47', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
-    ( 'james61', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
-    ( 'paul90', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
-    ( 'david95', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
-    ( 'paul52', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
-    ( 'wright82', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
-    ( 'james61', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
-    ( 'michael89', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
-    ( 'wright44', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
-    ( 'chrishaydon60', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
-    ( 'john30', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
-    ( 'rivera2', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
-    ( 'wright17', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
-    ( 'rivera30', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
-    ( 'daniel63', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
-    ( 'brooks56', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
-    ( 'wright29', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
-    ( 'morris52', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
-    ( 'morgan10', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
-    ( 'sanders55', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
-    ( 'mike9', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
-    ( 'michael69', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
-    ( 'david18', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
-    ( 'daniel41', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
-    ( 'david59', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
-    ( 'daniel17', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
-    ( 'john45', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
-    ( 'morgan17', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
-    ( 'john42', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
-    ( 'rogers50', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
-    ( 'smith35', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
-    ( 'mark56', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
-    ( 'chrishaydon12', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
-    ( 'mark90', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
-    ( 'maria85', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
-    ( 'rogers42', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
-    ( 'brooks95', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
-    ( 'brooks38', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
-    ( 'morris49', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
-    ( 'david86', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
-    ( 'wright15', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
-    ( 'wright75', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
-    ( 'sanders78', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
-    ( 'jenny092', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
-    ( 'cooper68', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
-    ( 'paul87', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
-    ( 'brooks59', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
-    ( 'rivera36', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
-    ( 'cooper25', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
-    ( 'mike3', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
-    ( 'rivera24', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
-    ( 'wright89', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
-    ( 'cooper12', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
-    ( 'paul32', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
-    ( 'wright11', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
-    ( 'maria51', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
-    ( 'morris85', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
-    ( 'chrishaydon88', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
-    ( 'brown49', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
-    ( 'mike55', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
-    ( 'paul6', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
-    ( 'james19', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
-    ( 'brown35', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
-    ( 'chrishaydon15', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
-    ( 'jenny0962', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
-    ( 'brooks57', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
-    ( 'chrishaydon7', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
-    ( 'morgan15', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
-    ( 'chrishaydon47', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
-    ( 'brooks41', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
-    ( 'mark61', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
-    ( 'brooks82', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
-    ( 'maria6', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
-    ( 'brown2', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
-    ( 'maria94', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
-    ( 'smith95', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
-    ( 'ross3', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
-    ( 'smith24', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
-    ( 'john19', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
-    ( 'michael93', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
-    ( 'bell35', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
-    ( 'david80', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
-    ( 'paul3', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
-    ( 'michael27', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
-    ( 'smith12', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
-    ( 'maria61', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
-    ( 'daniel3', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
-    ( 'jenny0923', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
-    ( 'morris5', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
-    ( 'ross58', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
-    ( 'michael48', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
-    ( 'brown92', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
-    ( 'morgan54', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
-    ( 'sanders87', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
-    ( 'paul54', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
-    ( 'cooper59', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
-    ( 'brooks16', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
-    ( 'rivera44', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
-    ( 'sanders60', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
-    ( 'morgan82', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
-    ( 'john69', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
-    ( 'david36', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
-    ( 'mike88', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
-    ( 'david9', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
-    ( 'david34', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
-    ( 'sanders20', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
-    ( 'mike22', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
-    ( 'maria63', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
-    ( 'miller94', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
-    ( 'chrishaydon17', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
-    ( 'brooks60', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
-    ( 'bell34', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
-    ( 'chrishaydon65', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
-    ( 'paul70', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
-    ( 'jenny0972', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
-    ( 'john41', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
-    ( 'smith78', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
-    ( 'morgan55', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
-    ( 'smith81', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
-    ( 'jenny0988', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
-    ( 'chrishaydon49', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
-    ( 'morris31', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
-    ( 'mike15', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
-    ( 'smith61', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
-    ( 'chrishaydon42', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
-    ( 'john62', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
-    ( 'maria40', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
-    ( 'james50', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
-    ( 'brown93', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
-    ( 'maria85', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
-    ( 'miller63', 'morgan', 'morris', 'Female', 'ee33d4596af639660986c67f04e6ff92', 1),
-    ( 'smith50', 'brown', 'brown', 'Female', 'bd1e4b23c510e1eae5fa69baa618f3e2', 1),
-    ( 'mike83', 'smith', 'cooper', 'Female', '4a3309bacad462ce5af197a808839446', 1),
-    ( 'mark90', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
-    ( 'morris71', 'rogers', 'sanders', 'Male', 'a7fd9a391ed17cc9be339bcb87af3451', 1),
-    ( 'brown70', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
-    ( 'jenny0930', 'bell', 'rivera', 'Female', 'c25f2f612dde293ec2155a5dfe7901f2', 1),
-    ( 'smith60', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
-    ( 'cooper56', 'ross', 'mike', 'Female', '81b32a932116fed6d09aaa2c2bc522df', 1),
-    ( 'chrishaydon67', 'wright', 'mark', 'Female', 'a4808f35e9eca8fd90326ad5632ff220', 1),
-    ( 'cooper90', 'ross', 'rivera', 'Male', '077380568f1971f1003978046142baeb', 1),
-    ( 'morris82', 'ross', 'miller', 'Female', '8d863a559ac2d9fba0dfa98649e55637', 1),
-    ( 'morgan3', 'sanders', 'mark', 'Male', '4d5a20ef5496dae22ea2bbfa40defc12', 1),
-    ( 'daniel25', 'michael', 'ross', 'Female', '6794c36a5638c0c0df58155493512e60', 1),
-    ( 'rivera64', 'morgan', 'mike', 'Female', '041dcc147d542af1dc6bf50192c1b10f', 1),
-    ( 'bell41', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
-    ( 'rivera34', 'bell', 'paul', 'Male', '7d4a65a26f424093d93857b14104585e', 1),
-    ( 'chrishaydon22', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
-    ( 'miller53', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
-    ( 'miller58', 'sanders', 'paul', 'Male', '3fa66dc95e8f6e524cd5afbf4abfebdf', 1),
-    ( 'wright41', 'smith', 'sanders', 'Female', '7ba55de0a483cd485e1629176c9fa5ac', 1),
-    ( 'john69', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
-    ( 'smith6', 'smith', 'paul', 'Female', '69b7b0f8981d3f462bddcd10e83d73b8', 1),
-    ( 'rogers88', 'miller', 'rivera', 'Male', '18b2b0e6b66ce69949cfd500bdbd547b', 1),
-    ( 'john98', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
-    ( 'paul69', 'john', 'morgan', 'Male', '98bdeac0dbd9377cd342ab3e2ed67002', 1),
-    ( 'morris55', 'wright', 'brown', 'Male', 'e808e39b0369890ca613a24d1bdbe80e', 1),
-    ( 'paul3', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
-    ( 'rogers28', 'brown', 'brown', 'Male', 'cb1b08d86f6053ce02919717671bd81f', 1),
-    ( 'brown16', 'james', 'chrishaydon', 'Female', 'ef70b7e590eb6ef3d09bdb05084610e4', 1),
-    ( 'david68', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
-    ( 'michael8', 'paul', 'morgan', 'Female', '6691fbe0b3d4d260b0ada3cd9c0b78f5', 1),
-    ( 'sanders67', 'brooks', 'paul', 'Male', 'ddf8e1b6ed97cdf30cb35d0f9e47517b', 1),
-    ( 'rivera75', 'brooks', 'brooks', 'Male', 'fb77723ac6a75b2dfa7636213fc12803', 1),
-    ( 'miller81', 'ross', 'david', 'Female', 'e5e1fa5d4c971a980e6d087016507eb7', 1),
-    ( 'jenny0952', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
-    ( 'rivera53', 'bell', 'smith', 'Female', '1e3e64c56121038797a7e50b655464c6', 1),
-    ( 'morgan33', 'wright', 'brown', 'Male', '8e5a808681a0070beaf455c2cabb782b', 1),
-    ( 'ross27', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
-    ( 'miller94', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
-    ( 'michael14', 'paul', 'sanders', 'Female', '9462cebb50643d2a7731b89c5b1b8fe3', 1),
-    ( 'rogers60', 'mark', 'smith', 'Male', 'bef7a008c13dcc9dd7947e5f248a1cfd', 1),
-    ( 'maria50', 'rivera', 'miller', 'Male', '64b7a989ccd1e83d881215cddbeafb72', 1),
-    ( 'morgan96', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
-    ( 'ross49', 'sanders', 'jenny09', 'Female', '53083ad87812f62ccd391f01069e77b5', 1),
-    ( 'wright37', 'miller', 'chrishaydon', 'Male', '0f8b225730e5be91f0f4cbb29156cf44', 1),
-    ( 'brown38', 'ross', 'morgan', 'Male', '07a841d9d548265d67ac8897f60f1b06', 1),
-    ( 'michael50', 'mike', 'rogers', 'Female', 'a366cae9b72e785998b0ce78a2284bdb', 1),
-    ( 'wright93', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
-    ( 'miller91', 'mike', 'chrishaydon', 'Male', 'edb8e845ed0dc8c888f7225fa43eb2a6', 1),
-    ( 'daniel21', 'cooper', 'sanders', 'Female', 'df3e462d93781ea422c125a4c2b5566a', 1),
-    ( 'bell44', 'ross', 'smith', 'Male', 'a49601f3e6a85c0387539bba4bcdbe6c', 1),
-    ( 'brooks58', 'ross', 'john', 'Male', '6e5b2a0375b4d4d500ee07e62cad6d6c', 1),
-    ( 'chrishaydon86', 'sanders', 'david', 'Female', 'c3bf2214f10104ec078bed756539f9af', 1),
-    ( 'jenny0910', 'paul', 'smith', 'Male', '58eea070ea61891bfea5954c38cb9336', 1),
-    ( 'rivera53', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
-    ( 'brown75', 'sanders', 'rivera', 'Female', '7c5cef8a2e387abe6908ee2f3fbc534a', 1),
-    ( 'maria40', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
-    ( 'john12', 'sanders', 'sanders', 'Male', 'cba0f422fbd342f81feb1dd78a43cad6', 1),
-    ( 'sanders88', 'paul', 'miller', 'Male', 'b6153be014b1af1811c85aa770c4cc46', 1),
-    ( 'morris98', 'morgan', 'brooks', 'Female', 'd793df005919fa5c4f45a61a7f9f8e87', 1),
-    ( 'miller17', 'daniel', 'brown', 'Female', 'a11bf4bd7d2f8c8162d97c7447ab07f8', 1),
-    ( 'cooper84', 'miller', 'miller', 'Male', '941752ab63605763d87142453aa35988', 1),
-    ( 'ross49', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
-    ( 'morgan54', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
-    ( 'chrishaydon29', 'wright', 'cooper', 'Female', 'b6ed3804fd6ad8ccd1c85c0a7deda4e3', 1),
-    ( 'paul3', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
-    ( 'morris98', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
-    ( 'cooper55', 'mark', 'ross', 'Female', '6c794b04248c5d6120dd84b8e94967a7', 1),
-    ( 'rivera85', 'brown', 'brooks', 'Female', '0ca4d10e4c787cc061e18feb815f747b', 1),
-    ( 'rogers43', 'jenny09', 'mike', 'Female', 'aa98ad5a318401147dc9580a9e134a7e', 1),
-    ( 'ross42', 'mark', 'michael', 'Female', '1507a0565d69991ff963cd3489b1c491', 1),
-    ( 'james29', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
-    ( 'chrishaydon20', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
-    ( 'wright68', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
-    ( 'cooper47', 'ross', 'paul', 'Male', '524f2968fabb85f0fdb2fa63110f6690', 1),
-    ( 'morgan73', 'chrishaydon', 'cooper', 'Male', '33b7c4b03c90ac30be52d86c3229577b', 1),
-    ( 'maria82', 'daniel', 'daniel', 'Male', '9a83d41058fafd2a50b9fcce02811361', 1),
-    ( 'john8', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
-    ( 'miller63', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
-    ( 'morgan26', 'michael', 'rogers', 'Male', 'e4a653c83b7fa702099b4c780e5fa68d', 1),
-    ( 'rivera69', 'brown', 'david', 'Female', 'b58472e08b58b46875487093c2867ec6', 1),
-    ( 'daniel31', 'john', 'rogers', 'Male', '9d84f6219123fb1920cf2e7494a44d77', 1),
-    ( 'sanders100', 'michael', 'john', 'Male', '349fda3c9d90de7e62c9ada6c0550a8b', 1),
-    ( 'mark84', 'morris', 'chrishaydon', 'Female', '8f9307568ba555323b0599ce0c715b1a', 1),
-    ( 'smith56', 'david', 'paul', 'Male', 'baaf6963e059f92921d196b5fef6669d', 1),
-    ( 'morris93', 'chrishaydon', 'james', 'Female', 'dba13ee70b381ea5b9ceac0ece3d850f', 1),
-    ( 'michael45', 'michael', 'paul', 'Female', 'd9f0344646b6ba7f224d9d3a0986cc7e', 1),
-    ( 'mike88', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
-    ( 'morgan96', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
-    ( 'james5', 'brooks', 'mike', 'Female', 'ac332ec30f36d30d5bf9221fe1b32bf0', 1),
-    ( 'morris83', 'sanders', 'maria', 'Male', 'fcb81feea910ea24117d4f26171991f8', 1),
-    ( 'rivera19', 'rivera', 'bell', 'Female', '0ba1e8782ffcf3e71c079e1e54c0a41c', 1),
-    ( 'daniel57', 'paul', 'bell', 'Female', '62d04b1633be93a4b4f93256a56e28d0', 1),
-    ( 'maria10', 'wright', 'sanders', 'Female', '9c8c23c03a74a7a320d742aa95cf08e3', 1),
-    ( 'david71', 'jenny09', 'david', 'Male', '16a061f8fdf5c93e9e77631dfb7d471a', 1),
-    ( 'sanders88', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
-    ( 'rogers37', 'chrishaydon', 'brown', 'Male', '822141a673303a54b9387d8fc6a0c425', 1),
-    ( 'chrishaydon10', 'chrishaydon', 'john', 'Male', '1b7d631cf551c86b671fd6bc2ec9d0dc', 1),
-    ( 'sanders27', 'bell', 'morris', 'Male', '576352444376fe3069d256ded463666f', 1),
-    ( 'brown17', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
-    ( 'morris86', 'rogers', 'ross', 'Male', '896b690b9566f53a875e03a3a324c091', 1),
-    ( 'mark79', 'bell', 'sanders', 'Male', 'cee8dbd9130bccbe4d945c9cfc41f330', 1),
-    ( 'david58', 'david', 'morgan', 'Female', 'ca391efb9f114371dced52c8eb18a412', 1),
-    ( 'daniel95', 'wright', 'ross', 'Male', '4458808784017babe1bb3ed79c61f0f7', 1),
-    ( 'brooks49', 'sanders', 'morgan', 'Female', 'dbd61cc2c16fa763662c8a29d63215e0', 1),
-    ( 'jenny0926', 'wright', 'david', 'Female', 'd2d4451b918dbb9730bb7e010a50ab60', 1),
-    ( 'bell37', 'morgan', 'john', 'Female', '9019a44759e7bdece498cc5dce570446', 1),
-    ( 'john46', 'miller', 'john', 'Female', 'c618d2bfb0bf88f1de98313820f226a8', 1),
-    ( 'chrishaydon43', 'miller', 'chrishaydon', 'Female', 'e63ca65668b82c9fd07eef6b719ad4aa', 1),
-    ( 'rogers19', 'mike', 'john', 'Male', '59843bfb2ca080a23bcbbc5278fa6982', 1),
-    ( 'brooks29', 'rivera', 'rivera', 'Female', 'f151014abd62636e598dbad4a5b43aa3', 1),
-    ( 'chrishaydon74', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
-    ( 'brooks43', 'morgan', 'cooper', 'Male', '669e54b4368a4a99595fe4a56b9bf6ff', 1),
-    ( 'ross44', 'morgan', 'smith', 'Female', 'a84cf2d984ed2eb7948b9fda85275a7f', 1),
-    ( 'bell63', 'chrishaydon', 'james', 'Male', '863d1292a4a36cdc9134a7f84e8070c9', 1),
-    ( 'rivera41', 'maria', 'mark', 'Male', 'a9dd9815cf33560650fc661a97f41547', 1),
-    ( 'wright17', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
-    ( 'michael15', 'david', 'mark', 'Female', 'a167a5d9cf8157d98d45c1d0e32b72f4', 1),
-    ( 'ross53', 'morris', 'daniel', 'Female', 'b4a6baa51bd47cf407213f6ba9254f71', 1),
-    ( 'cooper65', 'brooks', 'mark', 'Female', '26f039d32dad1e54e8f74b015ea9ec68', 1),
-    ( 'rivera2', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
-    ( 'james32', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
-    ( 'mark97', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
-    ( 'smith93', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
-    ( 'chrishaydon84', 'james', 'brown', 'Male', '5957038b6a3f0a0f305123af998bc437', 1),
-    ( 'smith30', 'maria', 'wright', 'Male', '62d191a19bda44ba011ebd37976b5a23', 1),
-    ( 'maria51', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
-    ( 'rivera52', 'cooper', 'michael', 'Male', 'c15a7058e0c14b26f6863fbd7549a1e7', 1),
-    ( 'chrishaydon48', 'david', 'cooper', 'Female', 'e51d84e0afa82e704755b26fe59f566e', 1),
-    ( 'morgan90', 'mike', 'maria', 'Female', 'e78f1e49da6ebf2fe756ec38b646ba36', 1),
-    ( 'sanders53', 'bell', 'mark', 'Female', '15d2a8035ab8f89a8d2388049cdb1ee9', 1),
-    ( 'john47', 'sanders', 'sanders', 'Female', 'd3945958288a3f00f1d5fe7230670061', 1),
-    ( 'daniel100', 'paul', 'cooper', 'Male', '1ca7a74ac2257ad3c0f5885cb90099f2', 1),
-    ( 'wright13', 'brown', 'mark', 'Male', '35e1bd4961d74f12ed2e2399f219aaa9', 1),
-    ( 'morgan86', 'brown', 'daniel', 'Female', 'ba7d8e424270cfe9f48eb09c99ff68a1', 1),
-    ( 'wright17', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
-    ( 'morris93', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
-    ( 'james40', 'rogers', 'rogers', 'Male', 'b44bc9d82406f9a445889a66b255f271', 1),
-    ( 'ross47', 'sanders', 'chrishaydon', 'Male', '4592e91917d68abf8835aeae27f38cfc', 1),
-    ( 'wright8', 'bell', 'cooper', 'Male', 'e30161a98a0e9e3933dbb856e6af4719', 1),
-    ( 'ross83', 'morris', 'sanders', 'Female', '2ec9470fb5a92621648ad5c708b050f8', 1),
-    ( 'ross9', 'bell', 'sanders', 'Female', '85b8a1781fe0eda5fcdb86d950c2e239', 1),
-    ( 'miller47', 'morris', 'david', 'Female', '2fb2bd61613de4adc3ab2fc7fc05fe88', 1),
-    ( 'smith28', 'brown', 'john', 'Female', '2e3a6218c8c4652563470fd23b5c9004', 1),
-    ( 'morris2', 'bell', 'jenny09', 'Male', '9248ee5ee8d1ff997d0009348e2ea662', 1),
-    ( 'morris4', 'rogers', 'brooks', 'Male', 'd9290c1d84c18de491112fab16fc0172', 1),
-    ( 'jenny0926', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
-    ( 'morgan21', 'john', 'cooper', 'Male', 'c1c1328643f85dca460e8ead1600c650', 1),
-    ( 'david3', 'michael', 'miller', 'Male', '6c5c00ec2728ed2459e821dce4a85349', 1),
-    ( 'ross58', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
-    ( 'jenny0949', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
-    ( 'john96', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
-    ( 'paul5', 'rivera', 'ross', 'Male', '13a3756c2cb7b486e32054a08271d3f1', 1),
-    ( 'sanders80', 'michael', 'james', 'Female', '73f63edc5f6f3b571886fbb1316c43bc', 1),
-    ( 'smith65', 'brooks', 'bell', 'Male', '7ba86a4101f3074f458df9a6e43e3f5a', 1),
-    ( 'rogers26', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
-    ( 'maria43', 'miller', 'bell', 'Male', '0c879f0908f637ebe8cc0db994ae0832', 1),
-    ( 'david99', 'maria', 'jenny09', 'Female', 'b38a8c25edada7483420c20a76b9d4b0', 1),
-    ( 'wright20', 'smith', 'rogers', 'Female', 'e11d269be9b74106223b357b06f2da33', 1),
-    ( 'brooks87', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
-    ( 'mike43', 'morgan', 'ross', 'Male', '024142778439c88b675219780cf1c578', 1),
-    ( 'ross30', 'mark', 'david', 'Male', 'c5fb92d8af4e45581d8bb7c71a5c0a1c', 1),
-    ( 'jenny0981', 'morgan', 'brooks', 'Female', '34ae1e72198b2ccb37610a3269368fa7', 1),
-    ( 'cooper11', 'james', 'james', 'Female', '243cffae81569cca39840aec1e9e2852', 1),
-    ( 'rogers97', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
-    ( 'wright84', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
-    ( 'jenny0932', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
-    ( 'sanders40', 'paul', 'sanders', 'Female', 'be92492fa64d6126726a71584a75a878', 1),
-    ( 'john80', 'daniel', 'ross', 'Female', '8a608ab5ea3865ae688ac2f8b1cc3b61', 1),
-    ( 'brooks58', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
-    ( 'miller37', 'brooks', 'bell', 'Female', '62c1ace1a0aa8e798169f547586457c8', 1),
-    ( 'james93', 'chrishaydon', 'james', 'Male', '7ef00ef6e7f47472db040222dae2dc8e', 1),
-    ( 'bell16', 'bell', 'james', 'Male', '0d5e1a6eab996a7d1d32a0e2ed22ff2a', 1),
-    ( 'smith96', 'mike', 'bell', 'Female', '68f2fb17fd3f13d8a0a394de396ce447', 1),
-    ( 'ross94', 'david', 'bell', 'Male', '84c62bc5b6f1dab11a1ca3d8d7a5e29e', 1),
-    ( 'ross95', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
-    ( 'chrishaydon82', 'jenny09', 'maria', 'Female', '8093f823794b8fa03c379c035300fd0b', 1),
-    ( 'michael3', 'mike', 'jenny09', 'Male', 'fc13f6e30b38a8529a57add8a59b5ec0', 1),
-
-    ( 'jenny0918', 'rogers', 'brown', 'Male', '8b9e34ac36a2fd947614e2616f3d6826', 1),
-    ( 'rogers43', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
-    ( 'morris91', 'paul', 'rivera', 'Male', '613be5cffa8a578c1816a1baf6bb8501', 1),
-    ( 'john4', 'james', 'rivera', 'Female', '76ebe07cd3c27e769f71251f294d4c94', 1),
-    ( 'cooper5', 'wright', 'morris', 'Male', '8105c71bb32ba7bf731c4162ff9c1f21', 1),
-    ( 'chrishaydon20', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
-    ( 'chrishaydon44', 'mark', 'smith', 'Female', 'a54db1865fb0fa3a66861d93e037741d', 1),
-    ( 'daniel13', 'brooks', 'brown', 'Female', '91270e97491a918496e6569c9fcff808', 1),
-    ( 'chrishaydon37', 'brown', 'mark', 'Male', 'a3efe2fadf9e33423b80532af0f95c33', 1),
-    ( 'david31', 'wright', 'paul', 'Female', 'b8e0691f01b08a810fade049028d9c3e', 1),
-    ( 'mark21', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
-    ( 'wright81', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
-    ( 'michael13', 'morris', 'cooper', 'Female', '9d450e0529ca7328d07679163206f732', 1),
-    ( 'rogers63', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
-    ( 'mike28', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
-    ( 'rivera92', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
-    ( 'ross95', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
-    ( 'paul85', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
-    ( 'smith34', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
-    ( 'james84', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
-    ( 'daniel53', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
-    ( 'brooks80', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
-    ( 'morgan65', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
-    ( 'sanders84', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
-    ( 'maria40', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
-    ( 'brown71', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
-    ( 'james63', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
-    ( 'jenny0993', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
-    ( 'john96', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
-    ( 'miller64', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
-    ( 'mark46', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
-    ( 'jenny0988', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
-    ( 'mark80', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
-    ( 'morris72', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
-    ( 'wright39', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
-    ( 'paul68', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
-    ( 'smith60', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
-    ( 'bell43', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
-    ( 'rogers79', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
-    ( 'daniel56', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
-    ( 'brooks85', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
-    ( 'mike30', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
-    ( 'paul92', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
-    ( 'bell96', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
-    ( 'john8', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
-    ( 'chrishaydon12', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
-    ( 'morgan13', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
-    ( 'james83', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
-    ( 'chrishaydon8', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
-    ( 'ross85', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
-    ( 'ross46', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
-    ( 'smith4', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
-    ( 'paul4', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
-    ( 'daniel26', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
-    ( 'chrishaydon2', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
-    ( 'david82', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
-    ( 'john97', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
-    ( 'david57', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
-    ( 'rivera100', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
-    ( 'bell13', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
-    ( 'brooks65', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
-    ( 'daniel40', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
-    ( 'cooper100', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
-    ( 'morris14', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
-    ( 'smith82', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
-    ( 'cooper35', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
-    ( 'morgan94', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
-    ( 'michael92', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
-    ( 'sanders48', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
-    ( 'brown76', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
-    ( 'james16', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
-    ( 'michael26', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
-    ( 'wright57', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
-    ( 'wright68', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
-    ( 'brooks1', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
-    ( 'bell2', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
-    ( 'miller100', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
-    ( 'rogers53', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
-    ( 'mike1', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
-    ( 'cooper57', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
-    ( 'daniel38', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
-    ( 'mark2', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
-    ( 'daniel79', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
-    ( 'wright4', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
-    ( 'brown84', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
-    ( 'paul41', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
-    ( 'mark5', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
-    ( 'jenny0994', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
-    ( 'morris53', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
-    ( 'paul68', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
-    ( 'brooks86', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
-    ( 'james54', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
-    ( 'rogers58', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
-    ( 'maria31', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
-    ( 'david5', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
-    ( 'mark21', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
-    ( 'jenny0932', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
-    ( 'john92', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
-    ( 'rogers98', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
-    ( 'rogers95', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
-    ( 'james50', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
-    ( 'miller80', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
-    ( 'mark29', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
-    ( 'cooper77', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
-    ( 'john24', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
-    ( 'chrishaydon32', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
-    ( 'bell41', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
-    ( 'ross99', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
-    ( 'smith9', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
-    ( 'miller73', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
-    ( 'michael44', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
-    ( 'michael36', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
-    ( 'smith93', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
-    ( 'morgan38', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
-    ( 'brown70', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
-    ( 'mike14', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
-    ( 'daniel72', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
-    ( 'jenny0974', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
-    ( 'maria51', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
-    ( 'michael97', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
-    ( 'chrishaydon33', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
-    ( 'sanders42', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
-    ( 'brooks87', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
-    ( 'bell4', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
-    ( 'jenny0945', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
-    ( 'james65', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
-    ( 'john98', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
-    ( 'paul80', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
-    ( 'john92', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
-    ( 'wright69', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
-    ( 'bell68', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
-    ( 'brooks97', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
-    ( 'mark14', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
-    ( 'david60', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
-    ( 'rivera68', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
-    ( 'brooks32', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
-    ( 'brown15', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
-    ( 'bell88', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
-    ( 'john26', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
-    ( 'paul55', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
-    ( 'david59', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
-    ( 'morris73', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
-    ( 'michael77', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
-    ( 'mark5', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
-    ( 'james65', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
-    ( 'maria39', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
-    ( 'michael37', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
-    ( 'sanders1', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
-    ( 'daniel30', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
-    ( 'brown53', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
-    ( 'john52', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
-    ( 'wright73', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
-    ( 'mark63', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
-    ( 'james29', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
-    ( 'mark23', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
-    ( 'cooper22', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
-    ( 'miller19', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
-    ( 'jenny0913', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
-    ( 'maria100', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
-    ( 'chrishaydon51', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
-    ( 'david9', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
-    ( 'mike52', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
-    ( 'wright5', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
-    ( 'brown21', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
-    ( 'daniel46', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
-    ( 'brown86', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
-    ( 'chrishaydon85', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
-    ( 'john41', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
-    ( 'mark72', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
-    ( 'michael67', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
-    ( 'jenny0943', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
-    ( 'daniel61', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
-    ( 'morgan34', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
-    ( 'jenny097', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
-    ( 'miller11', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
-    ( 'daniel99', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
-    ( 'miller20', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
-    ( 'sanders29', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
-    ( 'mark43', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
-    ( 'james86', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
-    ( 'chrishaydon74', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
-    ( 'brooks37', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
-    ( 'michael78', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
-    ( 'paul9', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
-    ( 'james57', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
-    ( 'mark91', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
-    ( 'wright30', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
-    ( 'rogers97', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
-    ( 'daniel8', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
-    ( 'mark66', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
-    ( 'daniel6', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
-    ( 'bell72', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
-    ( 'morgan77', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
-    ( 'bell24', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
-    ( 'michael31', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
-    ( 'cooper98', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
-    ( 'daniel41', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
-    ( 'michael1', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
-    ( 'chrishaydon20', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
-    ( 'daniel66', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
-    ( 'cooper52', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
-    ( 'chrishaydon99', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
-    ( 'smith66', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
-    ( 'mike48', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
-    ( 'rivera80', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
-    ( 'morgan35', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
-    ( 'brooks16', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
-    ( 'mike78', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
-    ( 'michael38', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
-    ( 'mark7', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
-    ( 'rogers80', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
-    ( 'wright81', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
-    ( 'michael21', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
-    ( 'daniel56', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
-    ( 'ross3', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
-    ( 'chrishaydon72', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
-    ( 'sanders66', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
-    ( 'michael4', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
-    ( 'brown66', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
-    ( 'wright26', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
-    ( 'james81', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
-    ( 'morris21', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
-    ( 'john30', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
-    ( 'jenny0929', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
-    ( 'wright91', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
-    ( 'jenny0922', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
-    ( 'rivera35', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
-    ( 'rogers53', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
-    ( 'ross50', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
-    ( 'ross41', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
-    ( 'rivera78', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
-    ( 'miller43', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
-    ( 'morgan59', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
-    ( 'chrishaydon63', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
-    ( 'wright93', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
-    ( 'maria62', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
-    ( 'mark85', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
-    ( 'wright3', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
-    ( 'brooks37', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
-    ( 'paul66', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
-    ( 'rivera36', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
-    ( 'paul42', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
-    ( 'morgan93', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
-    ( 'rivera43', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
-    ( 'daniel71', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
-    ( 'mike81', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
-    ( 'daniel83', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
-    ( 'smith54', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
-    ( 'sanders38', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
-    ( 'morris63', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
-    ( 'michael25', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
-    ( 'smith89', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
-    ( 'john97', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
-    ( 'jenny0985', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
-    ( 'wright84', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
-    ( 'maria53', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
-    ( 'brooks72', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
-    ( 'brown61', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
-    ( 'paul95', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
-    ( 'brooks92', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
-    ( 'james44', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
-    ( 'chrishaydon100', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
-    ( 'mark97', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
-    ( 'brooks91', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
-    ( 'jenny0929', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
-    ( 'morgan37', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
-    ( 'daniel43', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
-    ( 'mike98', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
-    ( 'john48', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
-    ( 'brooks65', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
-    ( 'cooper18', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
-    ( 'chrishaydon54', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
-    ( 'john67', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
-    ( 'morris27', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
-    ( 'ross45', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
-    ( 'rogers85', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
-    ( 'sanders96', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
-    ( 'smith70', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
-    ( 'wright31', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
-    ( 'paul10', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
-    ( 'sanders2', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
-    ( 'rogers14', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
-    ( 'morgan79', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
-    ( 'morris87', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
-    ( 'james6', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
-    ( 'wright33', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
-    ( 'maria85', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
-    ( 'ross31', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
-    ( 'chrishaydon75', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
-    ( 'david54', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
-    ( 'chrishaydon50', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
-    ( 'miller24', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
-    ( 'maria9', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
-    ( 'smith91', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
-    ( 'rivera11', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
-    ( 'james72', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
-    ( 'miller36', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
-    ( 'jenny0991', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
-    ( 'jenny0959', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
-    ( 'mike41', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
-    ( 'morgan20', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
-    ( 'miller29', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
-    ( 'ross56', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
-    ( 'chrishaydon63', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
-    ( 'morris54', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
-    ( 'bell20', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
-    ( 'paul41', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
-    ( 'morgan42', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
-    ( 'ross100', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
-    ( 'wright1', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
-    ( 'jenny0921', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
-    ( 'paul37', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
-    ( 'michael92', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
-    ( 'chrishaydon14', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
-    ( 'miller40', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
-    ( 'wright43', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
-    ( 'smith15', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
-    ( 'miller49', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
-    ( 'morris21', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
-    ( 'ross27', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
-    ( 'rivera16', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
-    ( 'maria73', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
-    ( 'chrishaydon60', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
-    ( 'david40', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
-    ( 'michael66', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
-    ( 'paul27', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
-    ( 'jenny0911', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
-    ( 'miller79', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
-    ( 'mark14', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
-    ( 'ross87', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
-    ( 'paul47', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
-    ( 'sanders61', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
-    ( 'rivera13', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
-    ( 'chrishaydon22', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
-    ( 'sanders42', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
-    ( 'david78', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
-    ( 'ross60', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
-    ( 'morris24', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
-    ( 'jenny0952', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
-    ( 'rogers72', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
-    ( 'daniel70', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
-    ( 'cooper76', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
-    ( 'jenny0942', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
-    ( 'daniel39', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
-    ( 'morris59', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
-    ( 'mike70', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
-    ( 'rogers20', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
-    ( 'david33', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
-    ( 'morris65', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
-    ( 'michael23', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
-    ( 'john96', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
-    ( 'wright64', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
-    ( 'david91', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
-    ( 'daniel87', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
-    ( 'ross60', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
-    ( 'morris60', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
-    ( 'rivera50', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
-    ( 'maria77', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
-    ( 'morgan64', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
-    ( 'miller41', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
-    ( 'david65', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
-    ( 'morgan96', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
-    ( 'ross73', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
-    ( 'brooks95', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
-    ( 'sanders92', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
-    ( 'cooper53', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
-    ( 'ross100', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
-    ( 'michael91', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
-    ( 'brooks51', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
-    ( 'ross26', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
-    ( 'morris58', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
-    ( 'morris58', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
-    ( 'ross12', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
-    ( 'brooks60', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
-    ( 'mark29', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
-    ( 'john14', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
-    ( 'rivera26', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
-    ( 'jenny0949', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
-    ( 'rogers96', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
-    ( 'david55', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
-    ( 'chrishaydon3', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
-    ( 'ross81', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
-    ( 'john70', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
-    ( 'miller34', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
-    ( 'bell26', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
-    ( 'ross92', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
-    ( 'rogers34', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
-    ( 'james51', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
-    ( 'ross54', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
-    ( 'brown59', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
-    ( 'michael83', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
-    ( 'miller10', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
-    ( 'jenny0939', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
-    ( 'paul100', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
-    ( 'smith82', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
-    ( 'morris6', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
-    ( 'paul76', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
-    ( 'mark97', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
-    ( 'john74', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
-    ( 'miller78', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
-    ( 'miller54', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
-    ( 'chrishaydon9', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
-    ( 'daniel4', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
-    ( 'john24', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
-    ( 'smith31', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
-    ( 'smith77', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
-    ( 'maria29', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
-    ( 'michael76', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
-    ( 'mark64', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
-    ( 'morris5', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
-    ( 'brown69', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
-    ( 'miller53', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
-    ( 'wright94', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
-    ( 'rogers26', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
-    ( 'jenny0977', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
-    ( 'daniel23', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
-    ( 'cooper66', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
-    ( 'chrishaydon61', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
-    ( 'maria95', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
-    ( 'james17', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
-    ( 'james18', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
-    ( 'mike47', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
-    ( 'john57', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
-    ( 'mark54', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
-    ( 'bell38', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
-    ( 'morgan31', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
-    ( 'miller16', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
-    ( 'rivera14', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
-    ( 'rogers22', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
-    ( 'jenny0963', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
-    ( 'mark42', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
-    ( 'mark7', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
-    ( 'john1', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
-    ( 'chrishaydon3', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
-    ( 'smith11', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
-    ( 'john22', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
-    ( 'rivera23', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
-    ( 'james32', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
-    ( 'daniel58', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
-    ( 'james18', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
-    ( 'brown93', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
-    ( 'bell89', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
-    ( 'miller82', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
-    ( 'paul21', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
-    ( 'chrishaydon88', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
-    ( 'rivera44', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
-    ( 'bell89', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
-    ( 'bell66', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
-    ( 'rogers31', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
-    ( 'bell47', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
-    ( 'sanders58', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
-    ( 'mark26', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
-    ( 'miller22', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
-    ( 'daniel46', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
-    ( 'morgan100', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
-    ( 'ross75', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
-    ( 'david68', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
-    ( 'rivera35', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
-    ( 'daniel63', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
-    ( 'ross37', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
-    ( 'michael23', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
-    ( 'mike48', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
-    ( 'david57', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
-    ( 'morris67', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
-    ( 'rogers49', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
-    ( 'morris14', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
-    ( 'brooks74', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
-    ( 'mike71', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
-    ( 'mark18', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
-    ( 'mark9', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
-    ( 'brooks21', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
-    ( 'bell4', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
-    ( 'brown17', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
-    ( 'jenny0923', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
-    ( 'smith66', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
-    ( 'daniel53', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
-    ( 'brown33', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
-    ( 'mark55', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
-    ( 'rivera83', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
-    ( 'paul72', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
-    ( 'david25', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
-    ( 'paul47', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
-    ( 'james61', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
-    ( 'paul90', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
-    ( 'david95', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
-    ( 'paul52', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
-    ( 'wright82', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
-    ( 'james61', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
-    ( 'michael89', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
-    ( 'wright44', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
-    ( 'chrishaydon60', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
-    ( 'john30', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
-    ( 'rivera2', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
-    ( 'wright17', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
-    ( 'rivera30', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
-    ( 'daniel63', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
-    ( 'brooks56', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
-    ( 'wright29', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
-    ( 'morris52', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
-    ( 'morgan10', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
-    ( 'sanders55', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
-    ( 'mike9', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
-    ( 'michael69', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
-    ( 'david18', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
-    ( 'daniel41', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
-    ( 'david59', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
-    ( 'daniel17', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
-    ( 'john45', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
-    ( 'morgan17', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
-    ( 'john42', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
-    ( 'rogers50', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
-    ( 'smith35', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
-    ( 'mark56', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
-    ( 'chrishaydon12', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
-    ( 'mark90', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
-    ( 'maria85', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
-    ( 'rogers42', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
-    ( 'brooks95', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
-    ( 'brooks38', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
-    ( 'morris49', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
-    ( 'david86', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
-    ( 'wright15', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
-    ( 'wright75', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
-    ( 'sanders78', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
-    ( 'jenny092', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
-    ( 'cooper68', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
-    ( 'paul87', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
-    ( 'brooks59', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
-    ( 'rivera36', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
-    ( 'cooper25', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
-    ( 'mike3', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
-    ( 'rivera24', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
-    ( 'wright89', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
-    ( 'cooper12', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
-    ( 'paul32', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
-    ( 'wright11', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
-    ( 'maria51', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
-    ( 'morris85', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
-    ( 'chrishaydon88', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
-    ( 'brown49', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
-    ( 'mike55', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
-    ( 'paul6', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
-    ( 'james19', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
-    ( 'brown35', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
-    ( 'chrishaydon15', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
-    ( 'jenny0962', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
-    ( 'brooks57', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
-    ( 'chrishaydon7', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
-    ( 'morgan15', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
-    ( 'chrishaydon47', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
-    ( 'brooks41', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
-    ( 'mark61', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
-    ( 'brooks82', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
-    ( 'maria6', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
-    ( 'brown2', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
-    ( 'maria94', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
-    ( 'smith95', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
-    ( 'ross3', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
-    ( 'smith24', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
-    ( 'john19', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
-    ( 'michael93', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
-    ( 'bell35', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
-    ( 'david80', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
-    ( 'paul3', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
-    ( 'michael27', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
-    ( 'smith12', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
-    ( 'maria61', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
-    ( 'daniel3', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
-    ( 'jenny0923', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
-    ( 'morris5', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
-    ( 'ross58', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
-    ( 'michael48', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
-    ( 'brown92', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
-    ( 'morgan54', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
-    ( 'sanders87', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
-    ( 'paul54', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
-    ( 'cooper59', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
-    ( 'brooks16', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
-    ( 'rivera44', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
-    ( 'sanders60', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
-    ( 'morgan82', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
-    ( 'john69', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
-    ( 'david36', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
-    ( 'mike88', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
-    ( 'david9', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
-    ( 'david34', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
-    ( 'sanders20', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
-
-    ( 'mike22', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
-    ( 'maria63', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
-    ( 'miller94', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
-    ( 'chrishaydon17', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
-    ( 'brooks60', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
-    ( 'bell34', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
-    ( 'chrishaydon65', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
-    ( 'paul70', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
-    ( 'jenny0972', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
-    ( 'john41', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
-    ( 'smith78', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
-    ( 'morgan55', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
-    ( 'smith81', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
-    ( 'jenny0988', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
-    ( 'chrishaydon49', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
-    ( 'morris31', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
-    ( 'mike15', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
-    ( 'smith61', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
-    ( 'chrishaydon42', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
-    ( 'john62', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
-    ( 'maria40', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
-    ( 'james50', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
-    ( 'brown93', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
-    ( 'maria85', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
-    ( 'miller63', 'morgan', 'morris', 'Female', 'ee33d4596af639660986c67f04e6ff92', 1),
-    ( 'smith50', 'brown', 'brown', 'Female', 'bd1e4b23c510e1eae5fa69baa618f3e2', 1),
-    ( 'mike83', 'smith', 'cooper', 'Female', '4a3309bacad462ce5af197a808839446', 1),
-    ( 'mark90', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
-    ( 'morris71', 'rogers', 'sanders', 'Male', 'a7fd9a391ed17cc9be339bcb87af3451', 1),
-    ( 'brown70', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
-    ( 'jenny0930', 'bell', 'rivera', 'Female', 'c25f2f612dde293ec2155a5dfe7901f2', 1),
-    ( 'smith60', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
-    ( 'cooper56', 'ross', 'mike', 'Female', '81b32a932116fed6d09aaa2c2bc522df', 1),
-    ( 'chrishaydon67', 'wright', 'mark', 'Female', 'a4808f35e9eca8fd90326ad5632ff220', 1),
-    ( 'cooper90', 'ross', 'rivera', 'Male', '077380568f1971f1003978046142baeb', 1),
-    ( 'morris82', 'ross', 'miller', 'Female', '8d863a559ac2d9fba0dfa98649e55637', 1),
-    ( 'morgan3', 'sanders', 'mark', 'Male', '4d5a20ef5496dae22ea2bbfa40defc12', 1),
-    ( 'daniel25', 'michael', 'ross', 'Female', '6794c36a5638c0c0df58155493512e60', 1),
-    ( 'rivera64', 'morgan', 'mike', 'Female', '041dcc147d542af1dc6bf50192c1b10f', 1),
-    ( 'bell41', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
-    ( 'rivera34', 'bell', 'paul', 'Male', '7d4a65a26f424093d93857b14104585e', 1),
-    ( 'chrishaydon22', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
-    ( 'miller53', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
-    ( 'miller58', 'sanders', 'paul', 'Male', '3fa66dc95e8f6e524cd5afbf4abfebdf', 1),
-    ( 'wright41', 'smith', 'sanders', 'Female', '7ba55de0a483cd485e1629176c9fa5ac', 1),
-    ( 'john69', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
-    ( 'smith6', 'smith', 'paul', 'Female', '69b7b0f8981d3f462bddcd10e83d73b8', 1),
-    ( 'rogers88', 'miller', 'rivera', 'Male', '18b2b0e6b66ce69949cfd500bdbd547b', 1),
-    ( 'john98', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
-    ( 'paul69', 'john', 'morgan', 'Male', '98bdeac0dbd9377cd342ab3e2ed67002', 1),
-    ( 'morris55', 'wright', 'brown', 'Male', 'e808e39b0369890ca613a24d1bdbe80e', 1),
-    ( 'paul3', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
-    ( 'rogers28', 'brown', 'brown', 'Male', 'cb1b08d86f6053ce02919717671bd81f', 1),
-    ( 'brown16', 'james', 'chrishaydon', 'Female', 'ef70b7e590eb6ef3d09bdb05084610e4', 1),
-    ( 'david68', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
-    ( 'michael8', 'paul', 'morgan', 'Female', '6691fbe0b3d4d260b0ada3cd9c0b78f5', 1),
-    ( 'sanders67', 'brooks', 'paul', 'Male', 'ddf8e1b6ed97cdf30cb35d0f9e47517b', 1),
-    ( 'rivera75', 'brooks', 'brooks', 'Male', 'fb77723ac6a75b2dfa7636213fc12803', 1),
-    ( 'miller81', 'ross', 'david', 'Female', 'e5e1fa5d4c971a980e6d087016507eb7', 1),
-    ( 'jenny0952', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
-    ( 'rivera53', 'bell', 'smith', 'Female', '1e3e64c56121038797a7e50b655464c6', 1),
-    ( 'morgan33', 'wright', 'brown', 'Male', '8e5a808681a0070beaf455c2cabb782b', 1),
-    ( 'ross27', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
-    ( 'miller94', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
-    ( 'michael14', 'paul', 'sanders', 'Female', '9462cebb50643d2a7731b89c5b1b8fe3', 1),
-    ( 'rogers60', 'mark', 'smith', 'Male', 'bef7a008c13dcc9dd7947e5f248a1cfd', 1),
-    ( 'maria50', 'rivera', 'miller', 'Male', '64b7a989ccd1e83d881215cddbeafb72', 1),
-    ( 'morgan96', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
-    ( 'ross49', 'sanders', 'jenny09', 'Female', '53083ad87812f62ccd391f01069e77b5', 1),
-    ( 'wright37', 'miller', 'chrishaydon', 'Male', '0f8b225730e5be91f0f4cbb29156cf44', 1),
-    ( 'brown38', 'ross', 'morgan', 'Male', '07a841d9d548265d67ac8897f60f1b06', 1),
-    ( 'michael50', 'mike', 'rogers', 'Female', 'a366cae9b72e785998b0ce78a2284bdb', 1),
-    ( 'wright93', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
-    ( 'miller91', 'mike', 'chrishaydon', 'Male', 'edb8e845ed0dc8c888f7225fa43eb2a6', 1),
-    ( 'daniel21', 'cooper', 'sanders', 'Female', 'df3e462d93781ea422c125a4c2b5566a', 1),
-    ( 'bell44', 'ross', 'smith', 'Male', 'a49601f3e6a85c0387539bba4bcdbe6c', 1),
-    ( 'brooks58', 'ross', 'john', 'Male', '6e5b2a0375b4d4d500ee07e62cad6d6c', 1),
-    ( 'chrishaydon86', 'sanders', 'david', 'Female', 'c3bf2214f10104ec078bed756539f9af', 1),
-    ( 'jenny0910', 'paul', 'smith', 'Male', '58eea070ea61891bfea5954c38cb9336', 1),
-    ( 'rivera53', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
-    ( 'brown75', 'sanders', 'rivera', 'Female', '7c5cef8a2e387abe6908ee2f3fbc534a', 1),
-    ( 'maria40', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
-    ( 'john12', 'sanders', 'sanders', 'Male', 'cba0f422fbd342f81feb1dd78a43cad6', 1),
-    ( 'sanders88', 'paul', 'miller', 'Male', 'b6153be014b1af1811c85aa770c4cc46', 1),
-    ( 'morris98', 'morgan', 'brooks', 'Female', 'd793df005919fa5c4f45a61a7f9f8e87', 1),
-    ( 'miller17', 'daniel', 'brown', 'Female', 'a11bf4bd7d2f8c8162d97c7447ab07f8', 1),
-    ( 'cooper84', 'miller', 'miller', 'Male', '941752ab63605763d87142453aa35988', 1),
-    ( 'ross49', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
-    ( 'morgan54', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
-    ( 'chrishaydon29', 'wright', 'cooper', 'Female', 'b6ed3804fd6ad8ccd1c85c0a7deda4e3', 1),
-    ( 'paul3', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
-    ( 'morris98', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
-    ( 'cooper55', 'mark', 'ross', 'Female', '6c794b04248c5d6120dd84b8e94967a7', 1),
-    ( 'rivera85', 'brown', 'brooks', 'Female', '0ca4d10e4c787cc061e18feb815f747b', 1),
-    ( 'rogers43', 'jenny09', 'mike', 'Female', 'aa98ad5a318401147dc9580a9e134a7e', 1),
-    ( 'ross42', 'mark', 'michael', 'Female', '1507a0565d69991ff963cd3489b1c491', 1),
-    ( 'james29', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
-    ( 'chrishaydon20', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
-    ( 'wright68', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
-    ( 'cooper47', 'ross', 'paul', 'Male', '524f2968fabb85f0fdb2fa63110f6690', 1),
-    ( 'morgan73', 'chrishaydon', 'cooper', 'Male', '33b7c4b03c90ac30be52d86c3229577b', 1),
-    ( 'maria82', 'daniel', 'daniel', 'Male', '9a83d41058fafd2a50b9fcce02811361', 1),
-    ( 'john8', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
-    ( 'miller63', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
-    ( 'morgan26', 'michael', 'rogers', 'Male', 'e4a653c83b7fa702099b4c780e5fa68d', 1),
-    ( 'rivera69', 'brown', 'david', 'Female', 'b58472e08b58b46875487093c2867ec6', 1),
-    ( 'daniel31', 'john', 'rogers', 'Male', '9d84f6219123fb1920cf2e7494a44d77', 1),
-    ( 'sanders100', 'michael', 'john', 'Male', '349fda3c9d90de7e62c9ada6c0550a8b', 1),
-    ( 'mark84', 'morris', 'chrishaydon', 'Female', '8f9307568ba555323b0599ce0c715b1a', 1),
-    ( 'smith56', 'david', 'paul', 'Male', 'baaf6963e059f92921d196b5fef6669d', 1),
-    ( 'morris93', 'chrishaydon', 'james', 'Female', 'dba13ee70b381ea5b9ceac0ece3d850f', 1),
-    ( 'michael45', 'michael', 'paul', 'Female', 'd9f0344646b6ba7f224d9d3a0986cc7e', 1),
-    ( 'mike88', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
-    ( 'morgan96', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
-    ( 'james5', 'brooks', 'mike', 'Female', 'ac332ec30f36d30d5bf9221fe1b32bf0', 1),
-    ( 'morris83', 'sanders', 'maria', 'Male', 'fcb81feea910ea24117d4f26171991f8', 1),
-    ( 'rivera19', 'rivera', 'bell', 'Female', '0ba1e8782ffcf3e71c079e1e54c0a41c', 1),
-    ( 'daniel57', 'paul', 'bell', 'Female', '62d04b1633be93a4b4f93256a56e28d0', 1),
-    ( 'maria10', 'wright', 'sanders', 'Female', '9c8c23c03a74a7a320d742aa95cf08e3', 1),
-    ( 'david71', 'jenny09', 'david', 'Male', '16a061f8fdf5c93e9e77631dfb7d471a', 1),
-    ( 'sanders88', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
-    ( 'rogers37', 'chrishaydon', 'brown', 'Male', '822141a673303a54b9387d8fc6a0c425', 1),
-    ( 'chrishaydon10', 'chrishaydon', 'john', 'Male', '1b7d631cf551c86b671fd6bc2ec9d0dc', 1),
-    ( 'sanders27', 'bell', 'morris', 'Male', '576352444376fe3069d256ded463666f', 1),
-    ( 'brown17', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
-    ( 'morris86', 'rogers', 'ross', 'Male', '896b690b9566f53a875e03a3a324c091', 1),
-    ( 'mark79', 'bell', 'sanders', 'Male', 'cee8dbd9130bccbe4d945c9cfc41f330', 1),
-    ( 'david58', 'david', 'morgan', 'Female', 'ca391efb9f114371dced52c8eb18a412', 1),
-    ( 'daniel95', 'wright', 'ross', 'Male', '4458808784017babe1bb3ed79c61f0f7', 1),
-    ( 'brooks49', 'sanders', 'morgan', 'Female', 'dbd61cc2c16fa763662c8a29d63215e0', 1),
-    ( 'jenny0926', 'wright', 'david', 'Female', 'd2d4451b918dbb9730bb7e010a50ab60', 1),
-    ( 'bell37', 'morgan', 'john', 'Female', '9019a44759e7bdece498cc5dce570446', 1),
-    ( 'john46', 'miller', 'john', 'Female', 'c618d2bfb0bf88f1de98313820f226a8', 1),
-    ( 'chrishaydon43', 'miller', 'chrishaydon', 'Female', 'e63ca65668b82c9fd07eef6b719ad4aa', 1),
-    ( 'rogers19', 'mike', 'john', 'Male', '59843bfb2ca080a23bcbbc5278fa6982', 1),
-    ( 'brooks29', 'rivera', 'rivera', 'Female', 'f151014abd62636e598dbad4a5b43aa3', 1),
-    ( 'chrishaydon74', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
-    ( 'brooks43', 'morgan', 'cooper', 'Male', '669e54b4368a4a99595fe4a56b9bf6ff', 1),
-    ( 'ross44', 'morgan', 'smith', 'Female', 'a84cf2d984ed2eb7948b9fda85275a7f', 1),
-    ( 'bell63', 'chrishaydon', 'james', 'Male', '863d1292a4a36cdc9134a7f84e8070c9', 1),
-    ( 'rivera41', 'maria', 'mark', 'Male', 'a9dd9815cf33560650fc661a97f41547', 1),
-    ( 'wright17', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
-    ( 'michael15', 'david', 'mark', 'Female', 'a167a5d9cf8157d98d45c1d0e32b72f4', 1),
-    ( 'ross53', 'morris', 'daniel', 'Female', 'b4a6baa51bd47cf407213f6ba9254f71', 1),
-    ( 'cooper65', 'brooks', 'mark', 'Female', '26f039d32dad1e54e8f74b015ea9ec68', 1),
-    ( 'rivera2', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
-    ( 'james32', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
-    ( 'mark97', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
-    ( 'smith93', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
-    ( 'chrishaydon84', 'james', 'brown', 'Male', '5957038b6a3f0a0f305123af998bc437', 1),
-    ( 'smith30', 'maria', 'wright', 'Male', '62d191a19bda44ba011ebd37976b5a23', 1),
-    ( 'maria51', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
-    ( 'rivera52', 'cooper', 'michael', 'Male', 'c15a7058e0c14b26f6863fbd7549a1e7', 1),
-    ( 'chrishaydon48', 'david', 'cooper', 'Female', 'e51d84e0afa82e704755b26fe59f566e', 1),
-    ( 'morgan90', 'mike', 'maria', 'Female', 'e78f1e49da6ebf2fe756ec38b646ba36', 1),
-    ( 'sanders53', 'bell', 'mark', 'Female', '15d2a8035ab8f89a8d2388049cdb1ee9', 1),
-    ( 'john47', 'sanders', 'sanders', 'Female', 'd3945958288a3f00f1d5fe7230670061', 1),
-    ( 'daniel100', 'paul', 'cooper', 'Male', '1ca7a74ac2257ad3c0f5885cb90099f2', 1),
-    ( 'wright13', 'brown', 'mark', 'Male', '35e1bd4961d74f12ed2e2399f219aaa9', 1),
-    ( 'morgan86', 'brown', 'daniel', 'Female', 'ba7d8e424270cfe9f48eb09c99ff68a1', 1),
-    ( 'wright17', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
-    ( 'morris93', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
-    ( 'james40', 'rogers', 'rogers', 'Male', 'b44bc9d82406f9a445889a66b255f271', 1),
-    ( 'ross47', 'sanders', 'chrishaydon', 'Male', '4592e91917d68abf8835aeae27f38cfc', 1),
-    ( 'wright8', 'bell', 'cooper', 'Male', 'e30161a98a0e9e3933dbb856e6af4719', 1),
-    ( 'ross83', 'morris', 'sanders', 'Female', '2ec9470fb5a92621648ad5c708b050f8', 1),
-    ( 'ross9', 'bell', 'sanders', 'Female', '85b8a1781fe0eda5fcdb86d950c2e239', 1),
-    ( 'miller47', 'morris', 'david', 'Female', '2fb2bd61613de4adc3ab2fc7fc05fe88', 1),
-    ( 'smith28', 'brown', 'john', 'Female', '2e3a6218c8c4652563470fd23b5c9004', 1),
-    ( 'morris2', 'bell', 'jenny09', 'Male', '9248ee5ee8d1ff997d0009348e2ea662', 1),
-    ( 'morris4', 'rogers', 'brooks', 'Male', 'd9290c1d84c18de491112fab16fc0172', 1),
-    ( 'jenny0926', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
-    ( 'morgan21', 'john', 'cooper', 'Male', 'c1c1328643f85dca460e8ead1600c650', 1),
-    ( 'david3', 'michael', 'miller', 'Male', '6c5c00ec2728ed2459e821dce4a85349', 1),
-    ( 'ross58', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
-    ( 'jenny0949', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
-    ( 'john96', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
-    ( 'paul5', 'rivera', 'ross', 'Male', '13a3756c2cb7b486e32054a08271d3f1', 1),
-    ( 'sanders80', 'michael', 'james', 'Female', '73f63edc5f6f3b571886fbb1316c43bc', 1),
-    ( 'smith65', 'brooks', 'bell', 'Male', '7ba86a4101f3074f458df9a6e43e3f5a', 1),
-    ( 'rogers26', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
-    ( 'maria43', 'miller', 'bell', 'Male', '0c879f0908f637ebe8cc0db994ae0832', 1),
-    ( 'david99', 'maria', 'jenny09', 'Female', 'b38a8c25edada7483420c20a76b9d4b0', 1),
-    ( 'wright20', 'smith', 'rogers', 'Female', 'e11d269be9b74106223b357b06f2da33', 1),
-    ( 'brooks87', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
-    ( 'mike43', 'morgan', 'ross', 'Male', '024142778439c88b675219780cf1c578', 1),
-    ( 'ross30', 'mark', 'david', 'Male', 'c5fb92d8af4e45581d8bb7c71a5c0a1c', 1),
-    ( 'jenny0981', 'morgan', 'brooks', 'Female', '34ae1e72198b2ccb37610a3269368fa7', 1),
-    ( 'cooper11', 'james', 'james', 'Female', '243cffae81569cca39840aec1e9e2852', 1),
-    ( 'rogers97', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
-    ( 'wright84', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
-    ( 'jenny0932', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
-    ( 'sanders40', 'paul', 'sanders', 'Female', 'be92492fa64d6126726a71584a75a878', 1),
-    ( 'john80', 'daniel', 'ross', 'Female', '8a608ab5ea3865ae688ac2f8b1cc3b61', 1),
-    ( 'brooks58', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
-    ( 'miller37', 'brooks', 'bell', 'Female', '62c1ace1a0aa8e798169f547586457c8', 1),
-    ( 'james93', 'chrishaydon', 'james', 'Male', '7ef00ef6e7f47472db040222dae2dc8e', 1),
-    ( 'bell16', 'bell', 'james', 'Male', '0d5e1a6eab996a7d1d32a0e2ed22ff2a', 1),
-    ( 'smith96', 'mike', 'bell', 'Female', '68f2fb17fd3f13d8a0a394de396ce447', 1),
-    ( 'ross94', 'david', 'bell', 'Male', '84c62bc5b6f1dab11a1ca3d8d7a5e29e', 1),
-    ( 'ross95', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
-    ( 'chrishaydon82', 'jenny09', 'maria', 'Female', '8093f823794b8fa03c379c035300fd0b', 1),
-    ( 'michael3', 'mike', 'jenny09', 'Male', 'fc13f6e30b38a8529a57add8a59b5ec0', 1),
-    ( 'jenny0918', 'rogers', 'brown', 'Male', '8b9e34ac36a2fd947614e2616f3d6826', 1),
-    ( 'rogers43', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
-    ( 'morris91', 'paul', 'rivera', 'Male', '613be5cffa8a578c1816a1baf6bb8501', 1),
-    ( 'john4', 'james', 'rivera', 'Female', '76ebe07cd3c27e769f71251f294d4c94', 1),
-    ( 'cooper5', 'wright', 'morris', 'Male', '8105c71bb32ba7bf731c4162ff9c1f21', 1),
-    ( 'chrishaydon20', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
-    ( 'chrishaydon44', 'mark', 'smith', 'Female', 'a54db1865fb0fa3a66861d93e037741d', 1),
-    ( 'daniel13', 'brooks', 'brown', 'Female', '91270e97491a918496e6569c9fcff808', 1),
-    ( 'chrishaydon37', 'brown', 'mark', 'Male', 'a3efe2fadf9e33423b80532af0f95c33', 1),
-    ( 'david31', 'wright', 'paul', 'Female', 'b8e0691f01b08a810fade049028d9c3e', 1),
-    ( 'mark21', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
-    ( 'wright81', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
-    ( 'michael13', 'morris', 'cooper', 'Female', '9d450e0529ca7328d07679163206f732', 1),
-    ( 'rogers63', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
-    ( 'mike28', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
-    ( 'rivera92', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
-    ( 'ross95', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
-    ( 'paul85', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
-    ( 'smith34', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
-    ( 'james84', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
-    ( 'daniel53', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
-    ( 'brooks80', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
-    ( 'morgan65', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
-    ( 'sanders84', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
-    ( 'maria40', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
-    ( 'brown71', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
-    ( 'james63', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
-    ( 'jenny0993', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
-    ( 'john96', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
-    ( 'miller64', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
-    ( 'mark46', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
-    ( 'jenny0988', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
-    ( 'mark80', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
-    ( 'morris72', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
-    ( 'wright39', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
-    ( 'paul68', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
-    ( 'smith60', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
-    ( 'bell43', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
-    ( 'rogers79', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
-    ( 'daniel56', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
-    ( 'brooks85', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
-    ( 'mike30', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
-    ( 'paul92', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
-    ( 'bell96', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
-    ( 'john8', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
-    ( 'chrishaydon12', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
-    ( 'morgan13', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
-    ( 'james83', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
-    ( 'chrishaydon8', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
-    ( 'ross85', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
-    ( 'ross46', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
-    ( 'smith4', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
-    ( 'paul4', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
-    ( 'daniel26', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
-    ( 'chrishaydon2', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
-    ( 'david82', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
-    ( 'john97', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
-    ( 'david57', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
-    ( 'rivera100', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
-    ( 'bell13', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
-    ( 'brooks65', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
-    ( 'daniel40', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
-    ( 'cooper100', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
-    ( 'morris14', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
-    ( 'smith82', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
-    ( 'cooper35', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
-    ( 'morgan94', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
-    ( 'michael92', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
-    ( 'sanders48', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
-    ( 'brown76', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
-    ( 'james16', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
-    ( 'michael26', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
-    ( 'wright57', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
-    ( 'wright68', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
-    ( 'brooks1', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
-    ( 'bell2', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
-    ( 'miller100', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
-    ( 'rogers53', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
-    ( 'mike1', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
-    ( 'cooper57', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
-    ( 'daniel38', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
-    ( 'mark2', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
-    ( 'daniel79', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
-    ( 'wright4', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
-    ( 'brown84', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
-    ( 'paul41', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
-    ( 'mark5', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
-    ( 'jenny0994', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
-    ( 'morris53', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
-    ( 'paul68', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
-    ( 'brooks86', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
-    ( 'james54', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
-    ( 'rogers58', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
-    ( 'maria31', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
-    ( 'david5', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
-    ( 'mark21', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
-    ( 'jenny0932', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
-    ( 'john92', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
-    ( 'rogers98', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
-    ( 'rogers95', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
-    ( 'james50', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
-    ( 'miller80', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
-    ( 'mark29', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
-    ( 'cooper77', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
-    ( 'john24', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
-    ( 'chrishaydon32', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
-    ( 'bell41', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
-    ( 'ross99', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
-    ( 'smith9', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
-    ( 'miller73', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
-    ( 'michael44', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
-    ( 'michael36', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
-    ( 'smith93', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
-    ( 'morgan38', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
-    ( 'brown70', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
-    ( 'mike14', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
-    ( 'daniel72', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
-    ( 'jenny0974', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
-    ( 'maria51', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
-    ( 'michael97', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
-    ( 'chrishaydon33', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
-    ( 'sanders42', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
-    ( 'brooks87', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
-    ( 'bell4', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
-    ( 'jenny0945', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
-    ( 'james65', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
-    ( 'john98', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
-    ( 'paul80', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
-    ( 'john92', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
-    ( 'wright69', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
-    ( 'bell68', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
-    ( 'brooks97', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
-    ( 'mark14', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
-    ( 'david60', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
-    ( 'rivera68', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
-    ( 'brooks32', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
-    ( 'brown15', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
-    ( 'bell88', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
-    ( 'john26', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
-    ( 'paul55', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
-    ( 'david59', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
-    ( 'morris73', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
-    ( 'michael77', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
-    ( 'mark5', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
-    ( 'james65', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
-    ( 'maria39', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
-    ( 'michael37', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
-    ( 'sanders1', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
-    ( 'daniel30', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
-    ( 'brown53', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
-    ( 'john52', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
-    ( 'wright73', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
-    ( 'mark63', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
-    ( 'james29', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
-    ( 'mark23', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
-    ( 'cooper22', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
-    ( 'miller19', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
-    ( 'jenny0913', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
-    ( 'maria100', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
-    ( 'chrishaydon51', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
-    ( 'david9', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
-    ( 'mike52', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
-    ( 'wright5', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
-    ( 'brown21', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
-    ( 'daniel46', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
-    ( 'brown86', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
-    ( 'chrishaydon85', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
-    ( 'john41', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
-    ( 'mark72', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
-    ( 'michael67', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
-    ( 'jenny0943', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
-    ( 'daniel61', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
-    ( 'morgan34', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
-    ( 'jenny097', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
-    ( 'miller11', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
-    ( 'daniel99', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
-    ( 'miller20', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
-    ( 'sanders29', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
-    ( 'mark43', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
-    ( 'james86', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
-    ( 'chrishaydon74', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
-    ( 'brooks37', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
-    ( 'michael78', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
-    ( 'paul9', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
-    ( 'james57', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
-    ( 'mark91', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
-    ( 'wright30', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
-    ( 'rogers97', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
-    ( 'daniel8', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
-    ( 'mark66', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
-    ( 'daniel6', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
-    ( 'bell72', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
-    ( 'morgan77', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
-    ( 'bell24', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
-    ( 'michael31', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
-    ( 'cooper98', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
-    ( 'daniel41', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
-    ( 'michael1', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
-    ( 'chrishaydon20', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
-    ( 'daniel66', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
-    ( 'cooper52', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
-    ( 'chrishaydon99', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
-    ( 'smith66', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
-    ( 'mike48', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
-    ( 'rivera80', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
-    ( 'morgan35', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
-    ( 'brooks16', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
-    ( 'mike78', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
-    ( 'michael38', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
-    ( 'mark7', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
-    ( 'rogers80', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
-    ( 'wright81', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
-    ( 'michael21', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
-    ( 'daniel56', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
-    ( 'ross3', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
-    ( 'chrishaydon72', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
-    ( 'sanders66', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
-    ( 'michael4', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
-    ( 'brown66', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
-    ( 'wright26', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
-    ( 'james81', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
-    ( 'morris21', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
-    ( 'john30', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
-    ( 'jenny0929', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
-    ( 'wright91', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
-    ( 'jenny0922', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
-    ( 'rivera35', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
-    ( 'rogers53', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
-    ( 'ross50', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
-    ( 'ross41', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
-    ( 'rivera78', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
-    ( 'miller43', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
-    ( 'morgan59', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
-    ( 'chrishaydon63', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
-    ( 'wright93', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
-    ( 'maria62', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
-    ( 'mark85', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
-    ( 'wright3', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
-    ( 'brooks37', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
-    ( 'paul66', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
-    ( 'rivera36', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
-    ( 'paul42', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
-    ( 'morgan93', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
-    ( 'rivera43', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
-    ( 'daniel71', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
-    ( 'mike81', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
-    ( 'daniel83', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
-    ( 'smith54', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
-    ( 'sanders38', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
-    ( 'morris63', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
-    ( 'michael25', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
-    ( 'smith89', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
-    ( 'john97', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
-    ( 'jenny0985', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
-    ( 'wright84', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
-    ( 'maria53', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
-    ( 'brooks72', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
-    ( 'brown61', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
-    ( 'paul95', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
-    ( 'brooks92', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
-    ( 'james44', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
-    ( 'chrishaydon100', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
-    ( 'mark97', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
-    ( 'brooks91', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
-    ( 'jenny0929', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
-    ( 'morgan37', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
-    ( 'daniel43', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
-    ( 'mike98', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
-    ( 'john48', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
-    ( 'brooks65', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
-    ( 'cooper18', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
-    ( 'chrishaydon54', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
-    ( 'john67', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
-    ( 'morris27', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
-    ( 'ross45', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
-    ( 'rogers85', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
-    ( 'sanders96', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
-    ( 'smith70', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
-    ( 'wright31', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
-    ( 'paul10', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
-    ( 'sanders2', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
-    ( 'rogers14', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
-    ( 'morgan79', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
-    ( 'morris87', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
-    ( 'james6', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
-    ( 'wright33', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
-    ( 'maria85', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
-    ( 'ross31', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
-    ( 'chrishaydon75', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
-    ( 'david54', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
-    ( 'chrishaydon50', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
-    ( 'miller24', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
-    ( 'maria9', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
-    ( 'smith91', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
-    ( 'rivera11', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
-    ( 'james72', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
-    ( 'miller36', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
-    ( 'jenny0991', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
-    ( 'jenny0959', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
-    ( 'mike41', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
-    ( 'morgan20', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
-    ( 'miller29', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
-    ( 'ross56', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
-    ( 'chrishaydon63', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
-    ( 'morris54', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
-    ( 'bell20', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
-    ( 'paul41', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
-    ( 'morgan42', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
-    ( 'ross100', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
-    ( 'wright1', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
-    ( 'jenny0921', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
-    ( 'paul37', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
-    ( 'michael92', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
-    ( 'chrishaydon14', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
-    ( 'miller40', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
-    ( 'wright43', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
-    ( 'smith15', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
-    ( 'miller49', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
-    ( 'morris21', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
-    ( 'ross27', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
-    ( 'rivera16', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
-    ( 'maria73', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
-    ( 'chrishaydon60', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
-    ( 'david40', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
-    ( 'michael66', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
-    ( 'paul27', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
-    ( 'jenny0911', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
-    ( 'miller79', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
-    ( 'mark14', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
-    ( 'ross87', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
-    ( 'paul47', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
-    ( 'sanders61', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
-    ( 'rivera13', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
-    ( 'chrishaydon22', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
-    ( 'sanders42', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
-    ( 'david78', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
-    ( 'ross60', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
-    ( 'morris24', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
-    ( 'jenny0952', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
-    ( 'rogers72', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
-    ( 'daniel70', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
-    ( 'cooper76', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
-    ( 'jenny0942', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
-    ( 'daniel39', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
-    ( 'morris59', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
-    ( 'mike70', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
-    ( 'rogers20', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
-    ( 'david33', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
-    ( 'morris65', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
-    ( 'michael23', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
-    ( 'john96', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
-    ( 'wright64', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
-    ( 'david91', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
-    ( 'daniel87', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
-    ( 'ross60', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
-    ( 'morris60', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
-    ( 'rivera50', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
-    ( 'maria77', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
-    ( 'morgan64', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
-    ( 'miller41', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
-    ( 'david65', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
-    ( 'morgan96', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
-    ( 'ross73', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
-    ( 'brooks95', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
-    ( 'sanders92', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
-    ( 'cooper53', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
-    ( 'ross100', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
-    ( 'michael91', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
-    ( 'brooks51', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
-    ( 'ross26', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
-    ( 'morris58', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
-    ( 'morris58', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
-    ( 'ross12', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
-    ( 'brooks60', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
-    ( 'mark29', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
-    ( 'john14', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
-    ( 'rivera26', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
-    ( 'jenny0949', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
-    ( 'rogers96', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
-    ( 'david55', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
-    ( 'chrishaydon3', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
-    ( 'ross81', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
-    ( 'john70', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
-    ( 'miller34', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
-    ( 'bell26', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
-    ( 'ross92', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
-    ( 'rogers34', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
-    ( 'james51', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
-    ( 'ross54', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
-    ( 'brown59', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
-    ( 'michael83', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
-    ( 'miller10', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
-    ( 'jenny0939', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
-    ( 'paul100', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
-    ( 'smith82', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
-    ( 'morris6', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
-    ( 'paul76', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
-    ( 'mark97', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
-    ( 'john74', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
-    ( 'miller78', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
-    ( 'miller54', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
-    ( 'chrishaydon9', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
-    ( 'daniel4', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
-    ( 'john24', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
-    ( 'smith31', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
-    ( 'smith77', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
-    ( 'maria29', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
-    ( 'michael76', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
-    ( 'mark64', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
-    ( 'morris5', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
-    ( 'brown69', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
-    ( 'miller53', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
-    ( 'wright94', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
-    ( 'rogers26', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
-    ( 'jenny0977', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
-    ( 'daniel23', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
-    ( 'cooper66', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
-    ( 'chrishaydon61', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
-    ( 'maria95', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
-    ( 'james17', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
-    ( 'james18', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
-    ( 'mike47', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
-    ( 'john57', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
-    ( 'mark54', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
-    ( 'bell38', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
-    ( 'morgan31', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
-    ( 'miller16', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
-    ( 'rivera14', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
-    ( 'rogers22', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
-    ( 'jenny0963', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
-    ( 'mark42', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
-    ( 'mark7', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
-    ( 'john1', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
-    ( 'chrishaydon3', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
-    ( 'smith11', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
-    ( 'john22', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
-    ( 'rivera23', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
-    ( 'james32', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
-    ( 'daniel58', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
-    ( 'james18', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
-    ( 'brown93', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
-    ( 'bell89', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
-    ( 'miller82', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
-    ( 'paul21', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
-    ( 'chrishaydon88', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
-    ( 'rivera44', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
-    ( 'bell89', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
-    ( 'bell66', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
-    ( 'rogers31', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
-    ( 'bell47', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
-    ( 'sanders58', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
-    ( 'mark26', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
-    ( 'miller22', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
-    ( 'daniel46', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
-    ( 'morgan100', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
-    ( 'ross75', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
-    ( 'david68', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
-    ( 'rivera35', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
-    ( 'daniel63', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
-    ( 'ross37', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
-    ( 'michael23', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
-    ( 'mike48', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
-    ( 'david57', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
-    ( 'morris67', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
-    ( 'rogers49', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
-    ( 'morris14', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
-    ( 'brooks74', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
-    ( 'mike71', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
-    ( 'mark18', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
-    ( 'mark9', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
-    ( 'brooks21', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
-    ( 'bell4', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
-    ( 'brown17', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
-    ( 'jenny0923', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
-    ( 'smith66', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
-    ( 'daniel53', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
-    ( 'brown33', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
-    ( 'mark55', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
-    ( 'rivera83', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
-    ( 'paul72', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
-    ( 'david25', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
-    ( 'paul47', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
-    ( 'james61', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
-    ( 'paul90', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
-    ( 'david95', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
-    ( 'paul52', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
-    ( 'wright82', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
-    ( 'james61', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
-    ( 'michael89', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
-    ( 'wright44', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
-    ( 'chrishaydon60', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
-    ( 'john30', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
-    ( 'rivera2', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
-    ( 'wright17', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
-    ( 'rivera30', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
-    ( 'daniel63', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
-    ( 'brooks56', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
-    ( 'wright29', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
-    ( 'morris52', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
-    ( 'morgan10', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
-    ( 'sanders55', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
-    ( 'mike9', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
-    ( 'michael69', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
-    ( 'david18', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
-    ( 'daniel41', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
-    ( 'david59', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
-    ( 'daniel17', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
-    ( 'john45', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
-    ( 'morgan17', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
-    ( 'john42', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
-    ( 'rogers50', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
-    ( 'smith35', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
-    ( 'mark56', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
-    ( 'chrishaydon12', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
-    ( 'mark90', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
-    ( 'maria85', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
-    ( 'rogers42', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
-    ( 'brooks95', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
-    ( 'brooks38', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
-    ( 'morris49', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
-    ( 'david86', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
-    ( 'wright15', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
-    ( 'wright75', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
-    ( 'sanders78', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
-    ( 'jenny092', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
-    ( 'cooper68', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
-    ( 'paul87', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
-    ( 'brooks59', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
-    ( 'rivera36', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
-    ( 'cooper25', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
-    ( 'mike3', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
-    ( 'rivera24', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
-    ( 'wright89', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
-    ( 'cooper12', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
-    ( 'paul32', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
-    ( 'wright11', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
-    ( 'maria51', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
-    ( 'morris85', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
-    ( 'chrishaydon88', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
-    ( 'brown49', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
-    ( 'mike55', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
-    ( 'paul6', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
-    ( 'james19', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
-    ( 'brown35', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
-    ( 'chrishaydon15', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
-    ( 'jenny0962', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
-    ( 'brooks57', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
-    ( 'chrishaydon7', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
-    ( 'morgan15', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
-    ( 'chrishaydon47', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
-    ( 'brooks41', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
-    ( 'mark61', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
-    ( 'brooks82', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
-    ( 'maria6', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
-    ( 'brown2', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
-    ( 'maria94', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
-    ( 'smith95', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
-    ( 'ross3', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
-    ( 'smith24', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
-    ( 'john19', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
-    ( 'michael93', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
-    ( 'bell35', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
-    ( 'david80', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
-    ( 'paul3', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
-    ( 'michael27', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
-    ( 'smith12', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
-    ( 'maria61', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
-    ( 'daniel3', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
-    ( 'jenny0923', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
-    ( 'morris5', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
-    ( 'ross58', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
-    ( 'michael48', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
-    ( 'brown92', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
-    ( 'morgan54', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
-    ( 'sanders87', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
-    ( 'paul54', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
-    ( 'cooper59', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
-    ( 'brooks16', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
-    ( 'rivera44', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
-    ( 'sanders60', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
-    ( 'morgan82', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
-    ( 'john69', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
-    ( 'david36', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
-    ( 'mike88', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
-    ( 'david9', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
-    ( 'david34', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
-    ( 'sanders20', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
-    ( 'mike22', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
-    ( 'maria63', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
-    ( 'miller94', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
-    ( 'chrishaydon17', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
-    ( 'brooks60', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
-    ( 'bell34', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
-    ( 'chrishaydon65', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
-    ( 'paul70', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
-    ( 'jenny0972', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
-    ( 'john41', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
-    ( 'smith78', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
-    ( 'morgan55', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
-    ( 'smith81', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
-    ( 'jenny0988', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
-    ( 'chrishaydon49', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
-    ( 'morris31', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
-    ( 'mike15', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
-    ( 'smith61', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
-    ( 'chrishaydon42', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
-    ( 'john62', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
-    ( 'maria40', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
-    ( 'james50', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
-    ( 'brown93', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
-    ( 'maria85', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
-    ( 'miller63', 'morgan', 'morris', 'Female', 'ee33d4596af639660986c67f04e6ff92', 1),
-    ( 'smith50', 'brown', 'brown', 'Female', 'bd1e4b23c510e1eae5fa69baa618f3e2', 1),
-    ( 'mike83', 'smith', 'cooper', 'Female', '4a3309bacad462ce5af197a808839446', 1),
-    ( 'mark90', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
-    ( 'morris71', 'rogers', 'sanders', 'Male', 'a7fd9a391ed17cc9be339bcb87af3451', 1),
-    ( 'brown70', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
-    ( 'jenny0930', 'bell', 'rivera', 'Female', 'c25f2f612dde293ec2155a5dfe7901f2', 1),
-    ( 'smith60', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
-    ( 'cooper56', 'ross', 'mike', 'Female', '81b32a932116fed6d09aaa2c2bc522df', 1),
-    ( 'chrishaydon67', 'wright', 'mark', 'Female', 'a4808f35e9eca8fd90326ad5632ff220', 1),
-    ( 'cooper90', 'ross', 'rivera', 'Male', '077380568f1971f1003978046142baeb', 1),
-    ( 'morris82', 'ross', 'miller', 'Female', '8d863a559ac2d9fba0dfa98649e55637', 1),
-    ( 'morgan3', 'sanders', 'mark', 'Male', '4d5a20ef5496dae22ea2bbfa40defc12', 1),
-    ( 'daniel25', 'michael', 'ross', 'Female', '6794c36a5638c0c0df58155493512e60', 1),
-    ( 'rivera64', 'morgan', 'mike', 'Female', '041dcc147d542af1dc6bf50192c1b10f', 1),
-    ( 'bell41', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
-    ( 'rivera34', 'bell', 'paul', 'Male', '7d4a65a26f424093d93857b14104585e', 1),
-    ( 'chrishaydon22', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
-    ( 'miller53', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
-    ( 'miller58', 'sanders', 'paul', 'Male', '3fa66dc95e8f6e524cd5afbf4abfebdf', 1),
-    ( 'wright41', 'smith', 'sanders', 'Female', '7ba55de0a483cd485e1629176c9fa5ac', 1),
-    ( 'john69', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
-    ( 'smith6', 'smith', 'paul', 'Female', '69b7b0f8981d3f462bddcd10e83d73b8', 1),
-    ( 'rogers88', 'miller', 'rivera', 'Male', '18b2b0e6b66ce69949cfd500bdbd547b', 1),
-    ( 'john98', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
-    ( 'paul69', 'john', 'morgan', 'Male', '98bdeac0dbd9377cd342ab3e2ed67002', 1),
-    ( 'morris55', 'wright', 'brown', 'Male', 'e808e39b0369890ca613a24d1bdbe80e', 1),
-    ( 'paul3', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
-    ( 'rogers28', 'brown', 'brown', 'Male', 'cb1b08d86f6053ce02919717671bd81f', 1),
-    ( 'brown16', 'james', 'chrishaydon', 'Female', 'ef70b7e590eb6ef3d09bdb05084610e4', 1),
-    ( 'david68', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
-    ( 'michael8', 'paul', 'morgan', 'Female', '6691fbe0b3d4d260b0ada3cd9c0b78f5', 1),
-    ( 'sanders67', 'brooks', 'paul', 'Male', 'ddf8e1b6ed97cdf30cb35d0f9e47517b', 1),
-    ( 'rivera75', 'brooks', 'brooks', 'Male', 'fb77723ac6a75b2dfa7636213fc12803', 1),
-    ( 'miller81', 'ross', 'david', 'Female', 'e5e1fa5d4c971a980e6d087016507eb7', 1),
-    ( 'jenny0952', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
-    ( 'rivera53', 'bell', 'smith', 'Female', '1e3e64c56121038797a7e50b655464c6', 1),
-    ( 'morgan33', 'wright', 'brown', 'Male', '8e5a808681a0070beaf455c2cabb782b', 1),
-    ( 'ross27', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
-    ( 'miller94', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
-    ( 'michael14', 'paul', 'sanders', 'Female', '9462cebb50643d2a7731b89c5b1b8fe3', 1),
-    ( 'rogers60', 'mark', 'smith', 'Male', 'bef7a008c13dcc9dd7947e5f248a1cfd', 1),
-    ( 'maria50', 'rivera', 'miller', 'Male', '64b7a989ccd1e83d881215cddbeafb72', 1),
-    ( 'morgan96', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
-    ( 'ross49', 'sanders', 'jenny09', 'Female', '53083ad87812f62ccd391f01069e77b5', 1),
-    ( 'wright37', 'miller', 'chrishaydon', 'Male', '0f8b225730e5be91f0f4cbb29156cf44', 1),
-    ( 'brown38', 'ross', 'morgan', 'Male', '07a841d9d548265d67ac8897f60f1b06', 1),
-    ( 'michael50', 'mike', 'rogers', 'Female', 'a366cae9b72e785998b0ce78a2284bdb', 1),
-    ( 'wright93', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
-    ( 'miller91', 'mike', 'chrishaydon', 'Male', 'edb8e845ed0dc8c888f7225fa43eb2a6', 1),
-    ( 'daniel21', 'cooper', 'sanders', 'Female', 'df3e462d93781ea422c125a4c2b5566a', 1),
-    ( 'bell44', 'ross', 'smith', 'Male', 'a49601f3e6a85c0387539bba4bcdbe6c', 1),
-    ( 'brooks58', 'ross', 'john', 'Male', '6e5b2a0375b4d4d500ee07e62cad6d6c', 1),
-    ( 'chrishaydon86', 'sanders', 'david', 'Female', 'c3bf2214f10104ec078bed756539f9af', 1),
-    ( 'jenny0910', 'paul', 'smith', 'Male', '58eea070ea61891bfea5954c38cb9336', 1),
-    ( 'rivera53', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
-    ( 'brown75', 'sanders', 'rivera', 'Female', '7c5cef8a2e387abe6908ee2f3fbc534a', 1),
-    ( 'maria40', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
-    ( 'john12', 'sanders', 'sanders', 'Male', 'cba0f422fbd342f81feb1dd78a43cad6', 1),
-    ( 'sanders88', 'paul', 'miller', 'Male', 'b6153be014b1af1811c85aa770c4cc46', 1),
-    ( 'morris98', 'morgan', 'brooks', 'Female', 'd793df005919fa5c4f45a61a7f9f8e87', 1),
-    ( 'miller17', 'daniel', 'brown', 'Female', 'a11bf4bd7d2f8c8162d97c7447ab07f8', 1),
-    ( 'cooper84', 'miller', 'miller', 'Male', '941752ab63605763d87142453aa35988', 1),
-    ( 'ross49', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
-    ( 'morgan54', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
-    ( 'chrishaydon29', 'wright', 'cooper', 'Female', 'b6ed3804fd6ad8ccd1c85c0a7deda4e3', 1),
-    ( 'paul3', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
-    ( 'morris98', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
-    ( 'cooper55', 'mark', 'ross', 'Female', '6c794b04248c5d6120dd84b8e94967a7', 1),
-    ( 'rivera85', 'brown', 'brooks', 'Female', '0ca4d10e4c787cc061e18feb815f747b', 1),
-    ( 'rogers43', 'jenny09', 'mike', 'Female', 'aa98ad5a318401147dc9580a9e134a7e', 1),
-    ( 'ross42', 'mark', 'michael', 'Female', '1507a0565d69991ff963cd3489b1c491', 1),
-    ( 'james29', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
-    ( 'chrishaydon20', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
-    ( 'wright68', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
-    ( 'cooper47', 'ross', 'paul', 'Male', '524f2968fabb85f0fdb2fa63110f6690', 1),
-    ( 'morgan73', 'chrishaydon', 'cooper', 'Male', '33b7c4b03c90ac30be52d86c3229577b', 1),
-    ( 'maria82', 'daniel', 'daniel', 'Male', '9a83d41058fafd2a50b9fcce02811361', 1),
-    ( 'john8', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
-    ( 'miller63', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
-    ( 'morgan26', 'michael', 'rogers', 'Male', 'e4a653c83b7fa702099b4c780e5fa68d', 1),
-    ( 'rivera69', 'brown', 'david', 'Female', 'b58472e08b58b46875487093c2867ec6', 1),
-    ( 'daniel31', 'john', 'rogers', 'Male', '9d84f6219123fb1920cf2e7494a44d77', 1),
-    ( 'sanders100', 'michael', 'john', 'Male', '349fda3c9d90de7e62c9ada6c0550a8b', 1),
-    ( 'mark84', 'morris', 'chrishaydon', 'Female', '8f9307568ba555323b0599ce0c715b1a', 1),
-    ( 'smith56', 'david', 'paul', 'Male', 'baaf6963e059f92921d196b5fef6669d', 1),
-    ( 'morris93', 'chrishaydon', 'james', 'Female', 'dba13ee70b381ea5b9ceac0ece3d850f', 1),
-    ( 'michael45', 'michael', 'paul', 'Female', 'd9f0344646b6ba7f224d9d3a0986cc7e', 1),
-    ( 'mike88', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
-    ( 'morgan96', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
-    ( 'james5', 'brooks', 'mike', 'Female', 'ac332ec30f36d30d5bf9221fe1b32bf0', 1),
-    ( 'morris83', 'sanders', 'maria', 'Male', 'fcb81feea910ea24117d4f26171991f8', 1),
-    ( 'rivera19', 'rivera', 'bell', 'Female', '0ba1e8782ffcf3e71c079e1e54c0a41c', 1),
-    ( 'daniel57', 'paul', 'bell', 'Female', '62d04b1633be93a4b4f93256a56e28d0', 1),
-    ( 'maria10', 'wright', 'sanders', 'Female', '9c8c23c03a74a7a320d742aa95cf08e3', 1),
-    ( 'david71', 'jenny09', 'david', 'Male', '16a061f8fdf5c93e9e77631dfb7d471a', 1),
-    ( 'sanders88', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
-    ( 'rogers37', 'chrishaydon', 'brown', 'Male', '822141a673303a54b9387d8fc6a0c425', 1),
-    ( 'chrishaydon10', 'chrishaydon', 'john', 'Male', '1b7d631cf551c86b671fd6bc2ec9d0dc', 1),
-    ( 'sanders27', 'bell', 'morris', 'Male', '576352444376fe3069d256ded463666f', 1),
-    ( 'brown17', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
-    ( 'morris86', 'rogers', 'ross', 'Male', '896b690b9566f53a875e03a3a324c091', 1),
-    ( 'mark79', 'bell', 'sanders', 'Male', 'cee8dbd9130bccbe4d945c9cfc41f330', 1),
-    ( 'david58', 'david', 'morgan', 'Female', 'ca391efb9f114371dced52c8eb18a412', 1),
-    ( 'daniel95', 'wright', 'ross', 'Male', '4458808784017babe1bb3ed79c61f0f7', 1),
-    ( 'brooks49', 'sanders', 'morgan', 'Female', 'dbd61cc2c16fa763662c8a29d63215e0', 1),
-    ( 'jenny0926', 'wright', 'david', 'Female', 'd2d4451b918dbb9730bb7e010a50ab60', 1),
-    ( 'bell37', 'morgan', 'john', 'Female', '9019a44759e7bdece498cc5dce570446', 1),
-    ( 'john46', 'miller', 'john', 'Female', 'c618d2bfb0bf88f1de98313820f226a8', 1),
-    ( 'chrishaydon43', 'miller', 'chrishaydon', 'Female', 'e63ca65668b82c9fd07eef6b719ad4aa', 1),
-    ( 'rogers19', 'mike', 'john', 'Male', '59843bfb2ca080a23bcbbc5278fa6982', 1),
-    ( 'brooks29', 'rivera', 'rivera', 'Female', 'f151014abd62636e598dbad4a5b43aa3', 1),
-    ( 'chrishaydon74', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
-    ( 'brooks43', 'morgan', 'cooper', 'Male', '669e54b4368a4a99595fe4a56b9bf6ff', 1),
-    ( 'ross44', 'morgan', 'smith', 'Female', 'a84cf2d984ed2eb7948b9fda85275a7f', 1),
-    ( 'bell63', 'chrishaydon', 'james', 'Male', '863d1292a4a36cdc9134a7f84e8070c9', 1),
-    ( 'rivera41', 'maria', 'mark', 'Male', 'a9dd9815cf33560650fc661a97f41547', 1),
-    ( 'wright17', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
-    ( 'michael15', 'david', 'mark', 'Female', 'a167a5d9cf8157d98d45c1d0e32b72f4', 1),
-    ( 'ross53', 'morris', 'daniel', 'Female', 'b4a6baa51bd47cf407213f6ba9254f71', 1),
-    ( 'cooper65', 'brooks', 'mark', 'Female', '26f039d32dad1e54e8f74b015ea9ec68', 1),
-    ( 'rivera2', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
-    ( 'james32', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
-    ( 'mark97', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
-    ( 'smith93', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
-    ( 'chrishaydon84', 'james', 'brown', 'Male', '5957038b6a3f0a0f305123af998bc437', 1),
-    ( 'smith30', 'maria', 'wright', 'Male', '62d191a19bda44ba011ebd37976b5a23', 1),
-    ( 'maria51', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
-    ( 'rivera52', 'cooper', 'michael', 'Male', 'c15a7058e0c14b26f6863fbd7549a1e7', 1),
-    ( 'chrishaydon48', 'david', 'cooper', 'Female', 'e51d84e0afa82e704755b26fe59f566e', 1),
-    ( 'morgan90', 'mike', 'maria', 'Female', 'e78f1e49da6ebf2fe756ec38b646ba36', 1),
-    ( 'sanders53', 'bell', 'mark', 'Female', '15d2a8035ab8f89a8d2388049cdb1ee9', 1),
-    ( 'john47', 'sanders', 'sanders', 'Female', 'd3945958288a3f00f1d5fe7230670061', 1),
-    ( 'daniel100', 'paul', 'cooper', 'Male', '1ca7a74ac2257ad3c0f5885cb90099f2', 1),
-    ( 'wright13', 'brown', 'mark', 'Male', '35e1bd4961d74f12ed2e2399f219aaa9', 1),
-    ( 'morgan86', 'brown', 'daniel', 'Female', 'ba7d8e424270cfe9f48eb09c99ff68a1', 1),
-    ( 'wright17', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
-    ( 'morris93', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
-    ( 'james40', 'rogers', 'rogers', 'Male', 'b44bc9d82406f9a445889a66b255f271', 1),
-    ( 'ross47', 'sanders', 'chrishaydon', 'Male', '4592e91917d68abf8835aeae27f38cfc', 1),
-    ( 'wright8', 'bell', 'cooper', 'Male', 'e30161a98a0e9e3933dbb856e6af4719', 1),
-    ( 'ross83', 'morris', 'sanders', 'Female', '2ec9470fb5a92621648ad5c708b050f8', 1),
-    ( 'ross9', 'bell', 'sanders', 'Female', '85b8a1781fe0eda5fcdb86d950c2e239', 1),
-    ( 'miller47', 'morris', 'david', 'Female', '2fb2bd61613de4adc3ab2fc7fc05fe88', 1),
-    ( 'smith28', 'brown', 'john', 'Female', '2e3a6218c8c4652563470fd23b5c9004', 1),
-    ( 'morris2', 'bell', 'jenny09', 'Male', '9248ee5ee8d1ff997d0009348e2ea662', 1),
-    ( 'morris4', 'rogers', 'brooks', 'Male', 'd9290c1d84c18de491112fab16fc0172', 1),
-    ( 'jenny0926', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
-    ( 'morgan21', 'john', 'cooper', 'Male', 'c1c1328643f85dca460e8ead1600c650', 1),
-    ( 'david3', 'michael', 'miller', 'Male', '6c5c00ec2728ed2459e821dce4a85349', 1),
-    ( 'ross58', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
-    ( 'jenny0949', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
-    ( 'john96', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
-    ( 'paul5', 'rivera', 'ross', 'Male', '13a3756c2cb7b486e32054a08271d3f1', 1),
-    ( 'sanders80', 'michael', 'james', 'Female', '73f63edc5f6f3b571886fbb1316c43bc', 1),
-    ( 'smith65', 'brooks', 'bell', 'Male', '7ba86a4101f3074f458df9a6e43e3f5a', 1),
-    ( 'rogers26', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
-    ( 'maria43', 'miller', 'bell', 'Male', '0c879f0908f637ebe8cc0db994ae0832', 1),
-    ( 'david99', 'maria', 'jenny09', 'Female', 'b38a8c25edada7483420c20a76b9d4b0', 1),
-    ( 'wright20', 'smith', 'rogers', 'Female', 'e11d269be9b74106223b357b06f2da33', 1),
-    ( 'brooks87', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
-    ( 'mike43', 'morgan', 'ross', 'Male', '024142778439c88b675219780cf1c578', 1),
-    ( 'ross30', 'mark', 'david', 'Male', 'c5fb92d8af4e45581d8bb7c71a5c0a1c', 1),
-    ( 'jenny0981', 'morgan', 'brooks', 'Female', '34ae1e72198b2ccb37610a3269368fa7', 1),
-    ( 'cooper11', 'james', 'james', 'Female', '243cffae81569cca39840aec1e9e2852', 1),
-    ( 'rogers97', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
-    ( 'wright84', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
-    ( 'jenny0932', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
-    ( 'sanders40', 'paul', 'sanders', 'Female', 'be92492fa64d6126726a71584a75a878', 1),
-    ( 'john80', 'daniel', 'ross', 'Female', '8a608ab5ea3865ae688ac2f8b1cc3b61', 1),
-    ( 'brooks58', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
-    ( 'miller37', 'brooks', 'bell', 'Female', '62c1ace1a0aa8e798169f547586457c8', 1),
-    ( 'james93', 'chrishaydon', 'james', 'Male', '7ef00ef6e7f47472db040222dae2dc8e', 1),
-    ( 'bell16', 'bell', 'james', 'Male', '0d5e1a6eab996a7d1d32a0e2ed22ff2a', 1),
-    ( 'smith96', 'mike', 'bell', 'Female', '68f2fb17fd3f13d8a0a394de396ce447', 1),
-    ( 'ross94', 'david', 'bell', 'Male', '84c62bc5b6f1dab11a1ca3d8d7a5e29e', 1),
-    ( 'ross95', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
-    ( 'chrishaydon82', 'jenny09', 'maria', 'Female', '8093f823794b8fa03c379c035300fd0b', 1),
-    ( 'michael3', 'mike', 'jenny09', 'Male', 'fc13f6e30b38a8529a57add8a59b5ec0', 1),
-    ( 'jenny0918', 'rogers', 'brown', 'Male', '8b9e34ac36a2fd947614e2616f3d6826', 1),
-    ( 'rogers43', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
-    ( 'morris91', 'paul', 'rivera', 'Male', '613be5cffa8a578c1816a1baf6bb8501', 1),
-    ( 'john4', 'james', 'rivera', 'Female', '76ebe07cd3c27e769f71251f294d4c94', 1),
-    ( 'cooper5', 'wright', 'morris', 'Male', '8105c71bb32ba7bf731c4162ff9c1f21', 1),
-    ( 'chrishaydon20', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
-    ( 'chrishaydon44', 'mark', 'smith', 'Female', 'a54db1865fb0fa3a66861d93e037741d', 1),
-    ( 'daniel13', 'brooks', 'brown', 'Female', '91270e97491a918496e6569c9fcff808', 1),
-    ( 'chrishaydon37', 'brown', 'mark', 'Male', 'a3efe2fadf9e33423b80532af0f95c33', 1),
-    ( 'david31', 'wright', 'paul', 'Female', 'b8e0691f01b08a810fade049028d9c3e', 1),
-    ( 'mark21', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
-    ( 'wright81', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
-    ( 'michael13', 'morris', 'cooper', 'Female', '9d450e0529ca7328d07679163206f732', 1),
-    ( 'rogers63', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
-    ( 'mike28', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
-    ( 'rivera92', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
-    ( 'ross95', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
-    ( 'paul85', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
-    ( 'smith34', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
-    ( 'james84', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
-    ( 'daniel53', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
-    ( 'brooks80', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
-    ( 'morgan65', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
-    ( 'sanders84', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
-    ( 'maria40', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
-    ( 'brown71', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
-    ( 'james63', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
-    ( 'jenny0993', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
-    ( 'john96', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
-    ( 'miller64', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
-    ( 'mark46', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
-    ( 'jenny0988', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
-    ( 'mark80', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
-    ( 'morris72', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
-    ( 'wright39', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
-    ( 'paul68', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
-    ( 'smith60', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
-    ( 'bell43', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
-    ( 'rogers79', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
-    ( 'daniel56', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
-    ( 'brooks85', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
-    ( 'mike30', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
-    ( 'paul92', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
-    ( 'bell96', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
-    ( 'john8', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
-    ( 'chrishaydon12', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
-    ( 'morgan13', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
-    ( 'james83', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
-    ( 'chrishaydon8', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
-    ( 'ross85', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
-    ( 'ross46', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
-    ( 'smith4', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
-    ( 'paul4', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
-    ( 'daniel26', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
-    ( 'chrishaydon2', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
-    ( 'david82', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
-    ( 'john97', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
-    ( 'david57', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
-    ( 'rivera100', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
-    ( 'bell13', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
-    ( 'brooks65', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
-    ( 'daniel40', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
-    ( 'cooper100', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
-    ( 'morris14', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
-    ( 'smith82', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
-    ( 'cooper35', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
-    ( 'morgan94', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
-    ( 'michael92', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
-    ( 'sanders48', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
-    ( 'brown76', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
-    ( 'james16', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
-    ( 'michael26', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
-    ( 'wright57', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
-    ( 'wright68', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
-    ( 'brooks1', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
-    ( 'bell2', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
-    ( 'miller100', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
-    ( 'rogers53', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
-    ( 'mike1', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
-    ( 'cooper57', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
-    ( 'daniel38', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
-    ( 'mark2', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
-    ( 'daniel79', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
-    ( 'wright4', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
-    ( 'brown84', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
-    ( 'paul41', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
-    ( 'mark5', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
-    ( 'jenny0994', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
-    ( 'morris53', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
-    ( 'paul68', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
-    ( 'brooks86', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
-    ( 'james54', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
-    ( 'rogers58', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
-    ( 'maria31', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
-    ( 'david5', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
-    ( 'mark21', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
-    ( 'jenny0932', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
-    ( 'john92', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
-    ( 'rogers98', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
-    ( 'rogers95', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
-    ( 'james50', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
-    ( 'miller80', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
-    ( 'mark29', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
-    ( 'cooper77', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
-    ( 'john24', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
-    ( 'chrishaydon32', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
-    ( 'bell41', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
-    ( 'ross99', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
-    ( 'smith9', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
-    ( 'miller73', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
-    ( 'michael44', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
-    ( 'michael36', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
-    ( 'smith93', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
-    ( 'morgan38', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
-    ( 'brown70', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
-    ( 'mike14', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
-    ( 'daniel72', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
-    ( 'jenny0974', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
-    ( 'maria51', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
-    ( 'michael97', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
-    ( 'chrishaydon33', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
-    ( 'sanders42', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
-    ( 'brooks87', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
-    ( 'bell4', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
-    ( 'jenny0945', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
-    ( 'james65', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
-    ( 'john98', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
-    ( 'paul80', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
-    ( 'john92', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
-    ( 'wright69', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
-    ( 'bell68', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
-    ( 'brooks97', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
-    ( 'mark14', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
-    ( 'david60', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
-    ( 'rivera68', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
-    ( 'brooks32', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
-    ( 'brown15', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
-    ( 'bell88', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
-    ( 'john26', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
-    ( 'paul55', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
-    ( 'david59', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
-    ( 'morris73', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
-    ( 'michael77', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
-    ( 'mark5', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
-    ( 'james65', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
-    ( 'maria39', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
-    ( 'michael37', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
-    ( 'sanders1', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
-    ( 'daniel30', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
-    ( 'brown53', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
-    ( 'john52', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
-    ( 'wright73', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
-    ( 'mark63', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
-    ( 'james29', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
-    ( 'mark23', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
-    ( 'cooper22', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
-    ( 'miller19', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
-    ( 'jenny0913', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
-    ( 'maria100', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
-    ( 'chrishaydon51', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
-    ( 'david9', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
-    ( 'mike52', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
-    ( 'wright5', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
-    ( 'brown21', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
-    ( 'daniel46', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
-    ( 'brown86', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
-    ( 'chrishaydon85', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
-    ( 'john41', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
-    ( 'mark72', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
-    ( 'michael67', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
-    ( 'jenny0943', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
-    ( 'daniel61', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
-    ( 'morgan34', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
-    ( 'jenny097', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
-    ( 'miller11', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
-    ( 'daniel99', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
-    ( 'miller20', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
-    ( 'sanders29', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
-
-    ( 'mark43', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
-    ( 'james86', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
-    ( 'chrishaydon74', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
-    ( 'brooks37', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
-    ( 'michael78', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
-    ( 'paul9', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
-    ( 'james57', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
-    ( 'mark91', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
-    ( 'wright30', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
-    ( 'rogers97', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
-    ( 'daniel8', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
-    ( 'mark66', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
-    ( 'daniel6', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
-    ( 'bell72', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
-    ( 'morgan77', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
-    ( 'bell24', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
-    ( 'michael31', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
-    ( 'cooper98', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
-    ( 'daniel41', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
-    ( 'michael1', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
-    ( 'chrishaydon20', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
-    ( 'daniel66', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
-    ( 'cooper52', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
-    ( 'chrishaydon99', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
-    ( 'smith66', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
-    ( 'mike48', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
-    ( 'rivera80', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
-    ( 'morgan35', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
-    ( 'brooks16', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
-    ( 'mike78', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
-    ( 'michael38', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
-    ( 'mark7', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
-    ( 'rogers80', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
-    ( 'wright81', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
-    ( 'michael21', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
-    ( 'daniel56', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
-    ( 'ross3', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
-    ( 'chrishaydon72', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
-    ( 'sanders66', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
-    ( 'michael4', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
-    ( 'brown66', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
-    ( 'wright26', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
-    ( 'james81', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
-    ( 'morris21', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
-    ( 'john30', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
-    ( 'jenny0929', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
-    ( 'wright91', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
-    ( 'jenny0922', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
-    ( 'rivera35', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
-    ( 'rogers53', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
-    ( 'ross50', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
-    ( 'ross41', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
-    ( 'rivera78', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
-    ( 'miller43', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
-    ( 'morgan59', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
-    ( 'chrishaydon63', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
-    ( 'wright93', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
-    ( 'maria62', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
-    ( 'mark85', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
-    ( 'wright3', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
-    ( 'brooks37', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
-    ( 'paul66', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
-    ( 'rivera36', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
-    ( 'paul42', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
-    ( 'morgan93', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
-    ( 'rivera43', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
-    ( 'daniel71', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
-    ( 'mike81', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
-    ( 'daniel83', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
-    ( 'smith54', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
-    ( 'sanders38', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
-    ( 'morris63', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
-    ( 'michael25', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
-    ( 'smith89', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
-    ( 'john97', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
-    ( 'jenny0985', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
-    ( 'wright84', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
-    ( 'maria53', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
-    ( 'brooks72', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
-    ( 'brown61', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
-    ( 'paul95', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
-    ( 'brooks92', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
-    ( 'james44', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
-    ( 'chrishaydon100', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
-    ( 'mark97', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
-    ( 'brooks91', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
-    ( 'jenny0929', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
-    ( 'morgan37', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
-    ( 'daniel43', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
-    ( 'mike98', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
-    ( 'john48', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
-    ( 'brooks65', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
-    ( 'cooper18', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
-    ( 'chrishaydon54', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
-    ( 'john67', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
-    ( 'morris27', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
-    ( 'ross45', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
-    ( 'rogers85', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
-    ( 'sanders96', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
-    ( 'smith70', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
-    ( 'wright31', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
-    ( 'paul10', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
-    ( 'sanders2', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
-    ( 'rogers14', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
-    ( 'morgan79', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
-    ( 'morris87', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
-    ( 'james6', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
-    ( 'wright33', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
-    ( 'maria85', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
-    ( 'ross31', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
-    ( 'chrishaydon75', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
-    ( 'david54', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
-    ( 'chrishaydon50', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
-    ( 'miller24', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
-    ( 'maria9', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
-    ( 'smith91', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
-    ( 'rivera11', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
-    ( 'james72', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
-    ( 'miller36', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
-    ( 'jenny0991', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
-    ( 'jenny0959', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
-    ( 'mike41', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
-    ( 'morgan20', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
-    ( 'miller29', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
-    ( 'ross56', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
-    ( 'chrishaydon63', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
-    ( 'morris54', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
-    ( 'bell20', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
-    ( 'paul41', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
-    ( 'morgan42', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
-    ( 'ross100', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
-    ( 'wright1', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
-    ( 'jenny0921', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
-    ( 'paul37', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
-    ( 'michael92', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
-    ( 'chrishaydon14', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
-    ( 'miller40', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
-    ( 'wright43', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
-    ( 'smith15', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
-    ( 'miller49', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
-    ( 'morris21', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
-    ( 'ross27', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
-    ( 'rivera16', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
-    ( 'maria73', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
-    ( 'chrishaydon60', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
-    ( 'david40', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
-    ( 'michael66', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
-    ( 'paul27', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
-    ( 'jenny0911', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
-    ( 'miller79', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
-    ( 'mark14', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
-    ( 'ross87', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
-    ( 'paul47', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
-    ( 'sanders61', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
-    ( 'rivera13', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
-    ( 'chrishaydon22', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
-    ( 'sanders42', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
-    ( 'david78', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
-    ( 'ross60', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
-    ( 'morris24', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
-    ( 'jenny0952', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
-    ( 'rogers72', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
-    ( 'daniel70', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
-    ( 'cooper76', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
-    ( 'jenny0942', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
-    ( 'daniel39', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
-    ( 'morris59', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
-    ( 'mike70', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
-    ( 'rogers20', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
-    ( 'david33', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
-    ( 'morris65', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
-    ( 'michael23', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
-    ( 'john96', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
-    ( 'wright64', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
-    ( 'david91', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
-    ( 'daniel87', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
-    ( 'ross60', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
-    ( 'morris60', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
-    ( 'rivera50', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
-    ( 'maria77', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
-    ( 'morgan64', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
-    ( 'miller41', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
-    ( 'david65', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
-    ( 'morgan96', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
-    ( 'ross73', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
-    ( 'brooks95', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
-    ( 'sanders92', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
-    ( 'cooper53', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
-    ( 'ross100', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
-    ( 'michael91', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
-    ( 'brooks51', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
-    ( 'ross26', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
-    ( 'morris58', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
-    ( 'morris58', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
-    ( 'ross12', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
-    ( 'brooks60', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
-    ( 'mark29', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
-    ( 'john14', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
-    ( 'rivera26', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
-    ( 'jenny0949', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
-    ( 'rogers96', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
-    ( 'david55', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
-    ( 'chrishaydon3', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
-    ( 'ross81', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
-    ( 'john70', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
-    ( 'miller34', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
-    ( 'bell26', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
-    ( 'ross92', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
-    ( 'rogers34', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
-    ( 'james51', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
-    ( 'ross54', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
-    ( 'brown59', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
-    ( 'michael83', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
-    ( 'miller10', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
-    ( 'jenny0939', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
-    ( 'paul100', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
-    ( 'smith82', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
-    ( 'morris6', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
-    ( 'paul76', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
-    ( 'mark97', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
-    ( 'john74', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
-    ( 'miller78', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
-    ( 'miller54', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
-    ( 'chrishaydon9', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
-    ( 'daniel4', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
-    ( 'john24', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
-    ( 'smith31', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
-    ( 'smith77', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
-    ( 'maria29', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
-    ( 'michael76', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
-    ( 'mark64', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
-    ( 'morris5', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
-    ( 'brown69', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
-    ( 'miller53', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
-    ( 'wright94', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
-    ( 'rogers26', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
-    ( 'jenny0977', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
-    ( 'daniel23', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
-    ( 'cooper66', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
-    ( 'chrishaydon61', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
-    ( 'maria95', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
-    ( 'james17', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
-    ( 'james18', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
-    ( 'mike47', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
-    ( 'john57', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
-    ( 'mark54', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
-    ( 'bell38', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
-    ( 'morgan31', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
-    ( 'miller16', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
-    ( 'rivera14', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
-    ( 'rogers22', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
-    ( 'jenny0963', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
-    ( 'mark42', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
-    ( 'mark7', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
-    ( 'john1', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
-    ( 'chrishaydon3', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
-    ( 'smith11', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
-    ( 'john22', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
-    ( 'rivera23', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
-    ( 'james32', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
-    ( 'daniel58', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
-    ( 'james18', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
-    ( 'brown93', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
-    ( 'bell89', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
-    ( 'miller82', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
-    ( 'paul21', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
-    ( 'chrishaydon88', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
-    ( 'rivera44', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
-    ( 'bell89', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
-    ( 'bell66', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
-    ( 'rogers31', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
-    ( 'bell47', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
-    ( 'sanders58', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
-    ( 'mark26', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
-    ( 'miller22', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
-    ( 'daniel46', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
-    ( 'morgan100', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
-    ( 'ross75', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
-    ( 'david68', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
-    ( 'rivera35', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
-    ( 'daniel63', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
-    ( 'ross37', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
-    ( 'michael23', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
-    ( 'mike48', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
-    ( 'david57', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
-    ( 'morris67', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
-    ( 'rogers49', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
-    ( 'morris14', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
-    ( 'brooks74', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
-    ( 'mike71', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
-    ( 'mark18', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
-    ( 'mark9', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
-    ( 'brooks21', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
-    ( 'bell4', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
-    ( 'brown17', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
-    ( 'jenny0923', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
-    ( 'smith66', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
-    ( 'daniel53', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
-    ( 'brown33', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
-    ( 'mark55', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
-    ( 'rivera83', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
-    ( 'paul72', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
-    ( 'david25', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
-    ( 'paul47', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
-    ( 'james61', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
-    ( 'paul90', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
-    ( 'david95', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
-    ( 'paul52', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
-    ( 'wright82', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
-    ( 'james61', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
-    ( 'michael89', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
-    ( 'wright44', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
-    ( 'chrishaydon60', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
-    ( 'john30', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
-    ( 'rivera2', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
-    ( 'wright17', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
-    ( 'rivera30', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
-    ( 'daniel63', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
-    ( 'brooks56', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
-    ( 'wright29', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
-    ( 'morris52', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
-    ( 'morgan10', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
-    ( 'sanders55', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
-    ( 'mike9', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
-    ( 'michael69', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
-    ( 'david18', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
-    ( 'daniel41', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
-    ( 'david59', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
-    ( 'daniel17', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
-    ( 'john45', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
-    ( 'morgan17', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
-    ( 'john42', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
-    ( 'rogers50', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
-    ( 'smith35', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
-    ( 'mark56', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
-    ( 'chrishaydon12', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
-    ( 'mark90', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
-    ( 'maria85', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
-    ( 'rogers42', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
-    ( 'brooks95', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
-    ( 'brooks38', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
-    ( 'morris49', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
-    ( 'david86', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
-    ( 'wright15', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
-    ( 'wright75', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
-    ( 'sanders78', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
-    ( 'jenny092', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
-    ( 'cooper68', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
-    ( 'paul87', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
-    ( 'brooks59', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
-    ( 'rivera36', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
-    ( 'cooper25', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
-    ( 'mike3', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
-    ( 'rivera24', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
-    ( 'wright89', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
-    ( 'cooper12', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
-    ( 'paul32', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
-    ( 'wright11', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
-    ( 'maria51', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
-    ( 'morris85', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
-    ( 'chrishaydon88', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
-    ( 'brown49', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
-    ( 'mike55', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
-    ( 'paul6', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
-    ( 'james19', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
-    ( 'brown35', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
-    ( 'chrishaydon15', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
-    ( 'jenny0962', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
-    ( 'brooks57', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
-    ( 'chrishaydon7', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
-    ( 'morgan15', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
-    ( 'chrishaydon47', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
-    ( 'brooks41', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
-    ( 'mark61', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
-    ( 'brooks82', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
-    ( 'maria6', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
-    ( 'brown2', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
-    ( 'maria94', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
-    ( 'smith95', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
-    ( 'ross3', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
-    ( 'smith24', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
-    ( 'john19', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
-    ( 'michael93', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
-    ( 'bell35', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
-    ( 'david80', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
-    ( 'paul3', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
-    ( 'michael27', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
-    ( 'smith12', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
-    ( 'maria61', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
-    ( 'daniel3', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
-    ( 'jenny0923', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
-    ( 'morris5', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
-    ( 'ross58', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
-    ( 'michael48', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
-    ( 'brown92', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
-    ( 'morgan54', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
-    ( 'sanders87', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
-    ( 'paul54', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
-    ( 'cooper59', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
-    ( 'brooks16', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
-    ( 'rivera44', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
-    ( 'sanders60', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
-    ( 'morgan82', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
-    ( 'john69', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
-    ( 'david36', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
-    ( 'mike88', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
-    ( 'david9', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
-    ( 'david34', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
-    ( 'sanders20', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
-    ( 'mike22', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
-    ( 'maria63', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
-    ( 'miller94', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
-    ( 'chrishaydon17', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
-    ( 'brooks60', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
-    ( 'bell34', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
-    ( 'chrishaydon65', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
-    ( 'paul70', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
-    ( 'jenny0972', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
-    ( 'john41', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
-    ( 'smith78', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
-    ( 'morgan55', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
-    ( 'smith81', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
-    ( 'jenny0988', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
-    ( 'chrishaydon49', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
-    ( 'morris31', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
-    ( 'mike15', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
-    ( 'smith61', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
-    ( 'chrishaydon42', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
-    ( 'john62', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
-    ( 'maria40', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
-    ( 'james50', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
-    ( 'brown93', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
-    ( 'maria85', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
-    ( 'miller63', 'morgan', 'morris', 'Female', 'ee33d4596af639660986c67f04e6ff92', 1),
-    ( 'smith50', 'brown', 'brown', 'Female', 'bd1e4b23c510e1eae5fa69baa618f3e2', 1),
-    ( 'mike83', 'smith', 'cooper', 'Female', '4a3309bacad462ce5af197a808839446', 1),
-    ( 'mark90', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
-    ( 'morris71', 'rogers', 'sanders', 'Male', 'a7fd9a391ed17cc9be339bcb87af3451', 1),
-    ( 'brown70', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
-    ( 'jenny0930', 'bell', 'rivera', 'Female', 'c25f2f612dde293ec2155a5dfe7901f2', 1),
-    ( 'smith60', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
-    ( 'cooper56', 'ross', 'mike', 'Female', '81b32a932116fed6d09aaa2c2bc522df', 1),
-    ( 'chrishaydon67', 'wright', 'mark', 'Female', 'a4808f35e9eca8fd90326ad5632ff220', 1),
-    ( 'cooper90', 'ross', 'rivera', 'Male', '077380568f1971f1003978046142baeb', 1),
-    ( 'morris82', 'ross', 'miller', 'Female', '8d863a559ac2d9fba0dfa98649e55637', 1),
-    ( 'morgan3', 'sanders', 'mark', 'Male', '4d5a20ef5496dae22ea2bbfa40defc12', 1),
-    ( 'daniel25', 'michael', 'ross', 'Female', '6794c36a5638c0c0df58155493512e60', 1),
-    ( 'rivera64', 'morgan', 'mike', 'Female', '041dcc147d542af1dc6bf50192c1b10f', 1),
-    ( 'bell41', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
-    ( 'rivera34', 'bell', 'paul', 'Male', '7d4a65a26f424093d93857b14104585e', 1),
-    ( 'chrishaydon22', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
-    ( 'miller53', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
-    ( 'miller58', 'sanders', 'paul', 'Male', '3fa66dc95e8f6e524cd5afbf4abfebdf', 1),
-    ( 'wright41', 'smith', 'sanders', 'Female', '7ba55de0a483cd485e1629176c9fa5ac', 1),
-    ( 'john69', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
-    ( 'smith6', 'smith', 'paul', 'Female', '69b7b0f8981d3f462bddcd10e83d73b8', 1),
-    ( 'rogers88', 'miller', 'rivera', 'Male', '18b2b0e6b66ce69949cfd500bdbd547b', 1),
-    ( 'john98', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
-    ( 'paul69', 'john', 'morgan', 'Male', '98bdeac0dbd9377cd342ab3e2ed67002', 1),
-    ( 'morris55', 'wright', 'brown', 'Male', 'e808e39b0369890ca613a24d1bdbe80e', 1),
-    ( 'paul3', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
-    ( 'rogers28', 'brown', 'brown', 'Male', 'cb1b08d86f6053ce02919717671bd81f', 1),
-    ( 'brown16', 'james', 'chrishaydon', 'Female', 'ef70b7e590eb6ef3d09bdb05084610e4', 1),
-    ( 'david68', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
-    ( 'michael8', 'paul', 'morgan', 'Female', '6691fbe0b3d4d260b0ada3cd9c0b78f5', 1),
-    ( 'sanders67', 'brooks', 'paul', 'Male', 'ddf8e1b6ed97cdf30cb35d0f9e47517b', 1),
-    ( 'rivera75', 'brooks', 'brooks', 'Male', 'fb77723ac6a75b2dfa7636213fc12803', 1),
-    ( 'miller81', 'ross', 'david', 'Female', 'e5e1fa5d4c971a980e6d087016507eb7', 1),
-    ( 'jenny0952', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
-    ( 'rivera53', 'bell', 'smith', 'Female', '1e3e64c56121038797a7e50b655464c6', 1),
-    ( 'morgan33', 'wright', 'brown', 'Male', '8e5a808681a0070beaf455c2cabb782b', 1),
-    ( 'ross27', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
-    ( 'miller94', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
-    ( 'michael14', 'paul', 'sanders', 'Female', '9462cebb50643d2a7731b89c5b1b8fe3', 1),
-    ( 'rogers60', 'mark', 'smith', 'Male', 'bef7a008c13dcc9dd7947e5f248a1cfd', 1),
-    ( 'maria50', 'rivera', 'miller', 'Male', '64b7a989ccd1e83d881215cddbeafb72', 1),
-    ( 'morgan96', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
-    ( 'ross49', 'sanders', 'jenny09', 'Female', '53083ad87812f62ccd391f01069e77b5', 1),
-    ( 'wright37', 'miller', 'chrishaydon', 'Male', '0f8b225730e5be91f0f4cbb29156cf44', 1),
-    ( 'brown38', 'ross', 'morgan', 'Male', '07a841d9d548265d67ac8897f60f1b06', 1),
-    ( 'michael50', 'mike', 'rogers', 'Female', 'a366cae9b72e785998b0ce78a2284bdb', 1),
-    ( 'wright93', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
-    ( 'miller91', 'mike', 'chrishaydon', 'Male', 'edb8e845ed0dc8c888f7225fa43eb2a6', 1),
-    ( 'daniel21', 'cooper', 'sanders', 'Female', 'df3e462d93781ea422c125a4c2b5566a', 1),
-    ( 'bell44', 'ross', 'smith', 'Male', 'a49601f3e6a85c0387539bba4bcdbe6c', 1),
-    ( 'brooks58', 'ross', 'john', 'Male', '6e5b2a0375b4d4d500ee07e62cad6d6c', 1),
-    ( 'chrishaydon86', 'sanders', 'david', 'Female', 'c3bf2214f10104ec078bed756539f9af', 1),
-    ( 'jenny0910', 'paul', 'smith', 'Male', '58eea070ea61891bfea5954c38cb9336', 1),
-    ( 'rivera53', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
-    ( 'brown75', 'sanders', 'rivera', 'Female', '7c5cef8a2e387abe6908ee2f3fbc534a', 1),
-    ( 'maria40', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
-    ( 'john12', 'sanders', 'sanders', 'Male', 'cba0f422fbd342f81feb1dd78a43cad6', 1),
-    ( 'sanders88', 'paul', 'miller', 'Male', 'b6153be014b1af1811c85aa770c4cc46', 1),
-    ( 'morris98', 'morgan', 'brooks', 'Female', 'd793df005919fa5c4f45a61a7f9f8e87', 1),
-    ( 'miller17', 'daniel', 'brown', 'Female', 'a11bf4bd7d2f8c8162d97c7447ab07f8', 1),
-    ( 'cooper84', 'miller', 'miller', 'Male', '941752ab63605763d87142453aa35988', 1),
-    ( 'ross49', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
-    ( 'morgan54', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
-    ( 'chrishaydon29', 'wright', 'cooper', 'Female', 'b6ed3804fd6ad8ccd1c85c0a7deda4e3', 1),
-    ( 'paul3', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
-    ( 'morris98', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
-    ( 'cooper55', 'mark', 'ross', 'Female', '6c794b04248c5d6120dd84b8e94967a7', 1),
-    ( 'rivera85', 'brown', 'brooks', 'Female', '0ca4d10e4c787cc061e18feb815f747b', 1),
-    ( 'rogers43', 'jenny09', 'mike', 'Female', 'aa98ad5a318401147dc9580a9e134a7e', 1),
-    ( 'ross42', 'mark', 'michael', 'Female', '1507a0565d69991ff963cd3489b1c491', 1),
-    ( 'james29', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
-    ( 'chrishaydon20', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
-    ( 'wright68', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
-    ( 'cooper47', 'ross', 'paul', 'Male', '524f2968fabb85f0fdb2fa63110f6690', 1),
-    ( 'morgan73', 'chrishaydon', 'cooper', 'Male', '33b7c4b03c90ac30be52d86c3229577b', 1),
-    ( 'maria82', 'daniel', 'daniel', 'Male', '9a83d41058fafd2a50b9fcce02811361', 1),
-    ( 'john8', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
-    ( 'miller63', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
-    ( 'morgan26', 'michael', 'rogers', 'Male', 'e4a653c83b7fa702099b4c780e5fa68d', 1),
-    ( 'rivera69', 'brown', 'david', 'Female', 'b58472e08b58b46875487093c2867ec6', 1),
-    ( 'daniel31', 'john', 'rogers', 'Male', '9d84f6219123fb1920cf2e7494a44d77', 1),
-    ( 'sanders100', 'michael', 'john', 'Male', '349fda3c9d90de7e62c9ada6c0550a8b', 1),
-    ( 'mark84', 'morris', 'chrishaydon', 'Female', '8f9307568ba555323b0599ce0c715b1a', 1),
-    ( 'smith56', 'david', 'paul', 'Male', 'baaf6963e059f92921d196b5fef6669d', 1),
-    ( 'morris93', 'chrishaydon', 'james', 'Female', 'dba13ee70b381ea5b9ceac0ece3d850f', 1),
-    ( 'michael45', 'michael', 'paul', 'Female', 'd9f0344646b6ba7f224d9d3a0986cc7e', 1),
-    ( 'mike88', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
-    ( 'morgan96', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
-    ( 'james5', 'brooks', 'mike', 'Female', 'ac332ec30f36d30d5bf9221fe1b32bf0', 1),
-    ( 'morris83', 'sanders', 'maria', 'Male', 'fcb81feea910ea24117d4f26171991f8', 1),
-    ( 'rivera19', 'rivera', 'bell', 'Female', '0ba1e8782ffcf3e71c079e1e54c0a41c', 1),
-    ( 'daniel57', 'paul', 'bell', 'Female', '62d04b1633be93a4b4f93256a56e28d0', 1),
-    ( 'maria10', 'wright', 'sanders', 'Female', '9c8c23c03a74a7a320d742aa95cf08e3', 1),
-    ( 'david71', 'jenny09', 'david', 'Male', '16a061f8fdf5c93e9e77631dfb7d471a', 1),
-    ( 'sanders88', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
-    ( 'rogers37', 'chrishaydon', 'brown', 'Male', '822141a673303a54b9387d8fc6a0c425', 1),
-    ( 'chrishaydon10', 'chrishaydon', 'john', 'Male', '1b7d631cf551c86b671fd6bc2ec9d0dc', 1),
-    ( 'sanders27', 'bell', 'morris', 'Male', '576352444376fe3069d256ded463666f', 1),
-    ( 'brown17', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
-    ( 'morris86', 'rogers', 'ross', 'Male', '896b690b9566f53a875e03a3a324c091', 1),
-    ( 'mark79', 'bell', 'sanders', 'Male', 'cee8dbd9130bccbe4d945c9cfc41f330', 1),
-    ( 'david58', 'david', 'morgan', 'Female', 'ca391efb9f114371dced52c8eb18a412', 1),
-    ( 'daniel95', 'wright', 'ross', 'Male', '4458808784017babe1bb3ed79c61f0f7', 1),
-    ( 'brooks49', 'sanders', 'morgan', 'Female', 'dbd61cc2c16fa763662c8a29d63215e0', 1),
-    ( 'jenny0926', 'wright', 'david', 'Female', 'd2d4451b918dbb9730bb7e010a50ab60', 1),
-    ( 'bell37', 'morgan', 'john', 'Female', '9019a44759e7bdece498cc5dce570446', 1),
-    ( 'john46', 'miller', 'john', 'Female', 'c618d2bfb0bf88f1de98313820f226a8', 1),
-    ( 'chrishaydon43', 'miller', 'chrishaydon', 'Female', 'e63ca65668b82c9fd07eef6b719ad4aa', 1),
-    ( 'rogers19', 'mike', 'john', 'Male', '59843bfb2ca080a23bcbbc5278fa6982', 1),
-    ( 'brooks29', 'rivera', 'rivera', 'Female', 'f151014abd62636e598dbad4a5b43aa3', 1),
-    ( 'chrishaydon74', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
-    ( 'brooks43', 'morgan', 'cooper', 'Male', '669e54b4368a4a99595fe4a56b9bf6ff', 1),
-    ( 'ross44', 'morgan', 'smith', 'Female', 'a84cf2d984ed2eb7948b9fda85275a7f', 1),
-    ( 'bell63', 'chrishaydon', 'james', 'Male', '863d1292a4a36cdc9134a7f84e8070c9', 1),
-    ( 'rivera41', 'maria', 'mark', 'Male', 'a9dd9815cf33560650fc661a97f41547', 1),
-    ( 'wright17', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
-    ( 'michael15', 'david', 'mark', 'Female', 'a167a5d9cf8157d98d45c1d0e32b72f4', 1),
-    ( 'ross53', 'morris', 'daniel', 'Female', 'b4a6baa51bd47cf407213f6ba9254f71', 1),
-    ( 'cooper65', 'brooks', 'mark', 'Female', '26f039d32dad1e54e8f74b015ea9ec68', 1),
-    ( 'rivera2', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
-    ( 'james32', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
-    ( 'mark97', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
-    ( 'smith93', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
-    ( 'chrishaydon84', 'james', 'brown', 'Male', '5957038b6a3f0a0f305123af998bc437', 1),
-    ( 'smith30', 'maria', 'wright', 'Male', '62d191a19bda44ba011ebd37976b5a23', 1),
-    ( 'maria51', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
-    ( 'rivera52', 'cooper', 'michael', 'Male', 'c15a7058e0c14b26f6863fbd7549a1e7', 1),
-    ( 'chrishaydon48', 'david', 'cooper', 'Female', 'e51d84e0afa82e704755b26fe59f566e', 1),
-    ( 'morgan90', 'mike', 'maria', 'Female', 'e78f1e49da6ebf2fe756ec38b646ba36', 1),
-    ( 'sanders53', 'bell', 'mark', 'Female', '15d2a8035ab8f89a8d2388049cdb1ee9', 1),
-    ( 'john47', 'sanders', 'sanders', 'Female', 'd3945958288a3f00f1d5fe7230670061', 1),
-    ( 'daniel100', 'paul', 'cooper', 'Male', '1ca7a74ac2257ad3c0f5885cb90099f2', 1),
-    ( 'wright13', 'brown', 'mark', 'Male', '35e1bd4961d74f12ed2e2399f219aaa9', 1),
-    ( 'morgan86', 'brown', 'daniel', 'Female', 'ba7d8e424270cfe9f48eb09c99ff68a1', 1),
-    ( 'wright17', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
-    ( 'morris93', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
-    ( 'james40', 'rogers', 'rogers', 'Male', 'b44bc9d82406f9a445889a66b255f271', 1),
-    ( 'ross47', 'sanders', 'chrishaydon', 'Male', '4592e91917d68abf8835aeae27f38cfc', 1),
-    ( 'wright8', 'bell', 'cooper', 'Male', 'e30161a98a0e9e3933dbb856e6af4719', 1),
-    ( 'ross83', 'morris', 'sanders', 'Female', '2ec9470fb5a92621648ad5c708b050f8', 1),
-    ( 'ross9', 'bell', 'sanders', 'Female', '85b8a1781fe0eda5fcdb86d950c2e239', 1),
-    ( 'miller47', 'morris', 'david', 'Female', '2fb2bd61613de4adc3ab2fc7fc05fe88', 1),
-    ( 'smith28', 'brown', 'john', 'Female', '2e3a6218c8c4652563470fd23b5c9004', 1),
-    ( 'morris2', 'bell', 'jenny09', 'Male', '9248ee5ee8d1ff997d0009348e2ea662', 1),
-    ( 'morris4', 'rogers', 'brooks', 'Male', 'd9290c1d84c18de491112fab16fc0172', 1),
-    ( 'jenny0926', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
-    ( 'morgan21', 'john', 'cooper', 'Male', 'c1c1328643f85dca460e8ead1600c650', 1),
-    ( 'david3', 'michael', 'miller', 'Male', '6c5c00ec2728ed2459e821dce4a85349', 1),
-    ( 'ross58', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
-    ( 'jenny0949', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
-
-    ( 'john96', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
-    ( 'paul5', 'rivera', 'ross', 'Male', '13a3756c2cb7b486e32054a08271d3f1', 1),
-    ( 'sanders80', 'michael', 'james', 'Female', '73f63edc5f6f3b571886fbb1316c43bc', 1),
-    ( 'smith65', 'brooks', 'bell', 'Male', '7ba86a4101f3074f458df9a6e43e3f5a', 1),
-    ( 'rogers26', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
-    ( 'maria43', 'miller', 'bell', 'Male', '0c879f0908f637ebe8cc0db994ae0832', 1),
-    ( 'david99', 'maria', 'jenny09', 'Female', 'b38a8c25edada7483420c20a76b9d4b0', 1),
-    ( 'wright20', 'smith', 'rogers', 'Female', 'e11d269be9b74106223b357b06f2da33', 1),
-    ( 'brooks87', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
-    ( 'mike43', 'morgan', 'ross', 'Male', '024142778439c88b675219780cf1c578', 1),
-    ( 'ross30', 'mark', 'david', 'Male', 'c5fb92d8af4e45581d8bb7c71a5c0a1c', 1),
-    ( 'jenny0981', 'morgan', 'brooks', 'Female', '34ae1e72198b2ccb37610a3269368fa7', 1),
-    ( 'cooper11', 'james', 'james', 'Female', '243cffae81569cca39840aec1e9e2852', 1),
-    ( 'rogers97', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
-    ( 'wright84', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
-    ( 'jenny0932', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
-    ( 'sanders40', 'paul', 'sanders', 'Female', 'be92492fa64d6126726a71584a75a878', 1),
-    ( 'john80', 'daniel', 'ross', 'Female', '8a608ab5ea3865ae688ac2f8b1cc3b61', 1),
-    ( 'brooks58', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
-    ( 'miller37', 'brooks', 'bell', 'Female', '62c1ace1a0aa8e798169f547586457c8', 1),
-    ( 'james93', 'chrishaydon', 'james', 'Male', '7ef00ef6e7f47472db040222dae2dc8e', 1),
-    ( 'bell16', 'bell', 'james', 'Male', '0d5e1a6eab996a7d1d32a0e2ed22ff2a', 1),
-    ( 'smith96', 'mike', 'bell', 'Female', '68f2fb17fd3f13d8a0a394de396ce447', 1),
-    ( 'ross94', 'david', 'bell', 'Male', '84c62bc5b6f1dab11a1ca3d8d7a5e29e', 1),
-    ( 'ross95', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
-    ( 'chrishaydon82', 'jenny09', 'maria', 'Female', '8093f823794b8fa03c379c035300fd0b', 1),
-    ( 'michael3', 'mike', 'jenny09', 'Male', 'fc13f6e30b38a8529a57add8a59b5ec0', 1),
-    ( 'jenny0918', 'rogers', 'brown', 'Male', '8b9e34ac36a2fd947614e2616f3d6826', 1),
-    ( 'rogers43', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
-    ( 'morris91', 'paul', 'rivera', 'Male', '613be5cffa8a578c1816a1baf6bb8501', 1),
-    ( 'john4', 'james', 'rivera', 'Female', '76ebe07cd3c27e769f71251f294d4c94', 1),
-    ( 'cooper5', 'wright', 'morris', 'Male', '8105c71bb32ba7bf731c4162ff9c1f21', 1),
-    ( 'chrishaydon20', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
-    ( 'chrishaydon44', 'mark', 'smith', 'Female', 'a54db1865fb0fa3a66861d93e037741d', 1),
-    ( 'daniel13', 'brooks', 'brown', 'Female', '91270e97491a918496e6569c9fcff808', 1),
-    ( 'chrishaydon37', 'brown', 'mark', 'Male', 'a3efe2fadf9e33423b80532af0f95c33', 1),
-    ( 'david31', 'wright', 'paul', 'Female', 'b8e0691f01b08a810fade049028d9c3e', 1),
-    ( 'mark21', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
-    ( 'wright81', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
-    ( 'michael13', 'morris', 'cooper', 'Female', '9d450e0529ca7328d07679163206f732', 1),
-    ( 'rogers63', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
-    ( 'mike28', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
-    ( 'rivera92', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
-    ( 'ross95', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
-    ( 'paul85', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
-    ( 'smith34', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
-    ( 'james84', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
-    ( 'daniel53', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
-    ( 'brooks80', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
-    ( 'morgan65', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
-    ( 'sanders84', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
-    ( 'maria40', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
-    ( 'brown71', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
-    ( 'james63', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
-    ( 'jenny0993', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
-    ( 'john96', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
-    ( 'miller64', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
-    ( 'mark46', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
-    ( 'jenny0988', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
-    ( 'mark80', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
-    ( 'morris72', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
-    ( 'wright39', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
-    ( 'paul68', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
-    ( 'smith60', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
-    ( 'bell43', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
-    ( 'rogers79', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
-    ( 'daniel56', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
-    ( 'brooks85', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
-    ( 'mike30', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
-    ( 'paul92', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
-    ( 'bell96', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
-    ( 'john8', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
-    ( 'chrishaydon12', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
-    ( 'morgan13', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
-    ( 'james83', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
-    ( 'chrishaydon8', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
-    ( 'ross85', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
-    ( 'ross46', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
-    ( 'smith4', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
-    ( 'paul4', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
-    ( 'daniel26', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
-    ( 'chrishaydon2', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
-    ( 'david82', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
-    ( 'john97', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
-    ( 'david57', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
-    ( 'rivera100', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
-    ( 'bell13', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
-    ( 'brooks65', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
-    ( 'daniel40', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
-    ( 'cooper100', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
-    ( 'morris14', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
-    ( 'smith82', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
-    ( 'cooper35', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
-    ( 'morgan94', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
-    ( 'michael92', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
-    ( 'sanders48', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
-    ( 'brown76', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
-    ( 'james16', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
-    ( 'michael26', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
-    ( 'wright57', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
-    ( 'wright68', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
-    ( 'brooks1', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
-    ( 'bell2', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
-    ( 'miller100', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
-    ( 'rogers53', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
-    ( 'mike1', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
-    ( 'cooper57', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
-    ( 'daniel38', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
-    ( 'mark2', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
-    ( 'daniel79', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
-    ( 'wright4', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
-    ( 'brown84', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
-    ( 'paul41', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
-    ( 'mark5', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
-    ( 'jenny0994', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
-    ( 'morris53', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
-    ( 'paul68', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
-    ( 'brooks86', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
-    ( 'james54', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
-    ( 'rogers58', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
-    ( 'maria31', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
-    ( 'david5', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
-    ( 'mark21', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
-    ( 'jenny0932', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
-    ( 'john92', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
-    ( 'rogers98', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
-    ( 'rogers95', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
-    ( 'james50', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
-    ( 'miller80', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
-    ( 'mark29', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
-    ( 'cooper77', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
-    ( 'john24', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
-    ( 'chrishaydon32', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
-    ( 'bell41', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
-    ( 'ross99', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
-    ( 'smith9', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
-    ( 'miller73', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
-    ( 'michael44', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
-    ( 'michael36', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
-    ( 'smith93', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
-    ( 'morgan38', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
-    ( 'brown70', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
-    ( 'mike14', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
-    ( 'daniel72', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
-    ( 'jenny0974', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
-    ( 'maria51', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
-    ( 'michael97', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
-    ( 'chrishaydon33', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
-    ( 'sanders42', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
-    ( 'brooks87', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
-    ( 'bell4', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
-    ( 'jenny0945', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
-    ( 'james65', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
-    ( 'john98', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
-    ( 'paul80', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
-    ( 'john92', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
-    ( 'wright69', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
-    ( 'bell68', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
-    ( 'brooks97', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
-    ( 'mark14', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
-    ( 'david60', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
-    ( 'rivera68', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
-    ( 'brooks32', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
-    ( 'brown15', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
-    ( 'bell88', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
-    ( 'john26', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
-    ( 'paul55', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
-    ( 'david59', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
-    ( 'morris73', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
-    ( 'michael77', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
-    ( 'mark5', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
-    ( 'james65', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
-    ( 'maria39', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
-    ( 'michael37', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
-    ( 'sanders1', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
-    ( 'daniel30', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
-    ( 'brown53', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
-    ( 'john52', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
-    ( 'wright73', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
-    ( 'mark63', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
-    ( 'james29', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
-    ( 'mark23', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
-    ( 'cooper22', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
-    ( 'miller19', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
-    ( 'jenny0913', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
-    ( 'maria100', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
-    ( 'chrishaydon51', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
-    ( 'david9', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
-    ( 'mike52', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
-    ( 'wright5', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
-    ( 'brown21', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
-    ( 'daniel46', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
-    ( 'brown86', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
-    ( 'chrishaydon85', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
-    ( 'john41', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
-    ( 'mark72', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
-    ( 'michael67', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
-    ( 'jenny0943', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
-    ( 'daniel61', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
-    ( 'morgan34', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
-    ( 'jenny097', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
-    ( 'miller11', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
-    ( 'daniel99', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
-    ( 'miller20', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
-    ( 'sanders29', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
-    ( 'mark43', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
-    ( 'james86', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
-    ( 'chrishaydon74', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
-    ( 'brooks37', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
-    ( 'michael78', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
-    ( 'paul9', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
-    ( 'james57', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
-    ( 'mark91', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
-    ( 'wright30', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
-    ( 'rogers97', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
-    ( 'daniel8', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
-    ( 'mark66', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
-    ( 'daniel6', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
-    ( 'bell72', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
-    ( 'morgan77', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
-    ( 'bell24', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
-    ( 'michael31', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
-    ( 'cooper98', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
-    ( 'daniel41', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
-    ( 'michael1', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
-    ( 'chrishaydon20', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
-    ( 'daniel66', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
-    ( 'cooper52', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
-    ( 'chrishaydon99', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
-    ( 'smith66', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
-    ( 'mike48', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
-    ( 'rivera80', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
-    ( 'morgan35', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
-    ( 'brooks16', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
-    ( 'mike78', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
-    ( 'michael38', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
-    ( 'mark7', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
-    ( 'rogers80', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
-    ( 'wright81', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
-    ( 'michael21', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
-    ( 'daniel56', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
-    ( 'ross3', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
-    ( 'chrishaydon72', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
-    ( 'sanders66', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
-    ( 'michael4', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
-    ( 'brown66', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
-    ( 'wright26', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
-    ( 'james81', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
-    ( 'morris21', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
-    ( 'john30', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
-    ( 'jenny0929', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
-    ( 'wright91', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
-    ( 'jenny0922', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
-    ( 'rivera35', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
-    ( 'rogers53', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
-    ( 'ross50', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
-    ( 'ross41', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
-    ( 'rivera78', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
-    ( 'miller43', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
-    ( 'morgan59', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
-    ( 'chrishaydon63', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
-    ( 'wright93', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
-    ( 'maria62', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
-    ( 'mark85', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
-    ( 'wright3', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
-    ( 'brooks37', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
-    ( 'paul66', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
-    ( 'rivera36', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
-    ( 'paul42', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
-    ( 'morgan93', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
-    ( 'rivera43', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
-    ( 'daniel71', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
-    ( 'mike81', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
-    ( 'daniel83', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
-    ( 'smith54', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
-    ( 'sanders38', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
-    ( 'morris63', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
-    ( 'michael25', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
-    ( 'smith89', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
-    ( 'john97', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
-    ( 'jenny0985', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
-    ( 'wright84', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
-    ( 'maria53', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
-    ( 'brooks72', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
-    ( 'brown61', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
-    ( 'paul95', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
-    ( 'brooks92', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
-    ( 'james44', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
-    ( 'chrishaydon100', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
-    ( 'mark97', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
-    ( 'brooks91', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
-    ( 'jenny0929', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
-    ( 'morgan37', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
-    ( 'daniel43', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
-    ( 'mike98', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
-    ( 'john48', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
-    ( 'brooks65', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
-    ( 'cooper18', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
-    ( 'chrishaydon54', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
-    ( 'john67', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
-    ( 'morris27', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
-    ( 'ross45', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
-    ( 'rogers85', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
-    ( 'sanders96', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
-    ( 'smith70', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
-    ( 'wright31', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
-    ( 'paul10', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
-    ( 'sanders2', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
-    ( 'rogers14', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
-    ( 'morgan79', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
-    ( 'morris87', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
-    ( 'james6', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
-    ( 'wright33', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
-    ( 'maria85', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
-    ( 'ross31', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
-    ( 'chrishaydon75', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
-    ( 'david54', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
-    ( 'chrishaydon50', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
-    ( 'miller24', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
-    ( 'maria9', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
-    ( 'smith91', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
-    ( 'rivera11', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
-    ( 'james72', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
-    ( 'miller36', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
-    ( 'jenny0991', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
-    ( 'jenny0959', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
-    ( 'mike41', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
-    ( 'morgan20', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
-    ( 'miller29', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
-    ( 'ross56', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
-    ( 'chrishaydon63', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
-    ( 'morris54', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
-    ( 'bell20', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
-    ( 'paul41', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
-    ( 'morgan42', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
-    ( 'ross100', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
-    ( 'wright1', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
-    ( 'jenny0921', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
-    ( 'paul37', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
-    ( 'michael92', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
-    ( 'chrishaydon14', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
-    ( 'miller40', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
-    ( 'wright43', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
-    ( 'smith15', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
-    ( 'miller49', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
-    ( 'morris21', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
-    ( 'ross27', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
-    ( 'rivera16', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
-    ( 'maria73', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
-    ( 'chrishaydon60', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
-    ( 'david40', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
-    ( 'michael66', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
-    ( 'paul27', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
-    ( 'jenny0911', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
-    ( 'miller79', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
-    ( 'mark14', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
-    ( 'ross87', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
-    ( 'paul47', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
-    ( 'sanders61', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
-    ( 'rivera13', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
-    ( 'chrishaydon22', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
-    ( 'sanders42', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
-    ( 'david78', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
-    ( 'ross60', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
-    ( 'morris24', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
-    ( 'jenny0952', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
-    ( 'rogers72', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
-    ( 'daniel70', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
-    ( 'cooper76', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
-    ( 'jenny0942', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
-    ( 'daniel39', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
-    ( 'morris59', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
-    ( 'mike70', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
-    ( 'rogers20', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
-    ( 'david33', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
-    ( 'morris65', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
-    ( 'michael23', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
-    ( 'john96', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
-    ( 'wright64', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
-    ( 'david91', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
-    ( 'daniel87', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
-    ( 'ross60', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
-    ( 'morris60', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
-    ( 'rivera50', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
-    ( 'maria77', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
-    ( 'morgan64', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
-    ( 'miller41', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
-    ( 'david65', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
-    ( 'morgan96', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
-    ( 'ross73', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
-    ( 'brooks95', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
-    ( 'sanders92', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
-    ( 'cooper53', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
-    ( 'ross100', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
-    ( 'michael91', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
-    ( 'brooks51', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
-    ( 'ross26', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
-    ( 'morris58', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
-    ( 'morris58', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
-    ( 'ross12', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
-    ( 'brooks60', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
-    ( 'mark29', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
-    ( 'john14', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
-    ( 'rivera26', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
-    ( 'jenny0949', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
-    ( 'rogers96', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
-    ( 'david55', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
-    ( 'chrishaydon3', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
-    ( 'ross81', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
-    ( 'john70', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
-    ( 'miller34', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
-    ( 'bell26', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
-    ( 'ross92', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
-    ( 'rogers34', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
-    ( 'james51', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
-    ( 'ross54', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
-    ( 'brown59', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
-    ( 'michael83', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
-    ( 'miller10', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
-    ( 'jenny0939', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
-    ( 'paul100', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
-    ( 'smith82', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
-    ( 'morris6', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
-    ( 'paul76', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
-    ( 'mark97', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
-    ( 'john74', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
-    ( 'miller78', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
-    ( 'miller54', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
-    ( 'chrishaydon9', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
-    ( 'daniel4', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
-    ( 'john24', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
-    ( 'smith31', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
-    ( 'smith77', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
-    ( 'maria29', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
-    ( 'michael76', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
-    ( 'mark64', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
-    ( 'morris5', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
-    ( 'brown69', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
-    ( 'miller53', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
-    ( 'wright94', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
-    ( 'rogers26', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
-    ( 'jenny0977', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
-    ( 'daniel23', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
-    ( 'cooper66', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
-    ( 'chrishaydon61', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
-    ( 'maria95', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
-    ( 'james17', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
-    ( 'james18', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
-    ( 'mike47', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
-    ( 'john57', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
-    ( 'mark54', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
-    ( 'bell38', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
-    ( 'morgan31', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
-    ( 'miller16', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
-    ( 'rivera14', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
-    ( 'rogers22', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
-    ( 'jenny0963', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
-    ( 'mark42', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
-    ( 'mark7', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
-    ( 'john1', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
-    ( 'chrishaydon3', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
-    ( 'smith11', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
-    ( 'john22', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
-    ( 'rivera23', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
-    ( 'james32', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
-    ( 'daniel58', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
-    ( 'james18', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
-    ( 'brown93', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
-    ( 'bell89', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
-    ( 'miller82', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
-    ( 'paul21', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
-    ( 'chrishaydon88', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
-    ( 'rivera44', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
-    ( 'bell89', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
-    ( 'bell66', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
-    ( 'rogers31', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
-    ( 'bell47', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
-    ( 'sanders58', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
-    ( 'mark26', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
-    ( 'miller22', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
-    ( 'daniel46', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
-    ( 'morgan100', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
-    ( 'ross75', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
-    ( 'david68', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
-    ( 'rivera35', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
-    ( 'daniel63', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
-    ( 'ross37', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
-    ( 'michael23', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
-    ( 'mike48', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
-    ( 'david57', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
-    ( 'morris67', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
-    ( 'rogers49', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
-    ( 'morris14', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
-    ( 'brooks74', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
-    ( 'mike71', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
-    ( 'mark18', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
-    ( 'mark9', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
-    ( 'brooks21', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
-    ( 'bell4', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
-    ( 'brown17', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
-    ( 'jenny0923', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
-    ( 'smith66', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
-    ( 'daniel53', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
-    ( 'brown33', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
-    ( 'mark55', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
-    ( 'rivera83', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
-    ( 'paul72', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
-    ( 'david25', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
-    ( 'paul47', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
-    ( 'james61', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
-    ( 'paul90', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
-    ( 'david95', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
-    ( 'paul52', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
-    ( 'wright82', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
-    ( 'james61', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
-    ( 'michael89', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
-    ( 'wright44', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
-    ( 'chrishaydon60', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
-    ( 'john30', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
-    ( 'rivera2', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
-    ( 'wright17', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
-    ( 'rivera30', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
-    ( 'daniel63', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
-    ( 'brooks56', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
-    ( 'wright29', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
-    ( 'morris52', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
-    ( 'morgan10', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
-    ( 'sanders55', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
-    ( 'mike9', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
-    ( 'michael69', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
-    ( 'david18', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
-    ( 'daniel41', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
-    ( 'david59', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
-    ( 'daniel17', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
-    ( 'john45', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
-    ( 'morgan17', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
-    ( 'john42', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
-    ( 'rogers50', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
-    ( 'smith35', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
-    ( 'mark56', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
-    ( 'chrishaydon12', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
-    ( 'mark90', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
-    ( 'maria85', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
-    ( 'rogers42', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
-    ( 'brooks95', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
-    ( 'brooks38', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
-    ( 'morris49', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
-    ( 'david86', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
-    ( 'wright15', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
-    ( 'wright75', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
-    ( 'sanders78', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
-    ( 'jenny092', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
-    ( 'cooper68', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
-    ( 'paul87', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
-    ( 'brooks59', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
-    ( 'rivera36', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
-    ( 'cooper25', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
-    ( 'mike3', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
-    ( 'rivera24', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
-    ( 'wright89', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
-    ( 'cooper12', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
-    ( 'paul32', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
-    ( 'wright11', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
-    ( 'maria51', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
-    ( 'morris85', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
-    ( 'chrishaydon88', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
-    ( 'brown49', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
-    ( 'mike55', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
-    ( 'paul6', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
-    ( 'james19', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
-    ( 'brown35', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
-    ( 'chrishaydon15', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
-    ( 'jenny0962', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
-    ( 'brooks57', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
-    ( 'chrishaydon7', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
-    ( 'morgan15', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
-    ( 'chrishaydon47', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
-    ( 'brooks41', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
-    ( 'mark61', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
-    ( 'brooks82', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
-    ( 'maria6', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
-    ( 'brown2', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
-    ( 'maria94', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
-    ( 'smith95', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
-    ( 'ross3', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
-    ( 'smith24', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
-    ( 'john19', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
-    ( 'michael93', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
+    ( 'rogers63_792', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
+    ( 'mike28_793', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
+    ( 'rivera92_794', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
+    ( 'ross95_795', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
+    ( 'paul85_796', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
+    ( 'smith34_797', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
+    ( 'james84_798', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
+    ( 'daniel53_799', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
+    ( 'brooks80_800', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
+    ( 'morgan65_801', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
+    ( 'sanders84_802', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
+    ( 'maria40_803', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
+    ( 'brown71_804', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
+    ( 'james63_805', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
+    ( 'jenny0993_806', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
+    ( 'john96_807', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
+    ( 'miller64_808', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
+    ( 'mark46_809', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
+    ( 'jenny0988_810', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
+    ( 'mark80_811', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
+    ( 'morris72_812', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
+    ( 'wright39_813', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
+    ( 'paul68_814', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
+    ( 'smith60_815', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
+    ( 'bell43_816', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
+    ( 'rogers79_817', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
+    ( 'daniel56_818', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
+    ( 'brooks85_819', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
+    ( 'mike30_820', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
+    ( 'paul92_821', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
+    ( 'bell96_822', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
+    ( 'john8_823', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
+    ( 'chrishaydon12_824', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
+    ( 'morgan13_825', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
+    ( 'james83_826', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
+    ( 'chrishaydon8_827', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
+    ( 'ross85_828', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
+    ( 'ross46_829', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
+    ( 'smith4_830', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
+    ( 'paul4_831', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
+    ( 'daniel26_832', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
+    ( 'chrishaydon2_833', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
+    ( 'david82_834', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
+    ( 'john97_835', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
+    ( 'david57_836', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
+    ( 'rivera100_837', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
+    ( 'bell13_838', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
+    ( 'brooks65_839', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
+    ( 'daniel40_840', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
+    ( 'cooper100_841', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
+    ( 'morris14_842', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
+    ( 'smith82_843', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
+    ( 'cooper35_844', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
+    ( 'morgan94_845', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
+    ( 'michael92_846', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
+    ( 'sanders48_847', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
+    ( 'brown76_848', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
+    ( 'james16_849', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
+    ( 'michael26_850', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
+    ( 'wright57_851', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
+    ( 'wright68_852', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
+    ( 'brooks1_853', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
+    ( 'bell2_854', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
+    ( 'miller100_855', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
+    ( 'rogers53_856', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
+    ( 'mike1_857', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
+    ( 'cooper57_858', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
+    ( 'daniel38_859', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
+    ( 'mark2_860', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
+    ( 'daniel79_861', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
+    ( 'wright4_862', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
+    ( 'brown84_863', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
+    ( 'paul41_864', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
+    ( 'mark5_865', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
+    ( 'jenny0994_866', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
+    ( 'morris53_867', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
+    ( 'paul68_868', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
+    ( 'brooks86_869', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
+    ( 'james54_870', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
+    ( 'rogers58_871', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
+    ( 'maria31_872', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
+    ( 'david5_873', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
+    ( 'mark21_874', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
+    ( 'jenny0932_875', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
+    ( 'john92_876', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
+    ( 'rogers98_877', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
+    ( 'rogers95_878', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
+    ( 'james50_879', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
+    ( 'miller80_880', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
+    ( 'mark29_881', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
+    ( 'cooper77_882', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
+    ( 'john24_883', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
+    ( 'chrishaydon32_884', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
+    ( 'bell41_885', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
+    ( 'ross99_886', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
+    ( 'smith9_887', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
+    ( 'miller73_888', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
+    ( 'michael44_889', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
+    ( 'michael36_890', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
+    ( 'smith93_891', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
+    ( 'morgan38_892', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
+    ( 'brown70_893', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
+    ( 'mike14_894', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
+    ( 'daniel72_895', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
+    ( 'jenny0974_896', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
+    ( 'maria51_897', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
+    ( 'michael97_898', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
+    ( 'chrishaydon33_899', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
+    ( 'sanders42_900', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
+    ( 'brooks87_901', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
+    ( 'bell4_902', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
+    ( 'jenny0945_903', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
+    ( 'james65_904', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
+    ( 'john98_905', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
+    ( 'paul80_906', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
+    ( 'john92_907', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
+    ( 'wright69_908', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
+    ( 'bell68_909', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
+    ( 'brooks97_910', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
+    ( 'mark14_911', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
+    ( 'david60_912', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
+    ( 'rivera68_913', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
+    ( 'brooks32_914', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
+    ( 'brown15_915', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
+    ( 'bell88_916', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
+    ( 'john26_917', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
+    ( 'paul55_918', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
+    ( 'david59_919', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
+    ( 'morris73_920', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
+    ( 'michael77_921', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
+    ( 'mark5_922', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
+    ( 'james65_923', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
+    ( 'maria39_924', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
+    ( 'michael37_925', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
+    ( 'sanders1_926', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
+    ( 'daniel30_927', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
+    ( 'brown53_928', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
+    ( 'john52_929', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
+    ( 'wright73_930', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
+    ( 'mark63_931', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
+    ( 'james29_932', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
+    ( 'mark23_933', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
+    ( 'cooper22_934', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
+    ( 'miller19_935', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
+    ( 'jenny0913_936', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
+    ( 'maria100_937', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
+    ( 'chrishaydon51_938', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
+    ( 'david9_939', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
+    ( 'mike52_940', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
+    ( 'wright5_941', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
+    ( 'brown21_942', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
+    ( 'daniel46_943', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
+    ( 'brown86_944', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
+    ( 'chrishaydon85_945', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
+    ( 'john41_946', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
+    ( 'mark72_947', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
+    ( 'michael67_948', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
+    ( 'jenny0943_949', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
+    ( 'daniel61_950', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
+    ( 'morgan34_951', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
+    ( 'jenny097_952', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
+    ( 'miller11_953', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
+    ( 'daniel99_954', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
+    ( 'miller20_955', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
+    ( 'sanders29_956', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
+    ( 'mark43_957', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
+    ( 'james86_958', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
+    ( 'chrishaydon74_959', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
+    ( 'brooks37_960', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
+    ( 'michael78_961', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
+    ( 'paul9_962', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
+    ( 'james57_963', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
+    ( 'mark91_964', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
+    ( 'wright30_965', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
+    ( 'rogers97_966', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
+    ( 'daniel8_967', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
+    ( 'mark66_968', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
+    ( 'daniel6_969', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
+    ( 'bell72_970', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
+    ( 'morgan77_971', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
+    ( 'bell24_972', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
+    ( 'michael31_973', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
+    ( 'cooper98_974', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
+    ( 'daniel41_975', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
+    ( 'michael1_976', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
+    ( 'chrishaydon20_977', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
+    ( 'daniel66_978', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
+    ( 'cooper52_979', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
+    ( 'chrishaydon99_980', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
+    ( 'smith66_981', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
+    ( 'mike48_982', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
+    ( 'rivera80_983', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
+    ( 'morgan35_984', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
+    ( 'brooks16_985', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
+    ( 'mike78_986', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
+    ( 'michael38_987', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
+    ( 'mark7_988', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
+    ( 'rogers80_989', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
+    ( 'wright81_990', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
+    ( 'michael21_991', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
+    ( 'daniel56_992', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
+    ( 'ross3_993', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
+    ( 'chrishaydon72_994', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
+    ( 'sanders66_995', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
+    ( 'michael4_996', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
+    ( 'brown66_997', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
+    ( 'wright26_998', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
+    ( 'james81_999', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
+    ( 'morris21_1000', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
+    ( 'john30_1001', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
+    ( 'jenny0929_1002', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
+    ( 'wright91_1003', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
+    ( 'jenny0922_1004', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
+    ( 'rivera35_1005', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
+    ( 'rogers53_1006', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
+    ( 'ross50_1007', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
+    ( 'ross41_1008', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
+    ( 'rivera78_1009', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
+    ( 'miller43_1010', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
+    ( 'morgan59_1011', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
+    ( 'chrishaydon63_1012', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
+    ( 'wright93_1013', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
+    ( 'maria62_1014', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
+    ( 'mark85_1015', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
+    ( 'wright3_1016', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
+    ( 'brooks37_1017', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
+    ( 'paul66_1018', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
+    ( 'rivera36_1019', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
+    ( 'paul42_1020', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
+    ( 'morgan93_1021', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
+    ( 'rivera43_1022', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
+    ( 'daniel71_1023', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
+    ( 'mike81_1024', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
+    ( 'daniel83_1025', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
+    ( 'smith54_1026', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
+    ( 'sanders38_1027', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
+    ( 'morris63_1028', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
+    ( 'michael25_1029', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
+    ( 'smith89_1030', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
+    ( 'john97_1031', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
+    ( 'jenny0985_1032', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
+    ( 'wright84_1033', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
+    ( 'maria53_1034', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
+    ( 'brooks72_1035', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
+    ( 'brown61_1036', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
+    ( 'paul95_1037', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
+    ( 'brooks92_1038', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
+    ( 'james44_1039', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
+    ( 'chrishaydon100_1040', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
+    ( 'mark97_1041', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
+    ( 'brooks91_1042', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
+    ( 'jenny0929_1043', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
+    ( 'morgan37_1044', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
+    ( 'daniel43_1045', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
+    ( 'mike98_1046', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
+    ( 'john48_1047', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
+    ( 'brooks65_1048', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
+    ( 'cooper18_1049', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
+    ( 'chrishaydon54_1050', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
+    ( 'john67_1051', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
+    ( 'morris27_1052', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
+    ( 'ross45_1053', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
+    ( 'rogers85_1054', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
+    ( 'sanders96_1055', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
+    ( 'smith70_1056', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
+    ( 'wright31_1057', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
+    ( 'paul10_1058', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
+    ( 'sanders2_1059', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
+    ( 'rogers14_1060', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
+    ( 'morgan79_1061', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
+    ( 'morris87_1062', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
+    ( 'james6_1063', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
+    ( 'wright33_1064', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
+    ( 'maria85_1065', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
+    ( 'ross31_1066', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
+    ( 'chrishaydon75_1067', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
+    ( 'david54_1068', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
+    ( 'chrishaydon50_1069', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
+    ( 'miller24_1070', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
+    ( 'maria9_1071', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
+    ( 'smith91_1072', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
+    ( 'rivera11_1073', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
+    ( 'james72_1074', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
+    ( 'miller36_1075', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
+    ( 'jenny0991_1076', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
+    ( 'jenny0959_1077', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
+    ( 'mike41_1078', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
+    ( 'morgan20_1079', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
+    ( 'miller29_1080', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
+    ( 'ross56_1081', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
+    ( 'chrishaydon63_1082', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
+    ( 'morris54_1083', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
+    ( 'bell20_1084', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
+    ( 'paul41_1085', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
+    ( 'morgan42_1086', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
+    ( 'ross100_1087', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
+    ( 'wright1_1088', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
+    ( 'jenny0921_1089', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
+    ( 'paul37_1090', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
+    ( 'michael92_1091', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
+    ( 'chrishaydon14_1092', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
+    ( 'miller40_1093', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
+    ( 'wright43_1094', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
+    ( 'smith15_1095', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
+    ( 'miller49_1096', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
+    ( 'morris21_1097', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
+    ( 'ross27_1098', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
+    ( 'rivera16_1099', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
+    ( 'maria73_1100', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
+    ( 'chrishaydon60_1101', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
+    ( 'david40_1102', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
+    ( 'michael66_1103', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
+    ( 'paul27_1104', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
+    ( 'jenny0911_1105', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
+    ( 'miller79_1106', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
+    ( 'mark14_1107', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
+    ( 'ross87_1108', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
+    ( 'paul47_1109', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
+    ( 'sanders61_1110', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
+    ( 'rivera13_1111', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
+    ( 'chrishaydon22_1112', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
+    ( 'sanders42_1113', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
+    ( 'david78_1114', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
+    ( 'ross60_1115', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
+    ( 'morris24_1116', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
+    ( 'jenny0952_1117', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
+    ( 'rogers72_1118', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
+    ( 'daniel70_1119', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
+    ( 'cooper76_1120', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
+    ( 'jenny0942_1121', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
+    ( 'daniel39_1122', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
+    ( 'morris59_1123', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
+    ( 'mike70_1124', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
+    ( 'rogers20_1125', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
+    ( 'david33_1126', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
+    ( 'morris65_1127', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
+    ( 'michael23_1128', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
+    ( 'john96_1129', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
+    ( 'wright64_1130', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
+    ( 'david91_1131', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
+    ( 'daniel87_1132', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
+    ( 'ross60_1133', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
+    ( 'morris60_1134', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
+    ( 'rivera50_1135', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
+    ( 'maria77_1136', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
+    ( 'morgan64_1137', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
+    ( 'miller41_1138', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
+    ( 'david65_1139', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
+    ( 'morgan96_1140', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
+    ( 'ross73_1141', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
+    ( 'brooks95_1142', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
+    ( 'sanders92_1143', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
+    ( 'cooper53_1144', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
+    ( 'ross100_1145', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
+    ( 'michael91_1146', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
+    ( 'brooks51_1147', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
+    ( 'ross26_1148', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
+    ( 'morris58_1149', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
+    ( 'morris58_1150', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
+    ( 'ross12_1151', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
+    ( 'brooks60_1152', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
+    ( 'mark29_1153', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
+    ( 'john14_1154', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
+    ( 'rivera26_1155', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
+    ( 'jenny0949_1156', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
+    ( 'rogers96_1157', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
+    ( 'david55_1158', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
+    ( 'chrishaydon3_1159', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
+    ( 'ross81_1160', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
+    ( 'john70_1161', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
+    ( 'miller34_1162', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
+    ( 'bell26_1163', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
+    ( 'ross92_1164', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
+    ( 'rogers34_1165', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
+    ( 'james51_1166', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
+    ( 'ross54_1167', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
+    ( 'brown59_1168', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
+    ( 'michael83_1169', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
+    ( 'miller10_1170', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
+    ( 'jenny0939_1171', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
+    ( 'paul100_1172', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
+    ( 'smith82_1173', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
+    ( 'morris6_1174', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
+    ( 'paul76_1175', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
+    ( 'mark97_1176', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
+    ( 'john74_1177', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
+    ( 'miller78_1178', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
+    ( 'miller54_1179', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
+    ( 'chrishaydon9_1180', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
+    ( 'daniel4_1181', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
+    ( 'john24_1182', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
+    ( 'smith31_1183', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
+    ( 'smith77_1184', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
+    ( 'maria29_1185', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
+    ( 'michael76_1186', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
+    ( 'mark64_1187', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
+    ( 'morris5_1188', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
+    ( 'brown69_1189', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
+    ( 'miller53_1190', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
+    ( 'wright94_1191', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
+    ( 'rogers26_1192', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
+    ( 'jenny0977_1193', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
+    ( 'daniel23_1194', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
+    ( 'cooper66_1195', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
+    ( 'chrishaydon61_1196', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
+    ( 'maria95_1197', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
+    ( 'james17_1198', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
+    ( 'james18_1199', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
+    ( 'mike47_1200', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
+    ( 'john57_1201', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
+    ( 'mark54_1202', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
+    ( 'bell38_1203', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
+    ( 'morgan31_1204', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
+    ( 'miller16_1205', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
+    ( 'rivera14_1206', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
+    ( 'rogers22_1207', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
+    ( 'jenny0963_1208', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
+    ( 'mark42_1209', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
+    ( 'mark7_1210', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
+    ( 'john1_1211', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
+    ( 'chrishaydon3_1212', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
+    ( 'smith11_1213', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
+    ( 'john22_1214', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
+    ( 'rivera23_1215', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
+    ( 'james32_1216', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
+    ( 'daniel58_1217', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
+    ( 'james18_1218', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
+    ( 'brown93_1219', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
+    ( 'bell89_1220', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
+    ( 'miller82_1221', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
+    ( 'paul21_1222', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
+    ( 'chrishaydon88_1223', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
+    ( 'rivera44_1224', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
+    ( 'bell89_1225', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
+    ( 'bell66_1226', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
+    ( 'rogers31_1227', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
+    ( 'bell47_1228', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
+    ( 'sanders58_1229', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
+    ( 'mark26_1230', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
+    ( 'miller22_1231', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
+    ( 'daniel46_1232', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
+    ( 'morgan100_1233', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
+    ( 'ross75_1234', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
+    ( 'david68_1235', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
+    ( 'rivera35_1236', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
+    ( 'daniel63_1237', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
+    ( 'ross37_1238', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
+    ( 'michael23_1239', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
+    ( 'mike48_1240', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
+    ( 'david57_1241', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
+    ( 'morris67_1242', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
+    ( 'rogers49_1243', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
+    ( 'morris14_1244', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
+    ( 'brooks74_1245', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
+    ( 'mike71_1246', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
+    ( 'mark18_1247', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
+    ( 'mark9_1248', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
+    ( 'brooks21_1249', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
+    ( 'bell4_1250', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
+    ( 'brown17_1251', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
+    ( 'jenny0923_1252', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
+    ( 'smith66_1253', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
+    ( 'daniel53_1254', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
+    ( 'brown33_1255', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
+    ( 'mark55_1256', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
+    ( 'rivera83_1257', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
+    ( 'paul72_1258', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
+    ( 'david25_1259', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
+    ( 'paul47_1260', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
+    ( 'james61_1261', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
+    ( 'paul90_1262', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
+    ( 'david95_1263', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
+    ( 'paul52_1264', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
+    ( 'wright82_1265', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
+    ( 'james61_1266', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
+    ( 'michael89_1267', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
+    ( 'wright44_1268', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
+    ( 'chrishaydon60_1269', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
+    ( 'john30_1270', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
+    ( 'rivera2_1271', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
+    ( 'wright17_1272', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
+    ( 'rivera30_1273', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
+    ( 'daniel63_1274', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
+    ( 'brooks56_1275', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
+    ( 'wright29_1276', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
+    ( 'morris52_1277', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
+    ( 'morgan10_1278', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
+    ( 'sanders55_1279', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
+    ( 'mike9_1280', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
+    ( 'michael69_1281', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
+    ( 'david18_1282', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
+    ( 'daniel41_1283', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
+    ( 'david59_1284', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
+    ( 'daniel17_1285', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
+    ( 'john45_1286', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
+    ( 'morgan17_1287', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
+    ( 'john42_1288', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
+    ( 'rogers50_1289', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
+    ( 'smith35_1290', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
+    ( 'mark56_1291', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
+    ( 'chrishaydon12_1292', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
+    ( 'mark90_1293', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
+    ( 'maria85_1294', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
+    ( 'rogers42_1295', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
+    ( 'brooks95_1296', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
+    ( 'brooks38_1297', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
+    ( 'morris49_1298', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
+    ( 'david86_1299', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
+    ( 'wright15_1300', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
+    ( 'wright75_1301', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
+    ( 'sanders78_1302', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
+    ( 'jenny092_1303', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
+    ( 'cooper68_1304', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
+    ( 'paul87_1305', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
+    ( 'brooks59_1306', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
+    ( 'rivera36_1307', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
+    ( 'cooper25_1308', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
+    ( 'mike3_1309', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
+    ( 'rivera24_1310', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
+    ( 'wright89_1311', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
+    ( 'cooper12_1312', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
+    ( 'paul32_1313', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
+    ( 'wright11_1314', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
+    ( 'maria51_1315', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
+    ( 'morris85_1316', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
+    ( 'chrishaydon88_1317', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
+    ( 'brown49_1318', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
+    ( 'mike55_1319', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
+    ( 'paul6_1320', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
+    ( 'james19_1321', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
+    ( 'brown35_1322', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
+    ( 'chrishaydon15_1323', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
+    ( 'jenny0962_1324', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
+    ( 'brooks57_1325', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
+    ( 'chrishaydon7_1326', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
+    ( 'morgan15_1327', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
+    ( 'chrishaydon47_1328', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
+    ( 'brooks41_1329', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
+    ( 'mark61_1330', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
+    ( 'brooks82_1331', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
+    ( 'maria6_1332', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
+    ( 'brown2_1333', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
+    ( 'maria94_1334', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
+    ( 'smith95_1335', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
+    ( 'ross3_1336', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
+    ( 'smith24_1337', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
+    ( 'john19_1338', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
+    ( 'michael93_1339', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
+    ( 'bell35_1340', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
+    ( 'david80_1341', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
+    ( 'paul3_1342', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
+    ( 'michael27_1343', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
+    ( 'smith12_1344', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
+    ( 'maria61_1345', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
+    ( 'daniel3_1346', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
+    ( 'jenny0923_1347', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
+    ( 'morris5_1348', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
+    ( 'ross58_1349', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
+    ( 'michael48_1350', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
+    ( 'brown92_1351', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
+    ( 'morgan54_1352', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
+    ( 'sanders87_1353', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
+    ( 'paul54_1354', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
+    ( 'cooper59_1355', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
+    ( 'brooks16_1356', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
+    ( 'rivera44_1357', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
+    ( 'sanders60_1358', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
+    ( 'morgan82_1359', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
+    ( 'john69_1360', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
+    ( 'david36_1361', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
+    ( 'mike88_1362', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
+    ( 'david9_1363', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
+    ( 'david34_1364', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
+    ( 'sanders20_1365', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
+    ( 'mike22_1366', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
+    ( 'maria63_1367', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
+    ( 'miller94_1368', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
+    ( 'chrishaydon17_1369', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
+    ( 'brooks60_1370', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
+    ( 'bell34_1371', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
+    ( 'chrishaydon65_1372', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
+    ( 'paul70_1373', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
+    ( 'jenny0972_1374', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
+    ( 'john41_1375', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
+    ( 'smith78_1376', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
+    ( 'morgan55_1377', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
+    ( 'smith81_1378', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
+    ( 'jenny0988_1379', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
+    ( 'chrishaydon49_1380', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
+    ( 'morris31_1381', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
+    ( 'mike15_1382', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
+    ( 'smith61_1383', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
+    ( 'chrishaydon42_1384', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
+    ( 'john62_1385', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
+    ( 'maria40_1386', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
+    ( 'james50_1387', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
+    ( 'ross63', 'morgan', 'mike', 'Female', '70d70c6d340b463fc4b3fde8d133de6d', 1),
+    ( 'brown93_1389', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
+    ( 'maria85_1390', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
+    ( 'miller63_1391', 'morgan', 'morris', 'Female', 'ee33d4596af639660986c67f04e6ff92', 1),
+    ( 'smith50_1392', 'brown', 'brown', 'Female', 'bd1e4b23c510e1eae5fa69baa618f3e2', 1),
+    ( 'mike83_1393', 'smith', 'cooper', 'Female', '4a3309bacad462ce5af197a808839446', 1),
+    ( 'mark90_1394', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
+    ( 'morris71_1395', 'rogers', 'sanders', 'Male', 'a7fd9a391ed17cc9be339bcb87af3451', 1),
+    ( 'brown70_1396', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
+    ( 'jenny0930_1397', 'bell', 'rivera', 'Female', 'c25f2f612dde293ec2155a5dfe7901f2', 1),
+    ( 'smith60_1398', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
+    ( 'cooper56_1399', 'ross', 'mike', 'Female', '81b32a932116fed6d09aaa2c2bc522df', 1),
+    ( 'chrishaydon67_1400', 'wright', 'mark', 'Female', 'a4808f35e9eca8fd90326ad5632ff220', 1),
+    ( 'cooper90_1401', 'ross', 'rivera', 'Male', '077380568f1971f1003978046142baeb', 1),
+    ( 'morris82_1402', 'ross', 'miller', 'Female', '8d863a559ac2d9fba0dfa98649e55637', 1),
+    ( 'morgan3_1403', 'sanders', 'mark', 'Male', '4d5a20ef5496dae22ea2bbfa40defc12', 1),
+    ( 'daniel25_1404', 'michael', 'ross', 'Female', '6794c36a5638c0c0df58155493512e60', 1),
+    ( 'rivera64_1405', 'morgan', 'mike', 'Female', '041dcc147d542af1dc6bf50192c1b10f', 1),
+    ( 'bell41_1406', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
+    ( 'rivera34_1407', 'bell', 'paul', 'Male', '7d4a65a26f424093d93857b14104585e', 1),
+    ( 'chrishaydon22_1408', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
+    ( 'miller53_1409', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
+    ( 'miller58_1410', 'sanders', 'paul', 'Male', '3fa66dc95e8f6e524cd5afbf4abfebdf', 1),
+    ( 'wright41_1411', 'smith', 'sanders', 'Female', '7ba55de0a483cd485e1629176c9fa5ac', 1),
+    ( 'john69_1412', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
+    ( 'smith6_1413', 'smith', 'paul', 'Female', '69b7b0f8981d3f462bddcd10e83d73b8', 1),
+    ( 'rogers88_1414', 'miller', 'rivera', 'Male', '18b2b0e6b66ce69949cfd500bdbd547b', 1),
+    ( 'john98_1415', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
+    ( 'paul69_1416', 'john', 'morgan', 'Male', '98bdeac0dbd9377cd342ab3e2ed67002', 1),
+    ( 'morris55_1417', 'wright', 'brown', 'Male', 'e808e39b0369890ca613a24d1bdbe80e', 1),
+    ( 'paul3_1418', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
+    ( 'rogers28_1419', 'brown', 'brown', 'Male', 'cb1b08d86f6053ce02919717671bd81f', 1),
+    ( 'brown16_1420', 'james', 'chrishaydon', 'Female', 'ef70b7e590eb6ef3d09bdb05084610e4', 1),
+    ( 'david68_1421', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
+    ( 'michael8_1422', 'paul', 'morgan', 'Female', '6691fbe0b3d4d260b0ada3cd9c0b78f5', 1),
+    ( 'sanders67_1423', 'brooks', 'paul', 'Male', 'ddf8e1b6ed97cdf30cb35d0f9e47517b', 1),
+    ( 'rivera75_1424', 'brooks', 'brooks', 'Male', 'fb77723ac6a75b2dfa7636213fc12803', 1),
+    ( 'miller81_1425', 'ross', 'david', 'Female', 'e5e1fa5d4c971a980e6d087016507eb7', 1),
+    ( 'jenny0952_1426', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
+    ( 'rivera53_1427', 'bell', 'smith', 'Female', '1e3e64c56121038797a7e50b655464c6', 1),
+    ( 'morgan33_1428', 'wright', 'brown', 'Male', '8e5a808681a0070beaf455c2cabb782b', 1),
+    ( 'ross27_1429', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
+    ( 'miller94_1430', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
+    ( 'michael14_1431', 'paul', 'sanders', 'Female', '9462cebb50643d2a7731b89c5b1b8fe3', 1),
+    ( 'rogers60_1432', 'mark', 'smith', 'Male', 'bef7a008c13dcc9dd7947e5f248a1cfd', 1),
+    ( 'maria50_1433', 'rivera', 'miller', 'Male', '64b7a989ccd1e83d881215cddbeafb72', 1),
+    ( 'morgan96_1434', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
+    ( 'ross49_1435', 'sanders', 'jenny09', 'Female', '53083ad87812f62ccd391f01069e77b5', 1),
+    ( 'wright37_1436', 'miller', 'chrishaydon', 'Male', '0f8b225730e5be91f0f4cbb29156cf44', 1),
+    ( 'brown38_1437', 'ross', 'morgan', 'Male', '07a841d9d548265d67ac8897f60f1b06', 1),
+    ( 'michael50_1438', 'mike', 'rogers', 'Female', 'a366cae9b72e785998b0ce78a2284bdb', 1),
+    ( 'wright93_1439', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
+    ( 'miller91_1440', 'mike', 'chrishaydon', 'Male', 'edb8e845ed0dc8c888f7225fa43eb2a6', 1),
+    ( 'daniel21_1441', 'cooper', 'sanders', 'Female', 'df3e462d93781ea422c125a4c2b5566a', 1),
+    ( 'bell44_1442', 'ross', 'smith', 'Male', 'a49601f3e6a85c0387539bba4bcdbe6c', 1),
+    ( 'brooks58_1443', 'ross', 'john', 'Male', '6e5b2a0375b4d4d500ee07e62cad6d6c', 1),
+    ( 'chrishaydon86_1444', 'sanders', 'david', 'Female', 'c3bf2214f10104ec078bed756539f9af', 1),
+    ( 'jenny0910_1445', 'paul', 'smith', 'Male', '58eea070ea61891bfea5954c38cb9336', 1),
+    ( 'rivera53_1446', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
+    ( 'brown75_1447', 'sanders', 'rivera', 'Female', '7c5cef8a2e387abe6908ee2f3fbc534a', 1),
+    ( 'maria40_1448', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
+    ( 'john12_1449', 'sanders', 'sanders', 'Male', 'cba0f422fbd342f81feb1dd78a43cad6', 1),
+    ( 'sanders88_1450', 'paul', 'miller', 'Male', 'b6153be014b1af1811c85aa770c4cc46', 1),
+    ( 'morris98_1451', 'morgan', 'brooks', 'Female', 'd793df005919fa5c4f45a61a7f9f8e87', 1),
+    ( 'miller17_1452', 'daniel', 'brown', 'Female', 'a11bf4bd7d2f8c8162d97c7447ab07f8', 1),
+    ( 'cooper84_1453', 'miller', 'miller', 'Male', '941752ab63605763d87142453aa35988', 1),
+    ( 'ross49_1454', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
+    ( 'morgan54_1455', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
+    ( 'chrishaydon29_1456', 'wright', 'cooper', 'Female', 'b6ed3804fd6ad8ccd1c85c0a7deda4e3', 1),
+    ( 'paul3_1457', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
+    ( 'morris98_1458', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
+    ( 'cooper55_1459', 'mark', 'ross', 'Female', '6c794b04248c5d6120dd84b8e94967a7', 1),
+    ( 'rivera85_1460', 'brown', 'brooks', 'Female', '0ca4d10e4c787cc061e18feb815f747b', 1),
+    ( 'rogers43_1461', 'jenny09', 'mike', 'Female', 'aa98ad5a318401147dc9580a9e134a7e', 1),
+    ( 'ross42_1462', 'mark', 'michael', 'Female', '1507a0565d69991ff963cd3489b1c491', 1),
+    ( 'james29_1463', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
+    ( 'chrishaydon20_1464', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
+    ( 'wright68_1465', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
+    ( 'cooper47_1466', 'ross', 'paul', 'Male', '524f2968fabb85f0fdb2fa63110f6690', 1),
+    ( 'morgan73_1467', 'chrishaydon', 'cooper', 'Male', '33b7c4b03c90ac30be52d86c3229577b', 1),
+    ( 'maria82_1468', 'daniel', 'daniel', 'Male', '9a83d41058fafd2a50b9fcce02811361', 1),
+    ( 'john8_1469', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
+    ( 'miller63_1470', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
+    ( 'morgan26_1471', 'michael', 'rogers', 'Male', 'e4a653c83b7fa702099b4c780e5fa68d', 1),
+    ( 'rivera69_1472', 'brown', 'david', 'Female', 'b58472e08b58b46875487093c2867ec6', 1),
+    ( 'daniel31_1473', 'john', 'rogers', 'Male', '9d84f6219123fb1920cf2e7494a44d77', 1),
+    ( 'sanders100_1474', 'michael', 'john', 'Male', '349fda3c9d90de7e62c9ada6c0550a8b', 1),
+    ( 'mark84_1475', 'morris', 'chrishaydon', 'Female', '8f9307568ba555323b0599ce0c715b1a', 1),
+    ( 'smith56_1476', 'david', 'paul', 'Male', 'baaf6963e059f92921d196b5fef6669d', 1),
+    ( 'morris93_1477', 'chrishaydon', 'james', 'Female', 'dba13ee70b381ea5b9ceac0ece3d850f', 1),
+    ( 'michael45_1478', 'michael', 'paul', 'Female', 'd9f0344646b6ba7f224d9d3a0986cc7e', 1),
+    ( 'mike88_1479', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
+    ( 'morgan96_1480', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
+    ( 'james5_1481', 'brooks', 'mike', 'Female', 'ac332ec30f36d30d5bf9221fe1b32bf0', 1),
+    ( 'morris83_1482', 'sanders', 'maria', 'Male', 'fcb81feea910ea24117d4f26171991f8', 1),
+    ( 'rivera19_1483', 'rivera', 'bell', 'Female', '0ba1e8782ffcf3e71c079e1e54c0a41c', 1),
+    ( 'daniel57_1484', 'paul', 'bell', 'Female', '62d04b1633be93a4b4f93256a56e28d0', 1),
+    ( 'maria10_1485', 'wright', 'sanders', 'Female', '9c8c23c03a74a7a320d742aa95cf08e3', 1),
+    ( 'david71_1486', 'jenny09', 'david', 'Male', '16a061f8fdf5c93e9e77631dfb7d471a', 1),
+    ( 'sanders88_1487', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
+    ( 'rogers37_1488', 'chrishaydon', 'brown', 'Male', '822141a673303a54b9387d8fc6a0c425', 1),
+    ( 'chrishaydon10_1489', 'chrishaydon', 'john', 'Male', '1b7d631cf551c86b671fd6bc2ec9d0dc', 1),
+    ( 'sanders27_1490', 'bell', 'morris', 'Male', '576352444376fe3069d256ded463666f', 1),
+    ( 'brown17_1491', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
+    ( 'morris86_1492', 'rogers', 'ross', 'Male', '896b690b9566f53a875e03a3a324c091', 1),
+    ( 'mark79_1493', 'bell', 'sanders', 'Male', 'cee8dbd9130bccbe4d945c9cfc41f330', 1),
+    ( 'david58_1494', 'david', 'morgan', 'Female', 'ca391efb9f114371dced52c8eb18a412', 1),
+    ( 'daniel95_1495', 'wright', 'ross', 'Male', '4458808784017babe1bb3ed79c61f0f7', 1),
+    ( 'brooks49_1496', 'sanders', 'morgan', 'Female', 'dbd61cc2c16fa763662c8a29d63215e0', 1),
+    ( 'jenny0926_1497', 'wright', 'david', 'Female', 'd2d4451b918dbb9730bb7e010a50ab60', 1),
+    ( 'bell37_1498', 'morgan', 'john', 'Female', '9019a44759e7bdece498cc5dce570446', 1),
+    ( 'john46_1499', 'miller', 'john', 'Female', 'c618d2bfb0bf88f1de98313820f226a8', 1),
+    ( 'chrishaydon43_1500', 'miller', 'chrishaydon', 'Female', 'e63ca65668b82c9fd07eef6b719ad4aa', 1),
+    ( 'rogers19_1501', 'mike', 'john', 'Male', '59843bfb2ca080a23bcbbc5278fa6982', 1),
+    ( 'brooks29_1502', 'rivera', 'rivera', 'Female', 'f151014abd62636e598dbad4a5b43aa3', 1),
+    ( 'chrishaydon74_1503', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
+    ( 'brooks43_1504', 'morgan', 'cooper', 'Male', '669e54b4368a4a99595fe4a56b9bf6ff', 1),
+    ( 'ross44_1505', 'morgan', 'smith', 'Female', 'a84cf2d984ed2eb7948b9fda85275a7f', 1),
+    ( 'bell63_1506', 'chrishaydon', 'james', 'Male', '863d1292a4a36cdc9134a7f84e8070c9', 1),
+    ( 'rivera41_1507', 'maria', 'mark', 'Male', 'a9dd9815cf33560650fc661a97f41547', 1),
+    ( 'wright17_1508', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
+    ( 'michael15_1509', 'david', 'mark', 'Female', 'a167a5d9cf8157d98d45c1d0e32b72f4', 1),
+    ( 'ross53_1510', 'morris', 'daniel', 'Female', 'b4a6baa51bd47cf407213f6ba9254f71', 1),
+    ( 'cooper65_1511', 'brooks', 'mark', 'Female', '26f039d32dad1e54e8f74b015ea9ec68', 1),
+    ( 'rivera2_1512', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
+    ( 'james32_1513', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
+    ( 'mark97_1514', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
+    ( 'smith93_1515', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
+    ( 'chrishaydon84_1516', 'james', 'brown', 'Male', '5957038b6a3f0a0f305123af998bc437', 1),
+    ( 'smith30_1517', 'maria', 'wright', 'Male', '62d191a19bda44ba011ebd37976b5a23', 1),
+    ( 'maria51_1518', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
+    ( 'rivera52_1519', 'cooper', 'michael', 'Male', 'c15a7058e0c14b26f6863fbd7549a1e7', 1),
+    ( 'chrishaydon48_1520', 'david', 'cooper', 'Female', 'e51d84e0afa82e704755b26fe59f566e', 1),
+    ( 'morgan90_1521', 'mike', 'maria', 'Female', 'e78f1e49da6ebf2fe756ec38b646ba36', 1),
+    ( 'sanders53_1522', 'bell', 'mark', 'Female', '15d2a8035ab8f89a8d2388049cdb1ee9', 1),
+    ( 'john47_1523', 'sanders', 'sanders', 'Female', 'd3945958288a3f00f1d5fe7230670061', 1),
+    ( 'daniel100_1524', 'paul', 'cooper', 'Male', '1ca7a74ac2257ad3c0f5885cb90099f2', 1),
+    ( 'wright13_1525', 'brown', 'mark', 'Male', '35e1bd4961d74f12ed2e2399f219aaa9', 1),
+    ( 'morgan86_1526', 'brown', 'daniel', 'Female', 'ba7d8e424270cfe9f48eb09c99ff68a1', 1),
+    ( 'wright17_1527', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
+    ( 'morris93_1528', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
+    ( 'james40_1529', 'rogers', 'rogers', 'Male', 'b44bc9d82406f9a445889a66b255f271', 1),
+    ( 'ross47_1530', 'sanders', 'chrishaydon', 'Male', '4592e91917d68abf8835aeae27f38cfc', 1),
+    ( 'wright8_1531', 'bell', 'cooper', 'Male', 'e30161a98a0e9e3933dbb856e6af4719', 1),
+    ( 'ross83_1532', 'morris', 'sanders', 'Female', '2ec9470fb5a92621648ad5c708b050f8', 1),
+    ( 'ross9_1533', 'bell', 'sanders', 'Female', '85b8a1781fe0eda5fcdb86d950c2e239', 1),
+    ( 'miller47_1534', 'morris', 'david', 'Female', '2fb2bd61613de4adc3ab2fc7fc05fe88', 1),
+    ( 'smith28_1535', 'brown', 'john', 'Female', '2e3a6218c8c4652563470fd23b5c9004', 1),
+    ( 'morris2_1536', 'bell', 'jenny09', 'Male', '9248ee5ee8d1ff997d0009348e2ea662', 1),
+    ( 'morris4_1537', 'rogers', 'brooks', 'Male', 'd9290c1d84c18de491112fab16fc0172', 1),
+    ( 'jenny0926_1538', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
+    ( 'morgan21_1539', 'john', 'cooper', 'Male', 'c1c1328643f85dca460e8ead1600c650', 1),
+    ( 'david3_1540', 'michael', 'miller', 'Male', '6c5c00ec2728ed2459e821dce4a85349', 1),
+    ( 'ross58_1541', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
+    ( 'jenny0949_1542', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
+    ( 'john96_1543', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
+    ( 'paul5_1544', 'rivera', 'ross', 'Male', '13a3756c2cb7b486e32054a08271d3f1', 1),
+    ( 'sanders80_1545', 'michael', 'james', 'Female', '73f63edc5f6f3b571886fbb1316c43bc', 1),
+    ( 'smith65_1546', 'brooks', 'bell', 'Male', '7ba86a4101f3074f458df9a6e43e3f5a', 1),
+    ( 'rogers26_1547', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
+    ( 'maria43_1548', 'miller', 'bell', 'Male', '0c879f0908f637ebe8cc0db994ae0832', 1),
+    ( 'david99_1549', 'maria', 'jenny09', 'Female', 'b38a8c25edada7483420c20a76b9d4b0', 1),
+    ( 'wright20_1550', 'smith', 'rogers', 'Female', 'e11d269be9b74106223b357b06f2da33', 1),
+    ( 'brooks87_1551', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
+    ( 'mike43_1552', 'morgan', 'ross', 'Male', '024142778439c88b675219780cf1c578', 1),
+    ( 'ross30_1553', 'mark', 'david', 'Male', 'c5fb92d8af4e45581d8bb7c71a5c0a1c', 1),
+    ( 'jenny0981_1554', 'morgan', 'brooks', 'Female', '34ae1e72198b2ccb37610a3269368fa7', 1),
+    ( 'cooper11_1555', 'james', 'james', 'Female', '243cffae81569cca39840aec1e9e2852', 1),
+    ( 'rogers97_1556', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
+    ( 'wright84_1557', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
+    ( 'jenny0932_1558', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
+    ( 'sanders40_1559', 'paul', 'sanders', 'Female', 'be92492fa64d6126726a71584a75a878', 1),
+    ( 'john80_1560', 'daniel', 'ross', 'Female', '8a608ab5ea3865ae688ac2f8b1cc3b61', 1),
+    ( 'brooks58_1561', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
+    ( 'miller37_1562', 'brooks', 'bell', 'Female', '62c1ace1a0aa8e798169f547586457c8', 1),
+    ( 'james93_1563', 'chrishaydon', 'james', 'Male', '7ef00ef6e7f47472db040222dae2dc8e', 1),
+    ( 'bell16_1564', 'bell', 'james', 'Male', '0d5e1a6eab996a7d1d32a0e2ed22ff2a', 1),
+    ( 'smith96_1565', 'mike', 'bell', 'Female', '68f2fb17fd3f13d8a0a394de396ce447', 1),
+    ( 'ross94_1566', 'david', 'bell', 'Male', '84c62bc5b6f1dab11a1ca3d8d7a5e29e', 1),
+    ( 'ross95_1567', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
+    ( 'chrishaydon82_1568', 'jenny09', 'maria', 'Female', '8093f823794b8fa03c379c035300fd0b', 1),
+    ( 'michael3_1569', 'mike', 'jenny09', 'Male', 'fc13f6e30b38a8529a57add8a59b5ec0', 1),
+    ( 'jenny0918_1570', 'rogers', 'brown', 'Male', '8b9e34ac36a2fd947614e2616f3d6826', 1),
+    ( 'rogers43_1571', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
+    ( 'morris91_1572', 'paul', 'rivera', 'Male', '613be5cffa8a578c1816a1baf6bb8501', 1),
+    ( 'john4_1573', 'james', 'rivera', 'Female', '76ebe07cd3c27e769f71251f294d4c94', 1),
+    ( 'cooper5_1574', 'wright', 'morris', 'Male', '8105c71bb32ba7bf731c4162ff9c1f21', 1),
+    ( 'chrishaydon20_1575', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
+    ( 'chrishaydon44_1576', 'mark', 'smith', 'Female', 'a54db1865fb0fa3a66861d93e037741d', 1),
+    ( 'daniel13_1577', 'brooks', 'brown', 'Female', '91270e97491a918496e6569c9fcff808', 1),
+    ( 'chrishaydon37_1578', 'brown', 'mark', 'Male', 'a3efe2fadf9e33423b80532af0f95c33', 1),
+    ( 'david31_1579', 'wright', 'paul', 'Female', 'b8e0691f01b08a810fade049028d9c3e', 1),
+    ( 'mark21_1580', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
+    ( 'wright81_1581', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
+    ( 'michael13_1582', 'morris', 'cooper', 'Female', '9d450e0529ca7328d07679163206f732', 1),
+    ( 'rogers63_1583', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
+    ( 'mike28_1584', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
+    ( 'rivera92_1585', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
+    ( 'ross95_1586', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
+    ( 'paul85_1587', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
+    ( 'smith34_1588', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
+    ( 'james84_1589', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
+    ( 'daniel53_1590', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
+    ( 'brooks80_1591', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
+    ( 'morgan65_1592', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
+    ( 'sanders84_1593', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
+    ( 'maria40_1594', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
+    ( 'brown71_1595', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
+    ( 'james63_1596', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
+    ( 'jenny0993_1597', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
+    ( 'john96_1598', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
+    ( 'miller64_1599', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
+    ( 'mark46_1600', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
+    ( 'jenny0988_1601', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
+    ( 'mark80_1602', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
+    ( 'morris72_1603', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
+    ( 'wright39_1604', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
+    ( 'paul68_1605', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
+    ( 'smith60_1606', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
+    ( 'bell43_1607', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
+    ( 'rogers79_1608', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
+    ( 'daniel56_1609', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
+    ( 'brooks85_1610', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
+    ( 'mike30_1611', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
+    ( 'paul92_1612', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
+    ( 'bell96_1613', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
+    ( 'john8_1614', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
+    ( 'chrishaydon12_1615', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
+    ( 'morgan13_1616', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
+    ( 'james83_1617', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
+    ( 'chrishaydon8_1618', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
+    ( 'ross85_1619', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
+    ( 'ross46_1620', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
+    ( 'smith4_1621', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
+    ( 'paul4_1622', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
+    ( 'daniel26_1623', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
+    ( 'chrishaydon2_1624', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
+    ( 'david82_1625', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
+    ( 'john97_1626', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
+    ( 'david57_1627', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
+    ( 'rivera100_1628', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
+    ( 'bell13_1629', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
+    ( 'brooks65_1630', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
+    ( 'daniel40_1631', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
+    ( 'cooper100_1632', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
+    ( 'morris14_1633', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
+    ( 'smith82_1634', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
+    ( 'cooper35_1635', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
+    ( 'morgan94_1636', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
+    ( 'michael92_1637', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
+    ( 'sanders48_1638', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
+    ( 'brown76_1639', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
+    ( 'james16_1640', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
+    ( 'michael26_1641', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
+    ( 'wright57_1642', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
+    ( 'wright68_1643', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
+    ( 'brooks1_1644', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
+    ( 'bell2_1645', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
+    ( 'miller100_1646', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
+    ( 'rogers53_1647', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
+    ( 'mike1_1648', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
+    ( 'cooper57_1649', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
+    ( 'daniel38_1650', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
+    ( 'mark2_1651', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
+    ( 'daniel79_1652', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
+    ( 'wright4_1653', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
+    ( 'brown84_1654', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
+    ( 'paul41_1655', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
+    ( 'mark5_1656', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
+    ( 'jenny0994_1657', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
+    ( 'morris53_1658', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
+    ( 'paul68_1659', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
+    ( 'brooks86_1660', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
+    ( 'james54_1661', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
+    ( 'rogers58_1662', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
+    ( 'maria31_1663', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
+    ( 'david5_1664', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
+    ( 'mark21_1665', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
+    ( 'jenny0932_1666', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
+    ( 'john92_1667', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
+    ( 'rogers98_1668', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
+    ( 'rogers95_1669', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
+    ( 'james50_1670', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
+    ( 'miller80_1671', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
+    ( 'mark29_1672', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
+    ( 'cooper77_1673', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
+    ( 'john24_1674', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
+    ( 'chrishaydon32_1675', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
+    ( 'bell41_1676', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
+    ( 'ross99_1677', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
+    ( 'smith9_1678', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
+    ( 'miller73_1679', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
+    ( 'michael44_1680', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
+    ( 'michael36_1681', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
+    ( 'smith93_1682', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
+    ( 'morgan38_1683', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
+    ( 'brown70_1684', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
+    ( 'mike14_1685', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
+    ( 'daniel72_1686', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
+    ( 'jenny0974_1687', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
+    ( 'maria51_1688', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
+    ( 'michael97_1689', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
+    ( 'chrishaydon33_1690', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
+    ( 'sanders42_1691', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
+    ( 'brooks87_1692', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
+    ( 'bell4_1693', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
+    ( 'jenny0945_1694', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
+    ( 'james65_1695', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
+    ( 'john98_1696', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
+    ( 'paul80_1697', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
+    ( 'john92_1698', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
+    ( 'wright69_1699', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
+    ( 'bell68_1700', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
+    ( 'brooks97_1701', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
+    ( 'mark14_1702', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
+    ( 'david60_1703', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
+    ( 'rivera68_1704', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
+    ( 'brooks32_1705', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
+    ( 'brown15_1706', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
+    ( 'bell88_1707', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
+    ( 'john26_1708', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
+    ( 'paul55_1709', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
+    ( 'david59_1710', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
+    ( 'morris73_1711', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
+    ( 'michael77_1712', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
+    ( 'mark5_1713', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
+    ( 'james65_1714', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
+    ( 'maria39_1715', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
+    ( 'michael37_1716', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
+    ( 'sanders1_1717', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
+    ( 'daniel30_1718', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
+    ( 'brown53_1719', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
+    ( 'john52_1720', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
+    ( 'wright73_1721', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
+    ( 'mark63_1722', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
+    ( 'james29_1723', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
+    ( 'mark23_1724', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
+    ( 'cooper22_1725', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
+    ( 'miller19_1726', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
+    ( 'jenny0913_1727', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
+    ( 'maria100_1728', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
+    ( 'chrishaydon51_1729', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
+    ( 'david9_1730', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
+    ( 'mike52_1731', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
+    ( 'wright5_1732', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
+    ( 'brown21_1733', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
+    ( 'daniel46_1734', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
+    ( 'brown86_1735', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
+    ( 'chrishaydon85_1736', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
+    ( 'john41_1737', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
+    ( 'mark72_1738', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
+    ( 'michael67_1739', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
+    ( 'jenny0943_1740', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
+    ( 'daniel61_1741', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
+    ( 'morgan34_1742', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
+    ( 'jenny097_1743', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
+    ( 'miller11_1744', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
+    ( 'daniel99_1745', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
+    ( 'miller20_1746', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
+    ( 'sanders29_1747', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
+    ( 'mark43_1748', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
+    ( 'james86_1749', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
+    ( 'chrishaydon74_1750', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
+    ( 'brooks37_1751', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
+    ( 'michael78_1752', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
+    ( 'paul9_1753', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
+    ( 'james57_1754', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
+    ( 'mark91_1755', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
+    ( 'wright30_1756', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
+    ( 'rogers97_1757', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
+    ( 'daniel8_1758', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
+    ( 'mark66_1759', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
+    ( 'daniel6_1760', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
+    ( 'bell72_1761', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
+    ( 'morgan77_1762', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
+    ( 'bell24_1763', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
+    ( 'michael31_1764', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
+    ( 'cooper98_1765', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
+    ( 'daniel41_1766', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
+    ( 'michael1_1767', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
+    ( 'chrishaydon20_1768', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
+    ( 'daniel66_1769', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
+    ( 'cooper52_1770', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
+    ( 'chrishaydon99_1771', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
+    ( 'smith66_1772', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
+    ( 'mike48_1773', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
+    ( 'rivera80_1774', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
+    ( 'morgan35_1775', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
+    ( 'brooks16_1776', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
+    ( 'mike78_1777', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
+    ( 'michael38_1778', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
+    ( 'mark7_1779', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
+    ( 'rogers80_1780', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
+    ( 'wright81_1781', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
+    ( 'michael21_1782', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
+    ( 'daniel56_1783', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
+    ( 'ross3_1784', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
+    ( 'chrishaydon72_1785', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
+    ( 'sanders66_1786', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
+    ( 'michael4_1787', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
+    ( 'brown66_1788', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
+    ( 'wright26_1789', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
+    ( 'james81_1790', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
+    ( 'morris21_1791', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
+    ( 'john30_1792', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
+    ( 'jenny0929_1793', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
+    ( 'wright91_1794', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
+    ( 'jenny0922_1795', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
+    ( 'rivera35_1796', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
+    ( 'rogers53_1797', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
+    ( 'ross50_1798', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
+    ( 'ross41_1799', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
+    ( 'rivera78_1800', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
+    ( 'miller43_1801', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
+    ( 'morgan59_1802', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
+    ( 'chrishaydon63_1803', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
+    ( 'wright93_1804', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
+    ( 'maria62_1805', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
+    ( 'mark85_1806', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
+    ( 'wright3_1807', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
+    ( 'brooks37_1808', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
+    ( 'paul66_1809', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
+    ( 'rivera36_1810', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
+    ( 'paul42_1811', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
+    ( 'morgan93_1812', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
+    ( 'rivera43_1813', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
+    ( 'daniel71_1814', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
+    ( 'mike81_1815', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
+    ( 'daniel83_1816', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
+    ( 'smith54_1817', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
+    ( 'sanders38_1818', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
+    ( 'morris63_1819', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
+    ( 'michael25_1820', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
+    ( 'smith89_1821', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
+    ( 'john97_1822', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
+    ( 'jenny0985_1823', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
+    ( 'wright84_1824', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
+    ( 'maria53_1825', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
+    ( 'brooks72_1826', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
+    ( 'brown61_1827', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
+    ( 'paul95_1828', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
+    ( 'brooks92_1829', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
+    ( 'james44_1830', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
+    ( 'chrishaydon100_1831', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
+    ( 'mark97_1832', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
+    ( 'brooks91_1833', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
+    ( 'jenny0929_1834', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
+    ( 'morgan37_1835', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
+    ( 'daniel43_1836', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
+    ( 'mike98_1837', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
+    ( 'john48_1838', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
+    ( 'brooks65_1839', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
+    ( 'cooper18_1840', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
+    ( 'chrishaydon54_1841', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
+    ( 'john67_1842', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
+    ( 'morris27_1843', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
+    ( 'ross45_1844', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
+    ( 'rogers85_1845', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
+    ( 'sanders96_1846', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
+    ( 'smith70_1847', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
+    ( 'wright31_1848', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
+    ( 'paul10_1849', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
+    ( 'sanders2_1850', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
+    ( 'rogers14_1851', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
+    ( 'morgan79_1852', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
+    ( 'morris87_1853', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
+    ( 'james6_1854', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
+    ( 'wright33_1855', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
+    ( 'maria85_1856', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
+    ( 'ross31_1857', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
+    ( 'chrishaydon75_1858', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
+    ( 'david54_1859', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
+    ( 'chrishaydon50_1860', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
+    ( 'miller24_1861', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
+    ( 'maria9_1862', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
+    ( 'smith91_1863', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
+    ( 'rivera11_1864', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
+    ( 'james72_1865', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
+    ( 'miller36_1866', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
+    ( 'jenny0991_1867', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
+    ( 'jenny0959_1868', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
+    ( 'mike41_1869', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
+    ( 'morgan20_1870', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
+    ( 'miller29_1871', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
+    ( 'ross56_1872', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
+    ( 'chrishaydon63_1873', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
+    ( 'morris54_1874', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
+    ( 'bell20_1875', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
+    ( 'paul41_1876', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
+    ( 'morgan42_1877', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
+    ( 'ross100_1878', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
+    ( 'wright1_1879', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
+    ( 'jenny0921_1880', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
+    ( 'paul37_1881', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
+    ( 'michael92_1882', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
+    ( 'chrishaydon14_1883', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
+    ( 'miller40_1884', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
+    ( 'wright43_1885', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
+    ( 'smith15_1886', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
+    ( 'miller49_1887', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
+    ( 'morris21_1888', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
+    ( 'ross27_1889', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
+    ( 'rivera16_1890', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
+    ( 'maria73_1891', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
+    ( 'chrishaydon60_1892', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
+    ( 'david40_1893', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
+    ( 'michael66_1894', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
+    ( 'paul27_1895', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
+    ( 'jenny0911_1896', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
+    ( 'miller79_1897', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
+    ( 'mark14_1898', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
+    ( 'ross87_1899', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
+    ( 'paul47_1900', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
+    ( 'sanders61_1901', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
+    ( 'rivera13_1902', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
+    ( 'chrishaydon22_1903', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
+    ( 'sanders42_1904', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
+    ( 'david78_1905', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
+    ( 'ross60_1906', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
+    ( 'morris24_1907', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
+    ( 'jenny0952_1908', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
+    ( 'rogers72_1909', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
+    ( 'daniel70_1910', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
+    ( 'cooper76_1911', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
+    ( 'jenny0942_1912', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
+    ( 'daniel39_1913', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
+    ( 'morris59_1914', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
+    ( 'mike70_1915', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
+    ( 'rogers20_1916', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
+    ( 'david33_1917', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
+    ( 'morris65_1918', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
+    ( 'michael23_1919', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
+    ( 'john96_1920', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
+    ( 'wright64_1921', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
+    ( 'david91_1922', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
+    ( 'daniel87_1923', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
+    ( 'ross60_1924', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
+    ( 'morris60_1925', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
+    ( 'rivera50_1926', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
+    ( 'maria77_1927', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
+    ( 'morgan64_1928', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
+    ( 'miller41_1929', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
+    ( 'david65_1930', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
+    ( 'morgan96_1931', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
+    ( 'ross73_1932', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
+    ( 'brooks95_1933', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
+    ( 'sanders92_1934', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
+    ( 'cooper53_1935', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
+    ( 'ross100_1936', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
+    ( 'michael91_1937', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
+    ( 'brooks51_1938', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
+    ( 'ross26_1939', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
+    ( 'morris58_1940', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
+    ( 'morris58_1941', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
+    ( 'ross12_1942', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
+    ( 'brooks60_1943', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
+    ( 'mark29_1944', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
+    ( 'john14_1945', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
+    ( 'rivera26_1946', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
+    ( 'jenny0949_1947', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
+    ( 'rogers96_1948', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
+    ( 'david55_1949', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
+    ( 'chrishaydon3_1950', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
+    ( 'ross81_1951', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
+    ( 'john70_1952', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
+    ( 'miller34_1953', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
+    ( 'bell26_1954', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
+    ( 'ross92_1955', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
+    ( 'rogers34_1956', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
+    ( 'james51_1957', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
+    ( 'ross54_1958', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
+    ( 'brown59_1959', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
+    ( 'michael83_1960', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
+    ( 'miller10_1961', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
+    ( 'jenny0939_1962', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
+    ( 'paul100_1963', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
+    ( 'smith82_1964', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
+    ( 'morris6_1965', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
+    ( 'paul76_1966', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
+    ( 'mark97_1967', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
+    ( 'john74_1968', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
+    ( 'miller78_1969', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
+    ( 'miller54_1970', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
+    ( 'chrishaydon9_1971', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
+    ( 'daniel4_1972', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
+    ( 'john24_1973', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
+    ( 'smith31_1974', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
+    ( 'smith77_1975', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
+    ( 'maria29_1976', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
+    ( 'michael76_1977', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
+    ( 'mark64_1978', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
+    ( 'morris5_1979', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
+    ( 'brown69_1980', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
+    ( 'miller53_1981', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
+    ( 'wright94_1982', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
+    ( 'rogers26_1983', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
+    ( 'jenny0977_1984', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
+    ( 'daniel23_1985', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
+    ( 'cooper66_1986', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
+    ( 'chrishaydon61_1987', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
+    ( 'maria95_1988', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
+    ( 'james17_1989', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
+    ( 'james18_1990', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
+    ( 'mike47_1991', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
+    ( 'john57_1992', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
+    ( 'mark54_1993', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
+    ( 'bell38_1994', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
+    ( 'morgan31_1995', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
+    ( 'miller16_1996', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
+    ( 'rivera14_1997', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
+    ( 'rogers22_1998', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
+    ( 'jenny0963_1999', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
+    ( 'mark42_2000', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
+    ( 'mark7_2001', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
+    ( 'john1_2002', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
+    ( 'chrishaydon3_2003', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
+    ( 'smith11_2004', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
+    ( 'john22_2005', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
+    ( 'rivera23_2006', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
+    ( 'james32_2007', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
+    ( 'daniel58_2008', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
+    ( 'james18_2009', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
+    ( 'brown93_2010', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
+    ( 'bell89_2011', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
+    ( 'miller82_2012', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
+    ( 'paul21_2013', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
+    ( 'chrishaydon88_2014', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
+    ( 'rivera44_2015', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
+    ( 'bell89_2016', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
+    ( 'bell66_2017', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
+    ( 'rogers31_2018', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
+    ( 'bell47_2019', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
+    ( 'sanders58_2020', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
+    ( 'mark26_2021', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
+    ( 'miller22_2022', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
+    ( 'daniel46_2023', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
+    ( 'morgan100_2024', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
+    ( 'ross75_2025', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
+    ( 'david68_2026', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
+    ( 'rivera35_2027', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
+    ( 'daniel63_2028', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
+    ( 'ross37_2029', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
+    ( 'michael23_2030', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
+    ( 'mike48_2031', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
+    ( 'david57_2032', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
+    ( 'morris67_2033', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
+    ( 'rogers49_2034', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
+    ( 'morris14_2035', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
+    ( 'brooks74_2036', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
+    ( 'mike71_2037', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
+    ( 'mark18_2038', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
+    ( 'mark9_2039', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
+    ( 'brooks21_2040', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
+    ( 'bell4_2041', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
+    ( 'brown17_2042', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
+    ( 'jenny0923_2043', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
+    ( 'smith66_2044', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
+    ( 'daniel53_2045', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
+    ( 'brown33_2046', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
+    ( 'mark55_2047', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
+    ( 'rivera83_2048', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
+    ( 'paul72_2049', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
+    ( 'david25_2050', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
+    ( 'paul47_2051', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
+    ( 'james61_2052', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
+    ( 'paul90_2053', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
+    ( 'david95_2054', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
+    ( 'paul52_2055', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
+    ( 'wright82_2056', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
+    ( 'james61_2057', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
+    ( 'michael89_2058', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
+    ( 'wright44_2059', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
+    ( 'chrishaydon60_2060', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
+    ( 'john30_2061', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
+    ( 'rivera2_2062', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
+    ( 'wright17_2063', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
+    ( 'rivera30_2064', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
+    ( 'daniel63_2065', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
+    ( 'brooks56_2066', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
+    ( 'wright29_2067', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
+    ( 'morris52_2068', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
+    ( 'morgan10_2069', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
+    ( 'sanders55_2070', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
+    ( 'mike9_2071', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
+    ( 'michael69_2072', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
+    ( 'david18_2073', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
+    ( 'daniel41_2074', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
+    ( 'david59_2075', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
+    ( 'daniel17_2076', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
+    ( 'john45_2077', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
+    ( 'morgan17_2078', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
+    ( 'john42_2079', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
+    ( 'rogers50_2080', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
+    ( 'smith35_2081', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
+    ( 'mark56_2082', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
+    ( 'chrishaydon12_2083', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
+    ( 'mark90_2084', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
+    ( 'maria85_2085', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
+    ( 'rogers42_2086', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
+    ( 'brooks95_2087', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
+    ( 'brooks38_2088', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
+    ( 'morris49_2089', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
+    ( 'david86_2090', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
+    ( 'wright15_2091', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
+    ( 'wright75_2092', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
+    ( 'sanders78_2093', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
+    ( 'jenny092_2094', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
+    ( 'cooper68_2095', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
+    ( 'paul87_2096', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
+    ( 'brooks59_2097', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
+    ( 'rivera36_2098', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
+    ( 'cooper25_2099', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
+    ( 'mike3_2100', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
+    ( 'rivera24_2101', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
+    ( 'wright89_2102', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
+    ( 'cooper12_2103', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
+    ( 'paul32_2104', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
+    ( 'wright11_2105', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
+    ( 'maria51_2106', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
+    ( 'morris85_2107', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
+    ( 'chrishaydon88_2108', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
+    ( 'brown49_2109', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
+    ( 'mike55_2110', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
+    ( 'paul6_2111', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
+    ( 'james19_2112', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
+    ( 'brown35_2113', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
+    ( 'chrishaydon15_2114', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
+    ( 'jenny0962_2115', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
+    ( 'brooks57_2116', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
+    ( 'chrishaydon7_2117', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
+    ( 'morgan15_2118', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
+    ( 'chrishaydon47_2119', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
+    ( 'brooks41_2120', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
+    ( 'mark61_2121', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
+    ( 'brooks82_2122', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
+    ( 'maria6_2123', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
+    ( 'brown2_2124', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
+    ( 'maria94_2125', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
+    ( 'smith95_2126', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
+    ( 'ross3_2127', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
+    ( 'smith24_2128', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
+    ( 'john19_2129', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
+    ( 'michael93_2130', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
+    ( 'bell35_2131', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
+    ( 'david80_2132', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
+    ( 'paul3_2133', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
+    ( 'michael27_2134', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
+    ( 'smith12_2135', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
+    ( 'maria61_2136', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
+    ( 'daniel3_2137', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
+    ( 'jenny0923_2138', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
+    ( 'morris5_2139', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
+    ( 'ross58_2140', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
+    ( 'michael48_2141', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
+    ( 'brown92_2142', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
+    ( 'morgan54_2143', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
+    ( 'sanders87_2144', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
+    ( 'paul54_2145', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
+    ( 'cooper59_2146', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
+    ( 'brooks16_2147', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
+    ( 'rivera44_2148', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
+    ( 'sanders60_2149', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
+    ( 'morgan82_2150', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
+    ( 'john69_2151', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
+    ( 'david36_2152', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
+    ( 'mike88_2153', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
+    ( 'david9_2154', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
+    ( 'david34_2155', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
+    ( 'sanders20_2156', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
+    ( 'mike22_2157', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
+    ( 'maria63_2158', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
+    ( 'miller94_2159', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
+    ( 'chrishaydon17_2160', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
+    ( 'brooks60_2161', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
+    ( 'bell34_2162', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
+    ( 'chrishaydon65_2163', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
+    ( 'paul70_2164', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
+    ( 'jenny0972_2165', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
+    ( 'john41_2166', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
+    ( 'smith78_2167', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
+    ( 'morgan55_2168', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
+    ( 'smith81_2169', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
+    ( 'jenny0988_2170', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
+    ( 'chrishaydon49_2171', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
+    ( 'morris31_2172', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
+    ( 'mike15_2173', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
+    ( 'smith61_2174', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
+    ( 'chrishaydon42_2175', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
+    ( 'john62_2176', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
+    ( 'maria40_2177', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
+    ( 'james50_2178', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
+    ( 'brown93_2179', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
+    ( 'maria85_2180', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
+    ( 'miller63_2181', 'morgan', 'morris', 'Female', 'ee33d4596af639660986c67f04e6ff92', 1),
+    ( 'smith50_2182', 'brown', 'brown', 'Female', 'bd1e4b23c510e1eae5fa69baa618f3e2', 1),
+    ( 'mike83_2183', 'smith', 'cooper', 'Female', '4a3309bacad462ce5af197a808839446', 1),
+    ( 'mark90_2184', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
+    ( 'morris71_2185', 'rogers', 'sanders', 'Male', 'a7fd9a391ed17cc9be339bcb87af3451', 1),
+    ( 'brown70_2186', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
+    ( 'jenny0930_2187', 'bell', 'rivera', 'Female', 'c25f2f612dde293ec2155a5dfe7901f2', 1),
+    ( 'smith60_2188', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
+    ( 'cooper56_2189', 'ross', 'mike', 'Female', '81b32a932116fed6d09aaa2c2bc522df', 1),
+    ( 'chrishaydon67_2190', 'wright', 'mark', 'Female', 'a4808f35e9eca8fd90326ad5632ff220', 1),
+    ( 'cooper90_2191', 'ross', 'rivera', 'Male', '077380568f1971f1003978046142baeb', 1),
+    ( 'morris82_2192', 'ross', 'miller', 'Female', '8d863a559ac2d9fba0dfa98649e55637', 1),
+    ( 'morgan3_2193', 'sanders', 'mark', 'Male', '4d5a20ef5496dae22ea2bbfa40defc12', 1),
+    ( 'daniel25_2194', 'michael', 'ross', 'Female', '6794c36a5638c0c0df58155493512e60', 1),
+    ( 'rivera64_2195', 'morgan', 'mike', 'Female', '041dcc147d542af1dc6bf50192c1b10f', 1),
+    ( 'bell41_2196', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
+    ( 'rivera34_2197', 'bell', 'paul', 'Male', '7d4a65a26f424093d93857b14104585e', 1),
+    ( 'chrishaydon22_2198', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
+    ( 'miller53_2199', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
+    ( 'miller58_2200', 'sanders', 'paul', 'Male', '3fa66dc95e8f6e524cd5afbf4abfebdf', 1),
+    ( 'wright41_2201', 'smith', 'sanders', 'Female', '7ba55de0a483cd485e1629176c9fa5ac', 1),
+    ( 'john69_2202', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
+    ( 'smith6_2203', 'smith', 'paul', 'Female', '69b7b0f8981d3f462bddcd10e83d73b8', 1),
+    ( 'rogers88_2204', 'miller', 'rivera', 'Male', '18b2b0e6b66ce69949cfd500bdbd547b', 1),
+    ( 'john98_2205', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
+    ( 'paul69_2206', 'john', 'morgan', 'Male', '98bdeac0dbd9377cd342ab3e2ed67002', 1),
+    ( 'morris55_2207', 'wright', 'brown', 'Male', 'e808e39b0369890ca613a24d1bdbe80e', 1),
+    ( 'paul3_2208', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
+    ( 'rogers28_2209', 'brown', 'brown', 'Male', 'cb1b08d86f6053ce02919717671bd81f', 1),
+    ( 'brown16_2210', 'james', 'chrishaydon', 'Female', 'ef70b7e590eb6ef3d09bdb05084610e4', 1),
+    ( 'david68_2211', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
+    ( 'michael8_2212', 'paul', 'morgan', 'Female', '6691fbe0b3d4d260b0ada3cd9c0b78f5', 1),
+    ( 'sanders67_2213', 'brooks', 'paul', 'Male', 'ddf8e1b6ed97cdf30cb35d0f9e47517b', 1),
+    ( 'rivera75_2214', 'brooks', 'brooks', 'Male', 'fb77723ac6a75b2dfa7636213fc12803', 1),
+    ( 'miller81_2215', 'ross', 'david', 'Female', 'e5e1fa5d4c971a980e6d087016507eb7', 1),
+    ( 'jenny0952_2216', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
+    ( 'rivera53_2217', 'bell', 'smith', 'Female', '1e3e64c56121038797a7e50b655464c6', 1),
+    ( 'morgan33_2218', 'wright', 'brown', 'Male', '8e5a808681a0070beaf455c2cabb782b', 1),
+    ( 'ross27_2219', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
+    ( 'miller94_2220', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
+    ( 'michael14_2221', 'paul', 'sanders', 'Female', '9462cebb50643d2a7731b89c5b1b8fe3', 1),
+    ( 'rogers60_2222', 'mark', 'smith', 'Male', 'bef7a008c13dcc9dd7947e5f248a1cfd', 1),
+    ( 'maria50_2223', 'rivera', 'miller', 'Male', '64b7a989ccd1e83d881215cddbeafb72', 1),
+    ( 'morgan96_2224', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
+    ( 'ross49_2225', 'sanders', 'jenny09', 'Female', '53083ad87812f62ccd391f01069e77b5', 1),
+    ( 'wright37_2226', 'miller', 'chrishaydon', 'Male', '0f8b225730e5be91f0f4cbb29156cf44', 1),
+    ( 'brown38_2227', 'ross', 'morgan', 'Male', '07a841d9d548265d67ac8897f60f1b06', 1),
+    ( 'michael50_2228', 'mike', 'rogers', 'Female', 'a366cae9b72e785998b0ce78a2284bdb', 1),
+    ( 'wright93_2229', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
+    ( 'miller91_2230', 'mike', 'chrishaydon', 'Male', 'edb8e845ed0dc8c888f7225fa43eb2a6', 1),
+    ( 'daniel21_2231', 'cooper', 'sanders', 'Female', 'df3e462d93781ea422c125a4c2b5566a', 1),
+    ( 'bell44_2232', 'ross', 'smith', 'Male', 'a49601f3e6a85c0387539bba4bcdbe6c', 1),
+    ( 'brooks58_2233', 'ross', 'john', 'Male', '6e5b2a0375b4d4d500ee07e62cad6d6c', 1),
+    ( 'chrishaydon86_2234', 'sanders', 'david', 'Female', 'c3bf2214f10104ec078bed756539f9af', 1),
+    ( 'jenny0910_2235', 'paul', 'smith', 'Male', '58eea070ea61891bfea5954c38cb9336', 1),
+    ( 'rivera53_2236', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
+    ( 'brown75_2237', 'sanders', 'rivera', 'Female', '7c5cef8a2e387abe6908ee2f3fbc534a', 1),
+    ( 'maria40_2238', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
+    ( 'john12_2239', 'sanders', 'sanders', 'Male', 'cba0f422fbd342f81feb1dd78a43cad6', 1),
+    ( 'sanders88_2240', 'paul', 'miller', 'Male', 'b6153be014b1af1811c85aa770c4cc46', 1),
+    ( 'morris98_2241', 'morgan', 'brooks', 'Female', 'd793df005919fa5c4f45a61a7f9f8e87', 1),
+    ( 'miller17_2242', 'daniel', 'brown', 'Female', 'a11bf4bd7d2f8c8162d97c7447ab07f8', 1),
+    ( 'cooper84_2243', 'miller', 'miller', 'Male', '941752ab63605763d87142453aa35988', 1),
+    ( 'ross49_2244', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
+    ( 'morgan54_2245', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
+    ( 'chrishaydon29_2246', 'wright', 'cooper', 'Female', 'b6ed3804fd6ad8ccd1c85c0a7deda4e3', 1),
+    ( 'paul3_2247', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
+    ( 'morris98_2248', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
+    ( 'cooper55_2249', 'mark', 'ross', 'Female', '6c794b04248c5d6120dd84b8e94967a7', 1),
+    ( 'rivera85_2250', 'brown', 'brooks', 'Female', '0ca4d10e4c787cc061e18feb815f747b', 1),
+    ( 'rogers43_2251', 'jenny09', 'mike', 'Female', 'aa98ad5a318401147dc9580a9e134a7e', 1),
+    ( 'ross42_2252', 'mark', 'michael', 'Female', '1507a0565d69991ff963cd3489b1c491', 1),
+    ( 'james29_2253', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
+    ( 'chrishaydon20_2254', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
+    ( 'wright68_2255', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
+    ( 'cooper47_2256', 'ross', 'paul', 'Male', '524f2968fabb85f0fdb2fa63110f6690', 1),
+    ( 'morgan73_2257', 'chrishaydon', 'cooper', 'Male', '33b7c4b03c90ac30be52d86c3229577b', 1),
+    ( 'maria82_2258', 'daniel', 'daniel', 'Male', '9a83d41058fafd2a50b9fcce02811361', 1),
+    ( 'john8_2259', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
+    ( 'miller63_2260', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
+    ( 'morgan26_2261', 'michael', 'rogers', 'Male', 'e4a653c83b7fa702099b4c780e5fa68d', 1),
+    ( 'rivera69_2262', 'brown', 'david', 'Female', 'b58472e08b58b46875487093c2867ec6', 1),
+    ( 'daniel31_2263', 'john', 'rogers', 'Male', '9d84f6219123fb1920cf2e7494a44d77', 1),
+    ( 'sanders100_2264', 'michael', 'john', 'Male', '349fda3c9d90de7e62c9ada6c0550a8b', 1),
+    ( 'mark84_2265', 'morris', 'chrishaydon', 'Female', '8f9307568ba555323b0599ce0c715b1a', 1),
+    ( 'smith56_2266', 'david', 'paul', 'Male', 'baaf6963e059f92921d196b5fef6669d', 1),
+    ( 'morris93_2267', 'chrishaydon', 'james', 'Female', 'dba13ee70b381ea5b9ceac0ece3d850f', 1),
+    ( 'michael45_2268', 'michael', 'paul', 'Female', 'd9f0344646b6ba7f224d9d3a0986cc7e', 1),
+    ( 'mike88_2269', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
+    ( 'morgan96_2270', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
+    ( 'james5_2271', 'brooks', 'mike', 'Female', 'ac332ec30f36d30d5bf9221fe1b32bf0', 1),
+    ( 'morris83_2272', 'sanders', 'maria', 'Male', 'fcb81feea910ea24117d4f26171991f8', 1),
+    ( 'rivera19_2273', 'rivera', 'bell', 'Female', '0ba1e8782ffcf3e71c079e1e54c0a41c', 1),
+    ( 'daniel57_2274', 'paul', 'bell', 'Female', '62d04b1633be93a4b4f93256a56e28d0', 1),
+    ( 'maria10_2275', 'wright', 'sanders', 'Female', '9c8c23c03a74a7a320d742aa95cf08e3', 1),
+    ( 'david71_2276', 'jenny09', 'david', 'Male', '16a061f8fdf5c93e9e77631dfb7d471a', 1),
+    ( 'sanders88_2277', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
+    ( 'rogers37_2278', 'chrishaydon', 'brown', 'Male', '822141a673303a54b9387d8fc6a0c425', 1),
+    ( 'chrishaydon10_2279', 'chrishaydon', 'john', 'Male', '1b7d631cf551c86b671fd6bc2ec9d0dc', 1),
+    ( 'sanders27_2280', 'bell', 'morris', 'Male', '576352444376fe3069d256ded463666f', 1),
+    ( 'brown17_2281', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
+    ( 'morris86_2282', 'rogers', 'ross', 'Male', '896b690b9566f53a875e03a3a324c091', 1),
+    ( 'mark79_2283', 'bell', 'sanders', 'Male', 'cee8dbd9130bccbe4d945c9cfc41f330', 1),
+    ( 'david58_2284', 'david', 'morgan', 'Female', 'ca391efb9f114371dced52c8eb18a412', 1),
+    ( 'daniel95_2285', 'wright', 'ross', 'Male', '4458808784017babe1bb3ed79c61f0f7', 1),
+    ( 'brooks49_2286', 'sanders', 'morgan', 'Female', 'dbd61cc2c16fa763662c8a29d63215e0', 1),
+    ( 'jenny0926_2287', 'wright', 'david', 'Female', 'd2d4451b918dbb9730bb7e010a50ab60', 1),
+    ( 'bell37_2288', 'morgan', 'john', 'Female', '9019a44759e7bdece498cc5dce570446', 1),
+    ( 'john46_2289', 'miller', 'john', 'Female', 'c618d2bfb0bf88f1de98313820f226a8', 1),
+    ( 'chrishaydon43_2290', 'miller', 'chrishaydon', 'Female', 'e63ca65668b82c9fd07eef6b719ad4aa', 1),
+    ( 'rogers19_2291', 'mike', 'john', 'Male', '59843bfb2ca080a23bcbbc5278fa6982', 1),
+    ( 'brooks29_2292', 'rivera', 'rivera', 'Female', 'f151014abd62636e598dbad4a5b43aa3', 1),
+    ( 'chrishaydon74_2293', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
+    ( 'brooks43_2294', 'morgan', 'cooper', 'Male', '669e54b4368a4a99595fe4a56b9bf6ff', 1),
+    ( 'ross44_2295', 'morgan', 'smith', 'Female', 'a84cf2d984ed2eb7948b9fda85275a7f', 1),
+    ( 'bell63_2296', 'chrishaydon', 'james', 'Male', '863d1292a4a36cdc9134a7f84e8070c9', 1),
+    ( 'rivera41_2297', 'maria', 'mark', 'Male', 'a9dd9815cf33560650fc661a97f41547', 1),
+    ( 'wright17_2298', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
+    ( 'michael15_2299', 'david', 'mark', 'Female', 'a167a5d9cf8157d98d45c1d0e32b72f4', 1),
+    ( 'ross53_2300', 'morris', 'daniel', 'Female', 'b4a6baa51bd47cf407213f6ba9254f71', 1),
+    ( 'cooper65_2301', 'brooks', 'mark', 'Female', '26f039d32dad1e54e8f74b015ea9ec68', 1),
+    ( 'rivera2_2302', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
+    ( 'james32_2303', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
+    ( 'mark97_2304', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
+    ( 'smith93_2305', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
+    ( 'chrishaydon84_2306', 'james', 'brown', 'Male', '5957038b6a3f0a0f305123af998bc437', 1),
+    ( 'smith30_2307', 'maria', 'wright', 'Male', '62d191a19bda44ba011ebd37976b5a23', 1),
+    ( 'maria51_2308', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
+    ( 'rivera52_2309', 'cooper', 'michael', 'Male', 'c15a7058e0c14b26f6863fbd7549a1e7', 1),
+    ( 'chrishaydon48_2310', 'david', 'cooper', 'Female', 'e51d84e0afa82e704755b26fe59f566e', 1),
+    ( 'morgan90_2311', 'mike', 'maria', 'Female', 'e78f1e49da6ebf2fe756ec38b646ba36', 1),
+    ( 'sanders53_2312', 'bell', 'mark', 'Female', '15d2a8035ab8f89a8d2388049cdb1ee9', 1),
+    ( 'john47_2313', 'sanders', 'sanders', 'Female', 'd3945958288a3f00f1d5fe7230670061', 1),
+    ( 'daniel100_2314', 'paul', 'cooper', 'Male', '1ca7a74ac2257ad3c0f5885cb90099f2', 1),
+    ( 'wright13_2315', 'brown', 'mark', 'Male', '35e1bd4961d74f12ed2e2399f219aaa9', 1),
+    ( 'morgan86_2316', 'brown', 'daniel', 'Female', 'ba7d8e424270cfe9f48eb09c99ff68a1', 1),
+    ( 'wright17_2317', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
+    ( 'morris93_2318', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
+    ( 'james40_2319', 'rogers', 'rogers', 'Male', 'b44bc9d82406f9a445889a66b255f271', 1),
+    ( 'ross47_2320', 'sanders', 'chrishaydon', 'Male', '4592e91917d68abf8835aeae27f38cfc', 1),
+    ( 'wright8_2321', 'bell', 'cooper', 'Male', 'e30161a98a0e9e3933dbb856e6af4719', 1),
+    ( 'ross83_2322', 'morris', 'sanders', 'Female', '2ec9470fb5a92621648ad5c708b050f8', 1),
+    ( 'ross9_2323', 'bell', 'sanders', 'Female', '85b8a1781fe0eda5fcdb86d950c2e239', 1),
+    ( 'miller47_2324', 'morris', 'david', 'Female', '2fb2bd61613de4adc3ab2fc7fc05fe88', 1),
+    ( 'smith28_2325', 'brown', 'john', 'Female', '2e3a6218c8c4652563470fd23b5c9004', 1),
+    ( 'morris2_2326', 'bell', 'jenny09', 'Male', '9248ee5ee8d1ff997d0009348e2ea662', 1),
+    ( 'morris4_2327', 'rogers', 'brooks', 'Male', 'd9290c1d84c18de491112fab16fc0172', 1),
+    ( 'jenny0926_2328', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
+    ( 'morgan21_2329', 'john', 'cooper', 'Male', 'c1c1328643f85dca460e8ead1600c650', 1),
+    ( 'david3_2330', 'michael', 'miller', 'Male', '6c5c00ec2728ed2459e821dce4a85349', 1),
+    ( 'ross58_2331', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
+    ( 'jenny0949_2332', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
+    ( 'john96_2333', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
+    ( 'paul5_2334', 'rivera', 'ross', 'Male', '13a3756c2cb7b486e32054a08271d3f1', 1),
+    ( 'sanders80_2335', 'michael', 'james', 'Female', '73f63edc5f6f3b571886fbb1316c43bc', 1),
+    ( 'smith65_2336', 'brooks', 'bell', 'Male', '7ba86a4101f3074f458df9a6e43e3f5a', 1),
+    ( 'rogers26_2337', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
+    ( 'maria43_2338', 'miller', 'bell', 'Male', '0c879f0908f637ebe8cc0db994ae0832', 1),
+    ( 'david99_2339', 'maria', 'jenny09', 'Female', 'b38a8c25edada7483420c20a76b9d4b0', 1),
+    ( 'wright20_2340', 'smith', 'rogers', 'Female', 'e11d269be9b74106223b357b06f2da33', 1),
+    ( 'brooks87_2341', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
+    ( 'mike43_2342', 'morgan', 'ross', 'Male', '024142778439c88b675219780cf1c578', 1),
+    ( 'ross30_2343', 'mark', 'david', 'Male', 'c5fb92d8af4e45581d8bb7c71a5c0a1c', 1),
+    ( 'jenny0981_2344', 'morgan', 'brooks', 'Female', '34ae1e72198b2ccb37610a3269368fa7', 1),
+    ( 'cooper11_2345', 'james', 'james', 'Female', '243cffae81569cca39840aec1e9e2852', 1),
+    ( 'rogers97_2346', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
+    ( 'wright84_2347', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
+    ( 'jenny0932_2348', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
+    ( 'sanders40_2349', 'paul', 'sanders', 'Female', 'be92492fa64d6126726a71584a75a878', 1),
+    ( 'john80_2350', 'daniel', 'ross', 'Female', '8a608ab5ea3865ae688ac2f8b1cc3b61', 1),
+    ( 'brooks58_2351', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
+    ( 'miller37_2352', 'brooks', 'bell', 'Female', '62c1ace1a0aa8e798169f547586457c8', 1),
+    ( 'james93_2353', 'chrishaydon', 'james', 'Male', '7ef00ef6e7f47472db040222dae2dc8e', 1),
+    ( 'bell16_2354', 'bell', 'james', 'Male', '0d5e1a6eab996a7d1d32a0e2ed22ff2a', 1),
+    ( 'smith96_2355', 'mike', 'bell', 'Female', '68f2fb17fd3f13d8a0a394de396ce447', 1),
+    ( 'ross94_2356', 'david', 'bell', 'Male', '84c62bc5b6f1dab11a1ca3d8d7a5e29e', 1),
+    ( 'ross95_2357', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
+    ( 'chrishaydon82_2358', 'jenny09', 'maria', 'Female', '8093f823794b8fa03c379c035300fd0b', 1),
+    ( 'michael3_2359', 'mike', 'jenny09', 'Male', 'fc13f6e30b38a8529a57add8a59b5ec0', 1),
 
-    ( 'bell35', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
-    ( 'david80', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
-    ( 'paul3', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
-    ( 'michael27', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
-    ( 'smith12', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
-    ( 'maria61', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
-    ( 'daniel3', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
-    ( 'jenny0923', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
-    ( 'morris5', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
-    ( 'ross58', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
-    ( 'michael48', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
-    ( 'brown92', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
-    ( 'morgan54', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
-    ( 'sanders87', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
-    ( 'paul54', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
-    ( 'cooper59', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
-    ( 'brooks16', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
-    ( 'rivera44', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
-    ( 'sanders60', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
-    ( 'morgan82', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
-    ( 'john69', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
-    ( 'david36', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
-    ( 'mike88', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
-    ( 'david9', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
-    ( 'david34', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
-    ( 'sanders20', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
-    ( 'mike22', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
-    ( 'maria63', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
-    ( 'miller94', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
-    ( 'chrishaydon17', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
-    ( 'brooks60', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
-    ( 'bell34', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
-    ( 'chrishaydon65', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
-    ( 'paul70', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
-    ( 'jenny0972', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
-    ( 'john41', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
-    ( 'smith78', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
-    ( 'morgan55', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
-    ( 'smith81', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
-    ( 'jenny0988', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
-    ( 'chrishaydon49', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
-    ( 'morris31', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
-    ( 'mike15', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
-    ( 'smith61', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
-    ( 'chrishaydon42', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
-    ( 'john62', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
-    ( 'maria40', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
-    ( 'james50', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
-    ( 'ross63', 'morgan', 'mike', 'Female', '70d70c6d340b463fc4b3fde8d133de6d', 1);
+    ( 'jenny0918_2360', 'rogers', 'brown', 'Male', '8b9e34ac36a2fd947614e2616f3d6826', 1),
+    ( 'rogers43_2361', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
+    ( 'morris91_2362', 'paul', 'rivera', 'Male', '613be5cffa8a578c1816a1baf6bb8501', 1),
+    ( 'john4_2363', 'james', 'rivera', 'Female', '76ebe07cd3c27e769f71251f294d4c94', 1),
+    ( 'cooper5_2364', 'wright', 'morris', 'Male', '8105c71bb32ba7bf731c4162ff9c1f21', 1),
+    ( 'chrishaydon20_2365', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
+    ( 'chrishaydon44_2366', 'mark', 'smith', 'Female', 'a54db1865fb0fa3a66861d93e037741d', 1),
+    ( 'daniel13_2367', 'brooks', 'brown', 'Female', '91270e97491a918496e6569c9fcff808', 1),
+    ( 'chrishaydon37_2368', 'brown', 'mark', 'Male', 'a3efe2fadf9e33423b80532af0f95c33', 1),
+    ( 'david31_2369', 'wright', 'paul', 'Female', 'b8e0691f01b08a810fade049028d9c3e', 1),
+    ( 'mark21_2370', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
+    ( 'wright81_2371', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
+    ( 'michael13_2372', 'morris', 'cooper', 'Female', '9d450e0529ca7328d07679163206f732', 1),
+    ( 'rogers63_2373', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
+    ( 'mike28_2374', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
+    ( 'rivera92_2375', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
+    ( 'ross95_2376', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
+    ( 'paul85_2377', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
+    ( 'smith34_2378', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
+    ( 'james84_2379', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
+    ( 'daniel53_2380', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
+    ( 'brooks80_2381', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
+    ( 'morgan65_2382', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
+    ( 'sanders84_2383', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
+    ( 'maria40_2384', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
+    ( 'brown71_2385', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
+    ( 'james63_2386', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
+    ( 'jenny0993_2387', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
+    ( 'john96_2388', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
+    ( 'miller64_2389', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
+    ( 'mark46_2390', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
+    ( 'jenny0988_2391', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
+    ( 'mark80_2392', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
+    ( 'morris72_2393', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
+    ( 'wright39_2394', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
+    ( 'paul68_2395', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
+    ( 'smith60_2396', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
+    ( 'bell43_2397', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
+    ( 'rogers79_2398', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
+    ( 'daniel56_2399', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
+    ( 'brooks85_2400', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
+    ( 'mike30_2401', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
+    ( 'paul92_2402', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
+    ( 'bell96_2403', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
+    ( 'john8_2404', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
+    ( 'chrishaydon12_2405', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
+    ( 'morgan13_2406', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
+    ( 'james83_2407', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
+    ( 'chrishaydon8_2408', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
+    ( 'ross85_2409', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
+    ( 'ross46_2410', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
+    ( 'smith4_2411', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
+    ( 'paul4_2412', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
+    ( 'daniel26_2413', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
+    ( 'chrishaydon2_2414', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
+    ( 'david82_2415', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
+    ( 'john97_2416', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
+    ( 'david57_2417', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
+    ( 'rivera100_2418', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
+    ( 'bell13_2419', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
+    ( 'brooks65_2420', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
+    ( 'daniel40_2421', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
+    ( 'cooper100_2422', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
+    ( 'morris14_2423', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
+    ( 'smith82_2424', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
+    ( 'cooper35_2425', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
+    ( 'morgan94_2426', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
+    ( 'michael92_2427', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
+    ( 'sanders48_2428', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
+    ( 'brown76_2429', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
+    ( 'james16_2430', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
+    ( 'michael26_2431', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
+    ( 'wright57_2432', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
+    ( 'wright68_2433', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
+    ( 'brooks1_2434', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
+    ( 'bell2_2435', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
+    ( 'miller100_2436', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
+    ( 'rogers53_2437', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
+    ( 'mike1_2438', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
+    ( 'cooper57_2439', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
+    ( 'daniel38_2440', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
+    ( 'mark2_2441', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
+    ( 'daniel79_2442', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
+    ( 'wright4_2443', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
+    ( 'brown84_2444', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
+    ( 'paul41_2445', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
+    ( 'mark5_2446', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
+    ( 'jenny0994_2447', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
+    ( 'morris53_2448', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
+    ( 'paul68_2449', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
+    ( 'brooks86_2450', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
+    ( 'james54_2451', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
+    ( 'rogers58_2452', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
+    ( 'maria31_2453', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
+    ( 'david5_2454', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
+    ( 'mark21_2455', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
+    ( 'jenny0932_2456', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
+    ( 'john92_2457', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
+    ( 'rogers98_2458', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
+    ( 'rogers95_2459', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
+    ( 'james50_2460', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
+    ( 'miller80_2461', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
+    ( 'mark29_2462', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
+    ( 'cooper77_2463', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
+    ( 'john24_2464', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
+    ( 'chrishaydon32_2465', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
+    ( 'bell41_2466', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
+    ( 'ross99_2467', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
+    ( 'smith9_2468', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
+    ( 'miller73_2469', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
+    ( 'michael44_2470', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
+    ( 'michael36_2471', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
+    ( 'smith93_2472', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
+    ( 'morgan38_2473', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
+    ( 'brown70_2474', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
+    ( 'mike14_2475', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
+    ( 'daniel72_2476', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
+    ( 'jenny0974_2477', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
+    ( 'maria51_2478', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
+    ( 'michael97_2479', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
+    ( 'chrishaydon33_2480', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
+    ( 'sanders42_2481', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
+    ( 'brooks87_2482', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
+    ( 'bell4_2483', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
+    ( 'jenny0945_2484', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
+    ( 'james65_2485', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
+    ( 'john98_2486', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
+    ( 'paul80_2487', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
+    ( 'john92_2488', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
+    ( 'wright69_2489', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
+    ( 'bell68_2490', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
+    ( 'brooks97_2491', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
+    ( 'mark14_2492', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
+    ( 'david60_2493', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
+    ( 'rivera68_2494', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
+    ( 'brooks32_2495', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
+    ( 'brown15_2496', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
+    ( 'bell88_2497', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
+    ( 'john26_2498', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
+    ( 'paul55_2499', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
+    ( 'david59_2500', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
+    ( 'morris73_2501', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
+    ( 'michael77_2502', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
+    ( 'mark5_2503', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
+    ( 'james65_2504', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
+    ( 'maria39_2505', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
+    ( 'michael37_2506', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
+    ( 'sanders1_2507', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
+    ( 'daniel30_2508', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
+    ( 'brown53_2509', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
+    ( 'john52_2510', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
+    ( 'wright73_2511', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
+    ( 'mark63_2512', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
+    ( 'james29_2513', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
+    ( 'mark23_2514', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
+    ( 'cooper22_2515', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
+    ( 'miller19_2516', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
+    ( 'jenny0913_2517', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
+    ( 'maria100_2518', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
+    ( 'chrishaydon51_2519', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
+    ( 'david9_2520', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
+    ( 'mike52_2521', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
+    ( 'wright5_2522', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
+    ( 'brown21_2523', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
+    ( 'daniel46_2524', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
+    ( 'brown86_2525', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
+    ( 'chrishaydon85_2526', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
+    ( 'john41_2527', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
+    ( 'mark72_2528', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
+    ( 'michael67_2529', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
+    ( 'jenny0943_2530', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
+    ( 'daniel61_2531', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
+    ( 'morgan34_2532', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
+    ( 'jenny097_2533', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
+    ( 'miller11_2534', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
+    ( 'daniel99_2535', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
+    ( 'miller20_2536', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
+    ( 'sanders29_2537', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
+    ( 'mark43_2538', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
+    ( 'james86_2539', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
+    ( 'chrishaydon74_2540', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
+    ( 'brooks37_2541', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
+    ( 'michael78_2542', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
+    ( 'paul9_2543', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
+    ( 'james57_2544', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
+    ( 'mark91_2545', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
+    ( 'wright30_2546', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
+    ( 'rogers97_2547', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
+    ( 'daniel8_2548', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
+    ( 'mark66_2549', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
+    ( 'daniel6_2550', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
+    ( 'bell72_2551', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
+    ( 'morgan77_2552', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
+    ( 'bell24_2553', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
+    ( 'michael31_2554', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
+    ( 'cooper98_2555', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
+    ( 'daniel41_2556', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
+    ( 'michael1_2557', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
+    ( 'chrishaydon20_2558', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
+    ( 'daniel66_2559', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
+    ( 'cooper52_2560', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
+    ( 'chrishaydon99_2561', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
+    ( 'smith66_2562', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
+    ( 'mike48_2563', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
+    ( 'rivera80_2564', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
+    ( 'morgan35_2565', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
+    ( 'brooks16_2566', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
+    ( 'mike78_2567', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
+    ( 'michael38_2568', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
+    ( 'mark7_2569', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
+    ( 'rogers80_2570', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
+    ( 'wright81_2571', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
+    ( 'michael21_2572', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
+    ( 'daniel56_2573', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
+    ( 'ross3_2574', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
+    ( 'chrishaydon72_2575', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
+    ( 'sanders66_2576', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
+    ( 'michael4_2577', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
+    ( 'brown66_2578', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
+    ( 'wright26_2579', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
+    ( 'james81_2580', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
+    ( 'morris21_2581', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
+    ( 'john30_2582', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
+    ( 'jenny0929_2583', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
+    ( 'wright91_2584', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
+    ( 'jenny0922_2585', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
+    ( 'rivera35_2586', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
+    ( 'rogers53_2587', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
+    ( 'ross50_2588', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
+    ( 'ross41_2589', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
+    ( 'rivera78_2590', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
+    ( 'miller43_2591', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
+    ( 'morgan59_2592', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
+    ( 'chrishaydon63_2593', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
+    ( 'wright93_2594', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
+    ( 'maria62_2595', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
+    ( 'mark85_2596', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
+    ( 'wright3_2597', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
+    ( 'brooks37_2598', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
+    ( 'paul66_2599', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
+    ( 'rivera36_2600', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
+    ( 'paul42_2601', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
+    ( 'morgan93_2602', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
+    ( 'rivera43_2603', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
+    ( 'daniel71_2604', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
+    ( 'mike81_2605', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
+    ( 'daniel83_2606', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
+    ( 'smith54_2607', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
+    ( 'sanders38_2608', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
+    ( 'morris63_2609', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
+    ( 'michael25_2610', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
+    ( 'smith89_2611', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
+    ( 'john97_2612', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
+    ( 'jenny0985_2613', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
+    ( 'wright84_2614', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
+    ( 'maria53_2615', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
+    ( 'brooks72_2616', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
+    ( 'brown61_2617', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
+    ( 'paul95_2618', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
+    ( 'brooks92_2619', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
+    ( 'james44_2620', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
+    ( 'chrishaydon100_2621', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
+    ( 'mark97_2622', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
+    ( 'brooks91_2623', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
+    ( 'jenny0929_2624', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
+    ( 'morgan37_2625', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
+    ( 'daniel43_2626', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
+    ( 'mike98_2627', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
+    ( 'john48_2628', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
+    ( 'brooks65_2629', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
+    ( 'cooper18_2630', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
+    ( 'chrishaydon54_2631', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
+    ( 'john67_2632', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
+    ( 'morris27_2633', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
+    ( 'ross45_2634', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
+    ( 'rogers85_2635', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
+    ( 'sanders96_2636', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
+    ( 'smith70_2637', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
+    ( 'wright31_2638', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
+    ( 'paul10_2639', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
+    ( 'sanders2_2640', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
+    ( 'rogers14_2641', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
+    ( 'morgan79_2642', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
+    ( 'morris87_2643', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
+    ( 'james6_2644', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
+    ( 'wright33_2645', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
+    ( 'maria85_2646', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
+    ( 'ross31_2647', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
+    ( 'chrishaydon75_2648', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
+    ( 'david54_2649', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
+    ( 'chrishaydon50_2650', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
+    ( 'miller24_2651', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
+    ( 'maria9_2652', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
+    ( 'smith91_2653', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
+    ( 'rivera11_2654', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
+    ( 'james72_2655', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
+    ( 'miller36_2656', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
+    ( 'jenny0991_2657', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
+    ( 'jenny0959_2658', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
+    ( 'mike41_2659', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
+    ( 'morgan20_2660', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
+    ( 'miller29_2661', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
+    ( 'ross56_2662', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
+    ( 'chrishaydon63_2663', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
+    ( 'morris54_2664', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
+    ( 'bell20_2665', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
+    ( 'paul41_2666', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
+    ( 'morgan42_2667', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
+    ( 'ross100_2668', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
+    ( 'wright1_2669', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
+    ( 'jenny0921_2670', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
+    ( 'paul37_2671', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
+    ( 'michael92_2672', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
+    ( 'chrishaydon14_2673', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
+    ( 'miller40_2674', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
+    ( 'wright43_2675', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
+    ( 'smith15_2676', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
+    ( 'miller49_2677', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
+    ( 'morris21_2678', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
+    ( 'ross27_2679', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
+    ( 'rivera16_2680', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
+    ( 'maria73_2681', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
+    ( 'chrishaydon60_2682', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
+    ( 'david40_2683', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
+    ( 'michael66_2684', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
+    ( 'paul27_2685', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
+    ( 'jenny0911_2686', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
+    ( 'miller79_2687', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
+    ( 'mark14_2688', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
+    ( 'ross87_2689', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
+    ( 'paul47_2690', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
+    ( 'sanders61_2691', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
+    ( 'rivera13_2692', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
+    ( 'chrishaydon22_2693', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
+    ( 'sanders42_2694', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
+    ( 'david78_2695', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
+    ( 'ross60_2696', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
+    ( 'morris24_2697', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
+    ( 'jenny0952_2698', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
+    ( 'rogers72_2699', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
+    ( 'daniel70_2700', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
+    ( 'cooper76_2701', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
+    ( 'jenny0942_2702', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
+    ( 'daniel39_2703', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
+    ( 'morris59_2704', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
+    ( 'mike70_2705', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
+    ( 'rogers20_2706', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
+    ( 'david33_2707', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
+    ( 'morris65_2708', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
+    ( 'michael23_2709', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
+    ( 'john96_2710', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
+    ( 'wright64_2711', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
+    ( 'david91_2712', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
+    ( 'daniel87_2713', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
+    ( 'ross60_2714', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
+    ( 'morris60_2715', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
+    ( 'rivera50_2716', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
+    ( 'maria77_2717', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
+    ( 'morgan64_2718', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
+    ( 'miller41_2719', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
+    ( 'david65_2720', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
+    ( 'morgan96_2721', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
+    ( 'ross73_2722', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
+    ( 'brooks95_2723', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
+    ( 'sanders92_2724', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
+    ( 'cooper53_2725', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
+    ( 'ross100_2726', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
+    ( 'michael91_2727', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
+    ( 'brooks51_2728', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
+    ( 'ross26_2729', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
+    ( 'morris58_2730', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
+    ( 'morris58_2731', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
+    ( 'ross12_2732', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
+    ( 'brooks60_2733', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
+    ( 'mark29_2734', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
+    ( 'john14_2735', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
+    ( 'rivera26_2736', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
+    ( 'jenny0949_2737', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
+    ( 'rogers96_2738', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
+    ( 'david55_2739', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
+    ( 'chrishaydon3_2740', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
+    ( 'ross81_2741', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
+    ( 'john70_2742', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
+    ( 'miller34_2743', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
+    ( 'bell26_2744', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
+    ( 'ross92_2745', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
+    ( 'rogers34_2746', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
+    ( 'james51_2747', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
+    ( 'ross54_2748', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
+    ( 'brown59_2749', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
+    ( 'michael83_2750', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
+    ( 'miller10_2751', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
+    ( 'jenny0939_2752', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
+    ( 'paul100_2753', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
+    ( 'smith82_2754', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
+    ( 'morris6_2755', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
+    ( 'paul76_2756', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
+    ( 'mark97_2757', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
+    ( 'john74_2758', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
+    ( 'miller78_2759', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
+    ( 'miller54_2760', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
+    ( 'chrishaydon9_2761', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
+    ( 'daniel4_2762', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
+    ( 'john24_2763', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
+    ( 'smith31_2764', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
+    ( 'smith77_2765', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
+    ( 'maria29_2766', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
+    ( 'michael76_2767', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
+    ( 'mark64_2768', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
+    ( 'morris5_2769', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
+    ( 'brown69_2770', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
+    ( 'miller53_2771', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
+    ( 'wright94_2772', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
+    ( 'rogers26_2773', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
+    ( 'jenny0977_2774', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
+    ( 'daniel23_2775', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
+    ( 'cooper66_2776', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
+    ( 'chrishaydon61_2777', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
+    ( 'maria95_2778', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
+    ( 'james17_2779', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
+    ( 'james18_2780', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
+    ( 'mike47_2781', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
+    ( 'john57_2782', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
+    ( 'mark54_2783', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
+    ( 'bell38_2784', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
+    ( 'morgan31_2785', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
+    ( 'miller16_2786', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
+    ( 'rivera14_2787', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
+    ( 'rogers22_2788', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
+    ( 'jenny0963_2789', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
+    ( 'mark42_2790', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
+    ( 'mark7_2791', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
+    ( 'john1_2792', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
+    ( 'chrishaydon3_2793', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
+    ( 'smith11_2794', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
+    ( 'john22_2795', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
+    ( 'rivera23_2796', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
+    ( 'james32_2797', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
+    ( 'daniel58_2798', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
+    ( 'james18_2799', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
+    ( 'brown93_2800', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
+    ( 'bell89_2801', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
+    ( 'miller82_2802', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
+    ( 'paul21_2803', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
+    ( 'chrishaydon88_2804', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
+    ( 'rivera44_2805', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
+    ( 'bell89_2806', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
+    ( 'bell66_2807', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
+    ( 'rogers31_2808', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
+    ( 'bell47_2809', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
+    ( 'sanders58_2810', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
+    ( 'mark26_2811', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
+    ( 'miller22_2812', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
+    ( 'daniel46_2813', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
+    ( 'morgan100_2814', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
+    ( 'ross75_2815', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
+    ( 'david68_2816', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
+    ( 'rivera35_2817', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
+    ( 'daniel63_2818', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
+    ( 'ross37_2819', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
+    ( 'michael23_2820', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
+    ( 'mike48_2821', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
+    ( 'david57_2822', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
+    ( 'morris67_2823', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
+    ( 'rogers49_2824', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
+    ( 'morris14_2825', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
+    ( 'brooks74_2826', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
+    ( 'mike71_2827', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
+    ( 'mark18_2828', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
+    ( 'mark9_2829', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
+    ( 'brooks21_2830', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
+    ( 'bell4_2831', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
+    ( 'brown17_2832', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
+    ( 'jenny0923_2833', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
+    ( 'smith66_2834', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
+    ( 'daniel53_2835', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
+    ( 'brown33_2836', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
+    ( 'mark55_2837', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
+    ( 'rivera83_2838', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
+    ( 'paul72_2839', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
+    ( 'david25_2840', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
+    ( 'paul47_2841', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
+    ( 'james61_2842', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
+    ( 'paul90_2843', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
+    ( 'david95_2844', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
+    ( 'paul52_2845', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
+    ( 'wright82_2846', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
+    ( 'james61_2847', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
+    ( 'michael89_2848', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
+    ( 'wright44_2849', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
+    ( 'chrishaydon60_2850', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
+    ( 'john30_2851', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
+    ( 'rivera2_2852', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
+    ( 'wright17_2853', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
+    ( 'rivera30_2854', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
+    ( 'daniel63_2855', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
+    ( 'brooks56_2856', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
+    ( 'wright29_2857', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
+    ( 'morris52_2858', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
+    ( 'morgan10_2859', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
+    ( 'sanders55_2860', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
+    ( 'mike9_2861', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
+    ( 'michael69_2862', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
+    ( 'david18_2863', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
+    ( 'daniel41_2864', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
+    ( 'david59_2865', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
+    ( 'daniel17_2866', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
+    ( 'john45_2867', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
+    ( 'morgan17_2868', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
+    ( 'john42_2869', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
+    ( 'rogers50_2870', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
+    ( 'smith35_2871', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
+    ( 'mark56_2872', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
+    ( 'chrishaydon12_2873', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
+    ( 'mark90_2874', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
+    ( 'maria85_2875', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
+    ( 'rogers42_2876', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
+    ( 'brooks95_2877', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
+    ( 'brooks38_2878', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
+    ( 'morris49_2879', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
+    ( 'david86_2880', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
+    ( 'wright15_2881', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
+    ( 'wright75_2882', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
+    ( 'sanders78_2883', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
+    ( 'jenny092_2884', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
+    ( 'cooper68_2885', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
+    ( 'paul87_2886', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
+    ( 'brooks59_2887', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
+    ( 'rivera36_2888', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
+    ( 'cooper25_2889', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
+    ( 'mike3_2890', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
+    ( 'rivera24_2891', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
+    ( 'wright89_2892', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
+    ( 'cooper12_2893', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
+    ( 'paul32_2894', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
+    ( 'wright11_2895', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
+    ( 'maria51_2896', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
+    ( 'morris85_2897', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
+    ( 'chrishaydon88_2898', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
+    ( 'brown49_2899', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
+    ( 'mike55_2900', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
+    ( 'paul6_2901', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
+    ( 'james19_2902', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
+    ( 'brown35_2903', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
+    ( 'chrishaydon15_2904', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
+    ( 'jenny0962_2905', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
+    ( 'brooks57_2906', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
+    ( 'chrishaydon7_2907', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
+    ( 'morgan15_2908', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
+    ( 'chrishaydon47_2909', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
+    ( 'brooks41_2910', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
+    ( 'mark61_2911', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
+    ( 'brooks82_2912', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
+    ( 'maria6_2913', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
+    ( 'brown2_2914', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
+    ( 'maria94_2915', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
+    ( 'smith95_2916', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
+    ( 'ross3_2917', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
+    ( 'smith24_2918', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
+    ( 'john19_2919', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
+    ( 'michael93_2920', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
+    ( 'bell35_2921', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
+    ( 'david80_2922', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
+    ( 'paul3_2923', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
+    ( 'michael27_2924', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
+    ( 'smith12_2925', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
+    ( 'maria61_2926', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
+    ( 'daniel3_2927', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
+    ( 'jenny0923_2928', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
+    ( 'morris5_2929', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
+    ( 'ross58_2930', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
+    ( 'michael48_2931', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
+    ( 'brown92_2932', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
+    ( 'morgan54_2933', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
+    ( 'sanders87_2934', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
+    ( 'paul54_2935', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
+    ( 'cooper59_2936', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
+    ( 'brooks16_2937', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
+    ( 'rivera44_2938', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
+    ( 'sanders60_2939', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
+    ( 'morgan82_2940', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
+    ( 'john69_2941', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
+    ( 'david36_2942', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
+    ( 'mike88_2943', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
+    ( 'david9_2944', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
+    ( 'david34_2945', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
+    ( 'sanders20_2946', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
+
+    ( 'mike22_2947', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
+    ( 'maria63_2948', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
+    ( 'miller94_2949', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
+    ( 'chrishaydon17_2950', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
+    ( 'brooks60_2951', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
+    ( 'bell34_2952', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
+    ( 'chrishaydon65_2953', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
+    ( 'paul70_2954', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
+    ( 'jenny0972_2955', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
+    ( 'john41_2956', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
+    ( 'smith78_2957', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
+    ( 'morgan55_2958', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
+    ( 'smith81_2959', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
+    ( 'jenny0988_2960', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
+    ( 'chrishaydon49_2961', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
+    ( 'morris31_2962', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
+    ( 'mike15_2963', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
+    ( 'smith61_2964', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
+    ( 'chrishaydon42_2965', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
+    ( 'john62_2966', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
+    ( 'maria40_2967', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
+    ( 'james50_2968', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
+    ( 'brown93_2969', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
+    ( 'maria85_2970', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
+    ( 'miller63_2971', 'morgan', 'morris', 'Female', 'ee33d4596af639660986c67f04e6ff92', 1),
+    ( 'smith50_2972', 'brown', 'brown', 'Female', 'bd1e4b23c510e1eae5fa69baa618f3e2', 1),
+    ( 'mike83_2973', 'smith', 'cooper', 'Female', '4a3309bacad462ce5af197a808839446', 1),
+    ( 'mark90_2974', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
+    ( 'morris71_2975', 'rogers', 'sanders', 'Male', 'a7fd9a391ed17cc9be339bcb87af3451', 1),
+    ( 'brown70_2976', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
+    ( 'jenny0930_2977', 'bell', 'rivera', 'Female', 'c25f2f612dde293ec2155a5dfe7901f2', 1),
+    ( 'smith60_2978', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
+    ( 'cooper56_2979', 'ross', 'mike', 'Female', '81b32a932116fed6d09aaa2c2bc522df', 1),
+    ( 'chrishaydon67_2980', 'wright', 'mark', 'Female', 'a4808f35e9eca8fd90326ad5632ff220', 1),
+    ( 'cooper90_2981', 'ross', 'rivera', 'Male', '077380568f1971f1003978046142baeb', 1),
+    ( 'morris82_2982', 'ross', 'miller', 'Female', '8d863a559ac2d9fba0dfa98649e55637', 1),
+    ( 'morgan3_2983', 'sanders', 'mark', 'Male', '4d5a20ef5496dae22ea2bbfa40defc12', 1),
+    ( 'daniel25_2984', 'michael', 'ross', 'Female', '6794c36a5638c0c0df58155493512e60', 1),
+    ( 'rivera64_2985', 'morgan', 'mike', 'Female', '041dcc147d542af1dc6bf50192c1b10f', 1),
+    ( 'bell41_2986', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
+    ( 'rivera34_2987', 'bell', 'paul', 'Male', '7d4a65a26f424093d93857b14104585e', 1),
+    ( 'chrishaydon22_2988', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
+    ( 'miller53_2989', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
+    ( 'miller58_2990', 'sanders', 'paul', 'Male', '3fa66dc95e8f6e524cd5afbf4abfebdf', 1),
+    ( 'wright41_2991', 'smith', 'sanders', 'Female', '7ba55de0a483cd485e1629176c9fa5ac', 1),
+    ( 'john69_2992', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
+    ( 'smith6_2993', 'smith', 'paul', 'Female', '69b7b0f8981d3f462bddcd10e83d73b8', 1),
+    ( 'rogers88_2994', 'miller', 'rivera', 'Male', '18b2b0e6b66ce69949cfd500bdbd547b', 1),
+    ( 'john98_2995', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
+    ( 'paul69_2996', 'john', 'morgan', 'Male', '98bdeac0dbd9377cd342ab3e2ed67002', 1),
+    ( 'morris55_2997', 'wright', 'brown', 'Male', 'e808e39b0369890ca613a24d1bdbe80e', 1),
+    ( 'paul3_2998', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
+    ( 'rogers28_2999', 'brown', 'brown', 'Male', 'cb1b08d86f6053ce02919717671bd81f', 1),
+    ( 'brown16_3000', 'james', 'chrishaydon', 'Female', 'ef70b7e590eb6ef3d09bdb05084610e4', 1),
+    ( 'david68_3001', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
+    ( 'michael8_3002', 'paul', 'morgan', 'Female', '6691fbe0b3d4d260b0ada3cd9c0b78f5', 1),
+    ( 'sanders67_3003', 'brooks', 'paul', 'Male', 'ddf8e1b6ed97cdf30cb35d0f9e47517b', 1),
+    ( 'rivera75_3004', 'brooks', 'brooks', 'Male', 'fb77723ac6a75b2dfa7636213fc12803', 1),
+    ( 'miller81_3005', 'ross', 'david', 'Female', 'e5e1fa5d4c971a980e6d087016507eb7', 1),
+    ( 'jenny0952_3006', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
+    ( 'rivera53_3007', 'bell', 'smith', 'Female', '1e3e64c56121038797a7e50b655464c6', 1),
+    ( 'morgan33_3008', 'wright', 'brown', 'Male', '8e5a808681a0070beaf455c2cabb782b', 1),
+    ( 'ross27_3009', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
+    ( 'miller94_3010', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
+    ( 'michael14_3011', 'paul', 'sanders', 'Female', '9462cebb50643d2a7731b89c5b1b8fe3', 1),
+    ( 'rogers60_3012', 'mark', 'smith', 'Male', 'bef7a008c13dcc9dd7947e5f248a1cfd', 1),
+    ( 'maria50_3013', 'rivera', 'miller', 'Male', '64b7a989ccd1e83d881215cddbeafb72', 1),
+    ( 'morgan96_3014', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
+    ( 'ross49_3015', 'sanders', 'jenny09', 'Female', '53083ad87812f62ccd391f01069e77b5', 1),
+    ( 'wright37_3016', 'miller', 'chrishaydon', 'Male', '0f8b225730e5be91f0f4cbb29156cf44', 1),
+    ( 'brown38_3017', 'ross', 'morgan', 'Male', '07a841d9d548265d67ac8897f60f1b06', 1),
+    ( 'michael50_3018', 'mike', 'rogers', 'Female', 'a366cae9b72e785998b0ce78a2284bdb', 1),
+    ( 'wright93_3019', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
+    ( 'miller91_3020', 'mike', 'chrishaydon', 'Male', 'edb8e845ed0dc8c888f7225fa43eb2a6', 1),
+    ( 'daniel21_3021', 'cooper', 'sanders', 'Female', 'df3e462d93781ea422c125a4c2b5566a', 1),
+    ( 'bell44_3022', 'ross', 'smith', 'Male', 'a49601f3e6a85c0387539bba4bcdbe6c', 1),
+    ( 'brooks58_3023', 'ross', 'john', 'Male', '6e5b2a0375b4d4d500ee07e62cad6d6c', 1),
+    ( 'chrishaydon86_3024', 'sanders', 'david', 'Female', 'c3bf2214f10104ec078bed756539f9af', 1),
+    ( 'jenny0910_3025', 'paul', 'smith', 'Male', '58eea070ea61891bfea5954c38cb9336', 1),
+    ( 'rivera53_3026', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
+    ( 'brown75_3027', 'sanders', 'rivera', 'Female', '7c5cef8a2e387abe6908ee2f3fbc534a', 1),
+    ( 'maria40_3028', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
+    ( 'john12_3029', 'sanders', 'sanders', 'Male', 'cba0f422fbd342f81feb1dd78a43cad6', 1),
+    ( 'sanders88_3030', 'paul', 'miller', 'Male', 'b6153be014b1af1811c85aa770c4cc46', 1),
+    ( 'morris98_3031', 'morgan', 'brooks', 'Female', 'd793df005919fa5c4f45a61a7f9f8e87', 1),
+    ( 'miller17_3032', 'daniel', 'brown', 'Female', 'a11bf4bd7d2f8c8162d97c7447ab07f8', 1),
+    ( 'cooper84_3033', 'miller', 'miller', 'Male', '941752ab63605763d87142453aa35988', 1),
+    ( 'ross49_3034', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
+    ( 'morgan54_3035', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
+    ( 'chrishaydon29_3036', 'wright', 'cooper', 'Female', 'b6ed3804fd6ad8ccd1c85c0a7deda4e3', 1),
+    ( 'paul3_3037', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
+    ( 'morris98_3038', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
+    ( 'cooper55_3039', 'mark', 'ross', 'Female', '6c794b04248c5d6120dd84b8e94967a7', 1),
+    ( 'rivera85_3040', 'brown', 'brooks', 'Female', '0ca4d10e4c787cc061e18feb815f747b', 1),
+    ( 'rogers43_3041', 'jenny09', 'mike', 'Female', 'aa98ad5a318401147dc9580a9e134a7e', 1),
+    ( 'ross42_3042', 'mark', 'michael', 'Female', '1507a0565d69991ff963cd3489b1c491', 1),
+    ( 'james29_3043', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
+    ( 'chrishaydon20_3044', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
+    ( 'wright68_3045', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
+    ( 'cooper47_3046', 'ross', 'paul', 'Male', '524f2968fabb85f0fdb2fa63110f6690', 1),
+    ( 'morgan73_3047', 'chrishaydon', 'cooper', 'Male', '33b7c4b03c90ac30be52d86c3229577b', 1),
+    ( 'maria82_3048', 'daniel', 'daniel', 'Male', '9a83d41058fafd2a50b9fcce02811361', 1),
+    ( 'john8_3049', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
+    ( 'miller63_3050', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
+    ( 'morgan26_3051', 'michael', 'rogers', 'Male', 'e4a653c83b7fa702099b4c780e5fa68d', 1),
+    ( 'rivera69_3052', 'brown', 'david', 'Female', 'b58472e08b58b46875487093c2867ec6', 1),
+    ( 'daniel31_3053', 'john', 'rogers', 'Male', '9d84f6219123fb1920cf2e7494a44d77', 1),
+    ( 'sanders100_3054', 'michael', 'john', 'Male', '349fda3c9d90de7e62c9ada6c0550a8b', 1),
+    ( 'mark84_3055', 'morris', 'chrishaydon', 'Female', '8f9307568ba555323b0599ce0c715b1a', 1),
+    ( 'smith56_3056', 'david', 'paul', 'Male', 'baaf6963e059f92921d196b5fef6669d', 1),
+    ( 'morris93_3057', 'chrishaydon', 'james', 'Female', 'dba13ee70b381ea5b9ceac0ece3d850f', 1),
+    ( 'michael45_3058', 'michael', 'paul', 'Female', 'd9f0344646b6ba7f224d9d3a0986cc7e', 1),
+    ( 'mike88_3059', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
+    ( 'morgan96_3060', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
+    ( 'james5_3061', 'brooks', 'mike', 'Female', 'ac332ec30f36d30d5bf9221fe1b32bf0', 1),
+    ( 'morris83_3062', 'sanders', 'maria', 'Male', 'fcb81feea910ea24117d4f26171991f8', 1),
+    ( 'rivera19_3063', 'rivera', 'bell', 'Female', '0ba1e8782ffcf3e71c079e1e54c0a41c', 1),
+    ( 'daniel57_3064', 'paul', 'bell', 'Female', '62d04b1633be93a4b4f93256a56e28d0', 1),
+    ( 'maria10_3065', 'wright', 'sanders', 'Female', '9c8c23c03a74a7a320d742aa95cf08e3', 1),
+    ( 'david71_3066', 'jenny09', 'david', 'Male', '16a061f8fdf5c93e9e77631dfb7d471a', 1),
+    ( 'sanders88_3067', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
+    ( 'rogers37_3068', 'chrishaydon', 'brown', 'Male', '822141a673303a54b9387d8fc6a0c425', 1),
+    ( 'chrishaydon10_3069', 'chrishaydon', 'john', 'Male', '1b7d631cf551c86b671fd6bc2ec9d0dc', 1),
+    ( 'sanders27_3070', 'bell', 'morris', 'Male', '576352444376fe3069d256ded463666f', 1),
+    ( 'brown17_3071', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
+    ( 'morris86_3072', 'rogers', 'ross', 'Male', '896b690b9566f53a875e03a3a324c091', 1),
+    ( 'mark79_3073', 'bell', 'sanders', 'Male', 'cee8dbd9130bccbe4d945c9cfc41f330', 1),
+    ( 'david58_3074', 'david', 'morgan', 'Female', 'ca391efb9f114371dced52c8eb18a412', 1),
+    ( 'daniel95_3075', 'wright', 'ross', 'Male', '4458808784017babe1bb3ed79c61f0f7', 1),
+    ( 'brooks49_3076', 'sanders', 'morgan', 'Female', 'dbd61cc2c16fa763662c8a29d63215e0', 1),
+    ( 'jenny0926_3077', 'wright', 'david', 'Female', 'd2d4451b918dbb9730bb7e010a50ab60', 1),
+    ( 'bell37_3078', 'morgan', 'john', 'Female', '9019a44759e7bdece498cc5dce570446', 1),
+    ( 'john46_3079', 'miller', 'john', 'Female', 'c618d2bfb0bf88f1de98313820f226a8', 1),
+    ( 'chrishaydon43_3080', 'miller', 'chrishaydon', 'Female', 'e63ca65668b82c9fd07eef6b719ad4aa', 1),
+    ( 'rogers19_3081', 'mike', 'john', 'Male', '59843bfb2ca080a23bcbbc5278fa6982', 1),
+    ( 'brooks29_3082', 'rivera', 'rivera', 'Female', 'f151014abd62636e598dbad4a5b43aa3', 1),
+    ( 'chrishaydon74_3083', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
+    ( 'brooks43_3084', 'morgan', 'cooper', 'Male', '669e54b4368a4a99595fe4a56b9bf6ff', 1),
+    ( 'ross44_3085', 'morgan', 'smith', 'Female', 'a84cf2d984ed2eb7948b9fda85275a7f', 1),
+    ( 'bell63_3086', 'chrishaydon', 'james', 'Male', '863d1292a4a36cdc9134a7f84e8070c9', 1),
+    ( 'rivera41_3087', 'maria', 'mark', 'Male', 'a9dd9815cf33560650fc661a97f41547', 1),
+    ( 'wright17_3088', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
+    ( 'michael15_3089', 'david', 'mark', 'Female', 'a167a5d9cf8157d98d45c1d0e32b72f4', 1),
+    ( 'ross53_3090', 'morris', 'daniel', 'Female', 'b4a6baa51bd47cf407213f6ba9254f71', 1),
+    ( 'cooper65_3091', 'brooks', 'mark', 'Female', '26f039d32dad1e54e8f74b015ea9ec68', 1),
+    ( 'rivera2_3092', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
+    ( 'james32_3093', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
+    ( 'mark97_3094', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
+    ( 'smith93_3095', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
+    ( 'chrishaydon84_3096', 'james', 'brown', 'Male', '5957038b6a3f0a0f305123af998bc437', 1),
+    ( 'smith30_3097', 'maria', 'wright', 'Male', '62d191a19bda44ba011ebd37976b5a23', 1),
+    ( 'maria51_3098', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
+    ( 'rivera52_3099', 'cooper', 'michael', 'Male', 'c15a7058e0c14b26f6863fbd7549a1e7', 1),
+    ( 'chrishaydon48_3100', 'david', 'cooper', 'Female', 'e51d84e0afa82e704755b26fe59f566e', 1),
+    ( 'morgan90_3101', 'mike', 'maria', 'Female', 'e78f1e49da6ebf2fe756ec38b646ba36', 1),
+    ( 'sanders53_3102', 'bell', 'mark', 'Female', '15d2a8035ab8f89a8d2388049cdb1ee9', 1),
+    ( 'john47_3103', 'sanders', 'sanders', 'Female', 'd3945958288a3f00f1d5fe7230670061', 1),
+    ( 'daniel100_3104', 'paul', 'cooper', 'Male', '1ca7a74ac2257ad3c0f5885cb90099f2', 1),
+    ( 'wright13_3105', 'brown', 'mark', 'Male', '35e1bd4961d74f12ed2e2399f219aaa9', 1),
+    ( 'morgan86_3106', 'brown', 'daniel', 'Female', 'ba7d8e424270cfe9f48eb09c99ff68a1', 1),
+    ( 'wright17_3107', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
+    ( 'morris93_3108', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
+    ( 'james40_3109', 'rogers', 'rogers', 'Male', 'b44bc9d82406f9a445889a66b255f271', 1),
+    ( 'ross47_3110', 'sanders', 'chrishaydon', 'Male', '4592e91917d68abf8835aeae27f38cfc', 1),
+    ( 'wright8_3111', 'bell', 'cooper', 'Male', 'e30161a98a0e9e3933dbb856e6af4719', 1),
+    ( 'ross83_3112', 'morris', 'sanders', 'Female', '2ec9470fb5a92621648ad5c708b050f8', 1),
+    ( 'ross9_3113', 'bell', 'sanders', 'Female', '85b8a1781fe0eda5fcdb86d950c2e239', 1),
+    ( 'miller47_3114', 'morris', 'david', 'Female', '2fb2bd61613de4adc3ab2fc7fc05fe88', 1),
+    ( 'smith28_3115', 'brown', 'john', 'Female', '2e3a6218c8c4652563470fd23b5c9004', 1),
+    ( 'morris2_3116', 'bell', 'jenny09', 'Male', '9248ee5ee8d1ff997d0009348e2ea662', 1),
+    ( 'morris4_3117', 'rogers', 'brooks', 'Male', 'd9290c1d84c18de491112fab16fc0172', 1),
+    ( 'jenny0926_3118', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
+    ( 'morgan21_3119', 'john', 'cooper', 'Male', 'c1c1328643f85dca460e8ead1600c650', 1),
+    ( 'david3_3120', 'michael', 'miller', 'Male', '6c5c00ec2728ed2459e821dce4a85349', 1),
+    ( 'ross58_3121', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
+    ( 'jenny0949_3122', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
+    ( 'john96_3123', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
+    ( 'paul5_3124', 'rivera', 'ross', 'Male', '13a3756c2cb7b486e32054a08271d3f1', 1),
+    ( 'sanders80_3125', 'michael', 'james', 'Female', '73f63edc5f6f3b571886fbb1316c43bc', 1),
+    ( 'smith65_3126', 'brooks', 'bell', 'Male', '7ba86a4101f3074f458df9a6e43e3f5a', 1),
+    ( 'rogers26_3127', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
+    ( 'maria43_3128', 'miller', 'bell', 'Male', '0c879f0908f637ebe8cc0db994ae0832', 1),
+    ( 'david99_3129', 'maria', 'jenny09', 'Female', 'b38a8c25edada7483420c20a76b9d4b0', 1),
+    ( 'wright20_3130', 'smith', 'rogers', 'Female', 'e11d269be9b74106223b357b06f2da33', 1),
+    ( 'brooks87_3131', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
+    ( 'mike43_3132', 'morgan', 'ross', 'Male', '024142778439c88b675219780cf1c578', 1),
+    ( 'ross30_3133', 'mark', 'david', 'Male', 'c5fb92d8af4e45581d8bb7c71a5c0a1c', 1),
+    ( 'jenny0981_3134', 'morgan', 'brooks', 'Female', '34ae1e72198b2ccb37610a3269368fa7', 1),
+    ( 'cooper11_3135', 'james', 'james', 'Female', '243cffae81569cca39840aec1e9e2852', 1),
+    ( 'rogers97_3136', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
+    ( 'wright84_3137', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
+    ( 'jenny0932_3138', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
+    ( 'sanders40_3139', 'paul', 'sanders', 'Female', 'be92492fa64d6126726a71584a75a878', 1),
+    ( 'john80_3140', 'daniel', 'ross', 'Female', '8a608ab5ea3865ae688ac2f8b1cc3b61', 1),
+    ( 'brooks58_3141', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
+    ( 'miller37_3142', 'brooks', 'bell', 'Female', '62c1ace1a0aa8e798169f547586457c8', 1),
+    ( 'james93_3143', 'chrishaydon', 'james', 'Male', '7ef00ef6e7f47472db040222dae2dc8e', 1),
+    ( 'bell16_3144', 'bell', 'james', 'Male', '0d5e1a6eab996a7d1d32a0e2ed22ff2a', 1),
+    ( 'smith96_3145', 'mike', 'bell', 'Female', '68f2fb17fd3f13d8a0a394de396ce447', 1),
+    ( 'ross94_3146', 'david', 'bell', 'Male', '84c62bc5b6f1dab11a1ca3d8d7a5e29e', 1),
+    ( 'ross95_3147', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
+    ( 'chrishaydon82_3148', 'jenny09', 'maria', 'Female', '8093f823794b8fa03c379c035300fd0b', 1),
+    ( 'michael3_3149', 'mike', 'jenny09', 'Male', 'fc13f6e30b38a8529a57add8a59b5ec0', 1),
+    ( 'jenny0918_3150', 'rogers', 'brown', 'Male', '8b9e34ac36a2fd947614e2616f3d6826', 1),
+    ( 'rogers43_3151', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
+    ( 'morris91_3152', 'paul', 'rivera', 'Male', '613be5cffa8a578c1816a1baf6bb8501', 1),
+    ( 'john4_3153', 'james', 'rivera', 'Female', '76ebe07cd3c27e769f71251f294d4c94', 1),
+    ( 'cooper5_3154', 'wright', 'morris', 'Male', '8105c71bb32ba7bf731c4162ff9c1f21', 1),
+    ( 'chrishaydon20_3155', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
+    ( 'chrishaydon44_3156', 'mark', 'smith', 'Female', 'a54db1865fb0fa3a66861d93e037741d', 1),
+    ( 'daniel13_3157', 'brooks', 'brown', 'Female', '91270e97491a918496e6569c9fcff808', 1),
+    ( 'chrishaydon37_3158', 'brown', 'mark', 'Male', 'a3efe2fadf9e33423b80532af0f95c33', 1),
+    ( 'david31_3159', 'wright', 'paul', 'Female', 'b8e0691f01b08a810fade049028d9c3e', 1),
+    ( 'mark21_3160', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
+    ( 'wright81_3161', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
+    ( 'michael13_3162', 'morris', 'cooper', 'Female', '9d450e0529ca7328d07679163206f732', 1),
+    ( 'rogers63_3163', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
+    ( 'mike28_3164', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
+    ( 'rivera92_3165', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
+    ( 'ross95_3166', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
+    ( 'paul85_3167', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
+    ( 'smith34_3168', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
+    ( 'james84_3169', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
+    ( 'daniel53_3170', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
+    ( 'brooks80_3171', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
+    ( 'morgan65_3172', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
+    ( 'sanders84_3173', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
+    ( 'maria40_3174', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
+    ( 'brown71_3175', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
+    ( 'james63_3176', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
+    ( 'jenny0993_3177', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
+    ( 'john96_3178', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
+    ( 'miller64_3179', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
+    ( 'mark46_3180', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
+    ( 'jenny0988_3181', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
+    ( 'mark80_3182', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
+    ( 'morris72_3183', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
+    ( 'wright39_3184', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
+    ( 'paul68_3185', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
+    ( 'smith60_3186', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
+    ( 'bell43_3187', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
+    ( 'rogers79_3188', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
+    ( 'daniel56_3189', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
+    ( 'brooks85_3190', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
+    ( 'mike30_3191', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
+    ( 'paul92_3192', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
+    ( 'bell96_3193', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
+    ( 'john8_3194', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
+    ( 'chrishaydon12_3195', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
+    ( 'morgan13_3196', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
+    ( 'james83_3197', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
+    ( 'chrishaydon8_3198', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
+    ( 'ross85_3199', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
+    ( 'ross46_3200', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
+    ( 'smith4_3201', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
+    ( 'paul4_3202', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
+    ( 'daniel26_3203', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
+    ( 'chrishaydon2_3204', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
+    ( 'david82_3205', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
+    ( 'john97_3206', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
+    ( 'david57_3207', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
+    ( 'rivera100_3208', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
+    ( 'bell13_3209', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
+    ( 'brooks65_3210', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
+    ( 'daniel40_3211', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
+    ( 'cooper100_3212', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
+    ( 'morris14_3213', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
+    ( 'smith82_3214', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
+    ( 'cooper35_3215', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
+    ( 'morgan94_3216', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
+    ( 'michael92_3217', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
+    ( 'sanders48_3218', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
+    ( 'brown76_3219', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
+    ( 'james16_3220', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
+    ( 'michael26_3221', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
+    ( 'wright57_3222', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
+    ( 'wright68_3223', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
+    ( 'brooks1_3224', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
+    ( 'bell2_3225', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
+    ( 'miller100_3226', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
+    ( 'rogers53_3227', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
+    ( 'mike1_3228', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
+    ( 'cooper57_3229', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
+    ( 'daniel38_3230', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
+    ( 'mark2_3231', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
+    ( 'daniel79_3232', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
+    ( 'wright4_3233', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
+    ( 'brown84_3234', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
+    ( 'paul41_3235', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
+    ( 'mark5_3236', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
+    ( 'jenny0994_3237', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
+    ( 'morris53_3238', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
+    ( 'paul68_3239', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
+    ( 'brooks86_3240', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
+    ( 'james54_3241', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
+    ( 'rogers58_3242', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
+    ( 'maria31_3243', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
+    ( 'david5_3244', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
+    ( 'mark21_3245', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
+    ( 'jenny0932_3246', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
+    ( 'john92_3247', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
+    ( 'rogers98_3248', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
+    ( 'rogers95_3249', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
+    ( 'james50_3250', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
+    ( 'miller80_3251', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
+    ( 'mark29_3252', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
+    ( 'cooper77_3253', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
+    ( 'john24_3254', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
+    ( 'chrishaydon32_3255', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
+    ( 'bell41_3256', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
+    ( 'ross99_3257', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
+    ( 'smith9_3258', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
+    ( 'miller73_3259', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
+    ( 'michael44_3260', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
+    ( 'michael36_3261', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
+    ( 'smith93_3262', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
+    ( 'morgan38_3263', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
+    ( 'brown70_3264', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
+    ( 'mike14_3265', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
+    ( 'daniel72_3266', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
+    ( 'jenny0974_3267', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
+    ( 'maria51_3268', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
+    ( 'michael97_3269', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
+    ( 'chrishaydon33_3270', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
+    ( 'sanders42_3271', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
+    ( 'brooks87_3272', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
+    ( 'bell4_3273', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
+    ( 'jenny0945_3274', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
+    ( 'james65_3275', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
+    ( 'john98_3276', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
+    ( 'paul80_3277', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
+    ( 'john92_3278', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
+    ( 'wright69_3279', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
+    ( 'bell68_3280', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
+    ( 'brooks97_3281', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
+    ( 'mark14_3282', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
+    ( 'david60_3283', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
+    ( 'rivera68_3284', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
+    ( 'brooks32_3285', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
+    ( 'brown15_3286', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
+    ( 'bell88_3287', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
+    ( 'john26_3288', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
+    ( 'paul55_3289', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
+    ( 'david59_3290', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
+    ( 'morris73_3291', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
+    ( 'michael77_3292', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
+    ( 'mark5_3293', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
+    ( 'james65_3294', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
+    ( 'maria39_3295', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
+    ( 'michael37_3296', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
+    ( 'sanders1_3297', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
+    ( 'daniel30_3298', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
+    ( 'brown53_3299', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
+    ( 'john52_3300', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
+    ( 'wright73_3301', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
+    ( 'mark63_3302', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
+    ( 'james29_3303', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
+    ( 'mark23_3304', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
+    ( 'cooper22_3305', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
+    ( 'miller19_3306', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
+    ( 'jenny0913_3307', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
+    ( 'maria100_3308', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
+    ( 'chrishaydon51_3309', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
+    ( 'david9_3310', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
+    ( 'mike52_3311', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
+    ( 'wright5_3312', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
+    ( 'brown21_3313', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
+    ( 'daniel46_3314', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
+    ( 'brown86_3315', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
+    ( 'chrishaydon85_3316', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
+    ( 'john41_3317', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
+    ( 'mark72_3318', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
+    ( 'michael67_3319', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
+    ( 'jenny0943_3320', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
+    ( 'daniel61_3321', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
+    ( 'morgan34_3322', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
+    ( 'jenny097_3323', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
+    ( 'miller11_3324', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
+    ( 'daniel99_3325', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
+    ( 'miller20_3326', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
+    ( 'sanders29_3327', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
+    ( 'mark43_3328', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
+    ( 'james86_3329', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
+    ( 'chrishaydon74_3330', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
+    ( 'brooks37_3331', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
+    ( 'michael78_3332', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
+    ( 'paul9_3333', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
+    ( 'james57_3334', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
+    ( 'mark91_3335', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
+    ( 'wright30_3336', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
+    ( 'rogers97_3337', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
+    ( 'daniel8_3338', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
+    ( 'mark66_3339', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
+    ( 'daniel6_3340', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
+    ( 'bell72_3341', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
+    ( 'morgan77_3342', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
+    ( 'bell24_3343', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
+    ( 'michael31_3344', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
+    ( 'cooper98_3345', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
+    ( 'daniel41_3346', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
+    ( 'michael1_3347', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
+    ( 'chrishaydon20_3348', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
+    ( 'daniel66_3349', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
+    ( 'cooper52_3350', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
+    ( 'chrishaydon99_3351', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
+    ( 'smith66_3352', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
+    ( 'mike48_3353', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
+    ( 'rivera80_3354', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
+    ( 'morgan35_3355', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
+    ( 'brooks16_3356', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
+    ( 'mike78_3357', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
+    ( 'michael38_3358', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
+    ( 'mark7_3359', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
+    ( 'rogers80_3360', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
+    ( 'wright81_3361', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
+    ( 'michael21_3362', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
+    ( 'daniel56_3363', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
+    ( 'ross3_3364', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
+    ( 'chrishaydon72_3365', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
+    ( 'sanders66_3366', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
+    ( 'michael4_3367', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
+    ( 'brown66_3368', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
+    ( 'wright26_3369', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
+    ( 'james81_3370', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
+    ( 'morris21_3371', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
+    ( 'john30_3372', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
+    ( 'jenny0929_3373', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
+    ( 'wright91_3374', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
+    ( 'jenny0922_3375', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
+    ( 'rivera35_3376', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
+    ( 'rogers53_3377', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
+    ( 'ross50_3378', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
+    ( 'ross41_3379', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
+    ( 'rivera78_3380', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
+    ( 'miller43_3381', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
+    ( 'morgan59_3382', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
+    ( 'chrishaydon63_3383', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
+    ( 'wright93_3384', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
+    ( 'maria62_3385', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
+    ( 'mark85_3386', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
+    ( 'wright3_3387', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
+    ( 'brooks37_3388', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
+    ( 'paul66_3389', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
+    ( 'rivera36_3390', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
+    ( 'paul42_3391', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
+    ( 'morgan93_3392', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
+    ( 'rivera43_3393', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
+    ( 'daniel71_3394', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
+    ( 'mike81_3395', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
+    ( 'daniel83_3396', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
+    ( 'smith54_3397', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
+    ( 'sanders38_3398', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
+    ( 'morris63_3399', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
+    ( 'michael25_3400', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
+    ( 'smith89_3401', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
+    ( 'john97_3402', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
+    ( 'jenny0985_3403', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
+    ( 'wright84_3404', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
+    ( 'maria53_3405', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
+    ( 'brooks72_3406', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
+    ( 'brown61_3407', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
+    ( 'paul95_3408', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
+    ( 'brooks92_3409', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
+    ( 'james44_3410', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
+    ( 'chrishaydon100_3411', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
+    ( 'mark97_3412', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
+    ( 'brooks91_3413', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
+    ( 'jenny0929_3414', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
+    ( 'morgan37_3415', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
+    ( 'daniel43_3416', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
+    ( 'mike98_3417', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
+    ( 'john48_3418', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
+    ( 'brooks65_3419', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
+    ( 'cooper18_3420', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
+    ( 'chrishaydon54_3421', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
+    ( 'john67_3422', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
+    ( 'morris27_3423', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
+    ( 'ross45_3424', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
+    ( 'rogers85_3425', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
+    ( 'sanders96_3426', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
+    ( 'smith70_3427', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
+    ( 'wright31_3428', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
+    ( 'paul10_3429', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
+    ( 'sanders2_3430', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
+    ( 'rogers14_3431', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
+    ( 'morgan79_3432', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
+    ( 'morris87_3433', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
+    ( 'james6_3434', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
+    ( 'wright33_3435', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
+    ( 'maria85_3436', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
+    ( 'ross31_3437', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
+    ( 'chrishaydon75_3438', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
+    ( 'david54_3439', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
+    ( 'chrishaydon50_3440', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
+    ( 'miller24_3441', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
+    ( 'maria9_3442', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
+    ( 'smith91_3443', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
+    ( 'rivera11_3444', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
+    ( 'james72_3445', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
+    ( 'miller36_3446', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
+    ( 'jenny0991_3447', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
+    ( 'jenny0959_3448', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
+    ( 'mike41_3449', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
+    ( 'morgan20_3450', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
+    ( 'miller29_3451', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
+    ( 'ross56_3452', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
+    ( 'chrishaydon63_3453', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
+    ( 'morris54_3454', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
+    ( 'bell20_3455', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
+    ( 'paul41_3456', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
+    ( 'morgan42_3457', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
+    ( 'ross100_3458', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
+    ( 'wright1_3459', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
+    ( 'jenny0921_3460', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
+    ( 'paul37_3461', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
+    ( 'michael92_3462', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
+    ( 'chrishaydon14_3463', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
+    ( 'miller40_3464', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
+    ( 'wright43_3465', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
+    ( 'smith15_3466', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
+    ( 'miller49_3467', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
+    ( 'morris21_3468', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
+    ( 'ross27_3469', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
+    ( 'rivera16_3470', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
+    ( 'maria73_3471', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
+    ( 'chrishaydon60_3472', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
+    ( 'david40_3473', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
+    ( 'michael66_3474', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
+    ( 'paul27_3475', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
+    ( 'jenny0911_3476', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
+    ( 'miller79_3477', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
+    ( 'mark14_3478', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
+    ( 'ross87_3479', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
+    ( 'paul47_3480', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
+    ( 'sanders61_3481', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
+    ( 'rivera13_3482', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
+    ( 'chrishaydon22_3483', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
+    ( 'sanders42_3484', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
+    ( 'david78_3485', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
+    ( 'ross60_3486', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
+    ( 'morris24_3487', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
+    ( 'jenny0952_3488', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
+    ( 'rogers72_3489', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
+    ( 'daniel70_3490', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
+    ( 'cooper76_3491', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
+    ( 'jenny0942_3492', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
+    ( 'daniel39_3493', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
+    ( 'morris59_3494', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
+    ( 'mike70_3495', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
+    ( 'rogers20_3496', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
+    ( 'david33_3497', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
+    ( 'morris65_3498', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
+    ( 'michael23_3499', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
+    ( 'john96_3500', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
+    ( 'wright64_3501', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
+    ( 'david91_3502', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
+    ( 'daniel87_3503', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
+    ( 'ross60_3504', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
+    ( 'morris60_3505', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
+    ( 'rivera50_3506', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
+    ( 'maria77_3507', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
+    ( 'morgan64_3508', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
+    ( 'miller41_3509', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
+    ( 'david65_3510', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
+    ( 'morgan96_3511', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
+    ( 'ross73_3512', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
+    ( 'brooks95_3513', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
+    ( 'sanders92_3514', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
+    ( 'cooper53_3515', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
+    ( 'ross100_3516', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
+    ( 'michael91_3517', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
+    ( 'brooks51_3518', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
+    ( 'ross26_3519', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
+    ( 'morris58_3520', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
+    ( 'morris58_3521', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
+    ( 'ross12_3522', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
+    ( 'brooks60_3523', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
+    ( 'mark29_3524', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
+    ( 'john14_3525', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
+    ( 'rivera26_3526', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
+    ( 'jenny0949_3527', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
+    ( 'rogers96_3528', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
+    ( 'david55_3529', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
+    ( 'chrishaydon3_3530', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
+    ( 'ross81_3531', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
+    ( 'john70_3532', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
+    ( 'miller34_3533', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
+    ( 'bell26_3534', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
+    ( 'ross92_3535', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
+    ( 'rogers34_3536', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
+    ( 'james51_3537', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
+    ( 'ross54_3538', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
+    ( 'brown59_3539', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
+    ( 'michael83_3540', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
+    ( 'miller10_3541', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
+    ( 'jenny0939_3542', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
+    ( 'paul100_3543', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
+    ( 'smith82_3544', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
+    ( 'morris6_3545', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
+    ( 'paul76_3546', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
+    ( 'mark97_3547', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
+    ( 'john74_3548', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
+    ( 'miller78_3549', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
+    ( 'miller54_3550', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
+    ( 'chrishaydon9_3551', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
+    ( 'daniel4_3552', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
+    ( 'john24_3553', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
+    ( 'smith31_3554', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
+    ( 'smith77_3555', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
+    ( 'maria29_3556', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
+    ( 'michael76_3557', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
+    ( 'mark64_3558', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
+    ( 'morris5_3559', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
+    ( 'brown69_3560', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
+    ( 'miller53_3561', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
+    ( 'wright94_3562', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
+    ( 'rogers26_3563', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
+    ( 'jenny0977_3564', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
+    ( 'daniel23_3565', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
+    ( 'cooper66_3566', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
+    ( 'chrishaydon61_3567', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
+    ( 'maria95_3568', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
+    ( 'james17_3569', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
+    ( 'james18_3570', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
+    ( 'mike47_3571', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
+    ( 'john57_3572', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
+    ( 'mark54_3573', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
+    ( 'bell38_3574', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
+    ( 'morgan31_3575', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
+    ( 'miller16_3576', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
+    ( 'rivera14_3577', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
+    ( 'rogers22_3578', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
+    ( 'jenny0963_3579', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
+    ( 'mark42_3580', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
+    ( 'mark7_3581', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
+    ( 'john1_3582', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
+    ( 'chrishaydon3_3583', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
+    ( 'smith11_3584', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
+    ( 'john22_3585', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
+    ( 'rivera23_3586', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
+    ( 'james32_3587', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
+    ( 'daniel58_3588', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
+    ( 'james18_3589', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
+    ( 'brown93_3590', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
+    ( 'bell89_3591', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
+    ( 'miller82_3592', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
+    ( 'paul21_3593', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
+    ( 'chrishaydon88_3594', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
+    ( 'rivera44_3595', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
+    ( 'bell89_3596', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
+    ( 'bell66_3597', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
+    ( 'rogers31_3598', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
+    ( 'bell47_3599', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
+    ( 'sanders58_3600', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
+    ( 'mark26_3601', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
+    ( 'miller22_3602', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
+    ( 'daniel46_3603', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
+    ( 'morgan100_3604', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
+    ( 'ross75_3605', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
+    ( 'david68_3606', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
+    ( 'rivera35_3607', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
+    ( 'daniel63_3608', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
+    ( 'ross37_3609', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
+    ( 'michael23_3610', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
+    ( 'mike48_3611', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
+    ( 'david57_3612', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
+    ( 'morris67_3613', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
+    ( 'rogers49_3614', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
+    ( 'morris14_3615', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
+    ( 'brooks74_3616', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
+    ( 'mike71_3617', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
+    ( 'mark18_3618', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
+    ( 'mark9_3619', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
+    ( 'brooks21_3620', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
+    ( 'bell4_3621', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
+    ( 'brown17_3622', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
+    ( 'jenny0923_3623', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
+    ( 'smith66_3624', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
+    ( 'daniel53_3625', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
+    ( 'brown33_3626', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
+    ( 'mark55_3627', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
+    ( 'rivera83_3628', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
+    ( 'paul72_3629', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
+    ( 'david25_3630', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
+    ( 'paul47_3631', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
+    ( 'james61_3632', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
+    ( 'paul90_3633', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
+    ( 'david95_3634', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
+    ( 'paul52_3635', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
+    ( 'wright82_3636', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
+    ( 'james61_3637', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
+    ( 'michael89_3638', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
+    ( 'wright44_3639', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
+    ( 'chrishaydon60_3640', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
+    ( 'john30_3641', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
+    ( 'rivera2_3642', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
+    ( 'wright17_3643', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
+    ( 'rivera30_3644', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
+    ( 'daniel63_3645', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
+    ( 'brooks56_3646', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
+    ( 'wright29_3647', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
+    ( 'morris52_3648', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
+    ( 'morgan10_3649', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
+    ( 'sanders55_3650', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
+    ( 'mike9_3651', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
+    ( 'michael69_3652', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
+    ( 'david18_3653', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
+    ( 'daniel41_3654', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
+    ( 'david59_3655', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
+    ( 'daniel17_3656', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
+    ( 'john45_3657', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
+    ( 'morgan17_3658', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
+    ( 'john42_3659', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
+    ( 'rogers50_3660', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
+    ( 'smith35_3661', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
+    ( 'mark56_3662', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
+    ( 'chrishaydon12_3663', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
+    ( 'mark90_3664', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
+    ( 'maria85_3665', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
+    ( 'rogers42_3666', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
+    ( 'brooks95_3667', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
+    ( 'brooks38_3668', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
+    ( 'morris49_3669', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
+    ( 'david86_3670', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
+    ( 'wright15_3671', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
+    ( 'wright75_3672', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
+    ( 'sanders78_3673', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
+    ( 'jenny092_3674', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
+    ( 'cooper68_3675', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
+    ( 'paul87_3676', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
+    ( 'brooks59_3677', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
+    ( 'rivera36_3678', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
+    ( 'cooper25_3679', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
+    ( 'mike3_3680', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
+    ( 'rivera24_3681', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
+    ( 'wright89_3682', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
+    ( 'cooper12_3683', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
+    ( 'paul32_3684', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
+    ( 'wright11_3685', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
+    ( 'maria51_3686', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
+    ( 'morris85_3687', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
+    ( 'chrishaydon88_3688', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
+    ( 'brown49_3689', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
+    ( 'mike55_3690', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
+    ( 'paul6_3691', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
+    ( 'james19_3692', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
+    ( 'brown35_3693', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
+    ( 'chrishaydon15_3694', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
+    ( 'jenny0962_3695', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
+    ( 'brooks57_3696', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
+    ( 'chrishaydon7_3697', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
+    ( 'morgan15_3698', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
+    ( 'chrishaydon47_3699', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
+    ( 'brooks41_3700', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
+    ( 'mark61_3701', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
+    ( 'brooks82_3702', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
+    ( 'maria6_3703', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
+    ( 'brown2_3704', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
+    ( 'maria94_3705', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
+    ( 'smith95_3706', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
+    ( 'ross3_3707', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
+    ( 'smith24_3708', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
+    ( 'john19_3709', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
+    ( 'michael93_3710', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
+    ( 'bell35_3711', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
+    ( 'david80_3712', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
+    ( 'paul3_3713', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
+    ( 'michael27_3714', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
+    ( 'smith12_3715', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
+    ( 'maria61_3716', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
+    ( 'daniel3_3717', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
+    ( 'jenny0923_3718', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
+    ( 'morris5_3719', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
+    ( 'ross58_3720', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
+    ( 'michael48_3721', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
+    ( 'brown92_3722', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
+    ( 'morgan54_3723', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
+    ( 'sanders87_3724', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
+    ( 'paul54_3725', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
+    ( 'cooper59_3726', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
+    ( 'brooks16_3727', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
+    ( 'rivera44_3728', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
+    ( 'sanders60_3729', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
+    ( 'morgan82_3730', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
+    ( 'john69_3731', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
+    ( 'david36_3732', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
+    ( 'mike88_3733', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
+    ( 'david9_3734', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
+    ( 'david34_3735', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
+    ( 'sanders20_3736', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
+    ( 'mike22_3737', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
+    ( 'maria63_3738', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
+    ( 'miller94_3739', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
+    ( 'chrishaydon17_3740', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
+    ( 'brooks60_3741', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
+    ( 'bell34_3742', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
+    ( 'chrishaydon65_3743', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
+    ( 'paul70_3744', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
+    ( 'jenny0972_3745', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
+    ( 'john41_3746', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
+    ( 'smith78_3747', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
+    ( 'morgan55_3748', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
+    ( 'smith81_3749', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
+    ( 'jenny0988_3750', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
+    ( 'chrishaydon49_3751', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
+    ( 'morris31_3752', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
+    ( 'mike15_3753', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
+    ( 'smith61_3754', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
+    ( 'chrishaydon42_3755', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
+    ( 'john62_3756', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
+    ( 'maria40_3757', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
+    ( 'james50_3758', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
+    ( 'brown93_3759', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
+    ( 'maria85_3760', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
+    ( 'miller63_3761', 'morgan', 'morris', 'Female', 'ee33d4596af639660986c67f04e6ff92', 1),
+    ( 'smith50_3762', 'brown', 'brown', 'Female', 'bd1e4b23c510e1eae5fa69baa618f3e2', 1),
+    ( 'mike83_3763', 'smith', 'cooper', 'Female', '4a3309bacad462ce5af197a808839446', 1),
+    ( 'mark90_3764', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
+    ( 'morris71_3765', 'rogers', 'sanders', 'Male', 'a7fd9a391ed17cc9be339bcb87af3451', 1),
+    ( 'brown70_3766', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
+    ( 'jenny0930_3767', 'bell', 'rivera', 'Female', 'c25f2f612dde293ec2155a5dfe7901f2', 1),
+    ( 'smith60_3768', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
+    ( 'cooper56_3769', 'ross', 'mike', 'Female', '81b32a932116fed6d09aaa2c2bc522df', 1),
+    ( 'chrishaydon67_3770', 'wright', 'mark', 'Female', 'a4808f35e9eca8fd90326ad5632ff220', 1),
+    ( 'cooper90_3771', 'ross', 'rivera', 'Male', '077380568f1971f1003978046142baeb', 1),
+    ( 'morris82_3772', 'ross', 'miller', 'Female', '8d863a559ac2d9fba0dfa98649e55637', 1),
+    ( 'morgan3_3773', 'sanders', 'mark', 'Male', '4d5a20ef5496dae22ea2bbfa40defc12', 1),
+    ( 'daniel25_3774', 'michael', 'ross', 'Female', '6794c36a5638c0c0df58155493512e60', 1),
+    ( 'rivera64_3775', 'morgan', 'mike', 'Female', '041dcc147d542af1dc6bf50192c1b10f', 1),
+    ( 'bell41_3776', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
+    ( 'rivera34_3777', 'bell', 'paul', 'Male', '7d4a65a26f424093d93857b14104585e', 1),
+    ( 'chrishaydon22_3778', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
+    ( 'miller53_3779', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
+    ( 'miller58_3780', 'sanders', 'paul', 'Male', '3fa66dc95e8f6e524cd5afbf4abfebdf', 1),
+    ( 'wright41_3781', 'smith', 'sanders', 'Female', '7ba55de0a483cd485e1629176c9fa5ac', 1),
+    ( 'john69_3782', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
+    ( 'smith6_3783', 'smith', 'paul', 'Female', '69b7b0f8981d3f462bddcd10e83d73b8', 1),
+    ( 'rogers88_3784', 'miller', 'rivera', 'Male', '18b2b0e6b66ce69949cfd500bdbd547b', 1),
+    ( 'john98_3785', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
+    ( 'paul69_3786', 'john', 'morgan', 'Male', '98bdeac0dbd9377cd342ab3e2ed67002', 1),
+    ( 'morris55_3787', 'wright', 'brown', 'Male', 'e808e39b0369890ca613a24d1bdbe80e', 1),
+    ( 'paul3_3788', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
+    ( 'rogers28_3789', 'brown', 'brown', 'Male', 'cb1b08d86f6053ce02919717671bd81f', 1),
+    ( 'brown16_3790', 'james', 'chrishaydon', 'Female', 'ef70b7e590eb6ef3d09bdb05084610e4', 1),
+    ( 'david68_3791', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
+    ( 'michael8_3792', 'paul', 'morgan', 'Female', '6691fbe0b3d4d260b0ada3cd9c0b78f5', 1),
+    ( 'sanders67_3793', 'brooks', 'paul', 'Male', 'ddf8e1b6ed97cdf30cb35d0f9e47517b', 1),
+    ( 'rivera75_3794', 'brooks', 'brooks', 'Male', 'fb77723ac6a75b2dfa7636213fc12803', 1),
+    ( 'miller81_3795', 'ross', 'david', 'Female', 'e5e1fa5d4c971a980e6d087016507eb7', 1),
+    ( 'jenny0952_3796', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
+    ( 'rivera53_3797', 'bell', 'smith', 'Female', '1e3e64c56121038797a7e50b655464c6', 1),
+    ( 'morgan33_3798', 'wright', 'brown', 'Male', '8e5a808681a0070beaf455c2cabb782b', 1),
+    ( 'ross27_3799', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
+    ( 'miller94_3800', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
+    ( 'michael14_3801', 'paul', 'sanders', 'Female', '9462cebb50643d2a7731b89c5b1b8fe3', 1),
+    ( 'rogers60_3802', 'mark', 'smith', 'Male', 'bef7a008c13dcc9dd7947e5f248a1cfd', 1),
+    ( 'maria50_3803', 'rivera', 'miller', 'Male', '64b7a989ccd1e83d881215cddbeafb72', 1),
+    ( 'morgan96_3804', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
+    ( 'ross49_3805', 'sanders', 'jenny09', 'Female', '53083ad87812f62ccd391f01069e77b5', 1),
+    ( 'wright37_3806', 'miller', 'chrishaydon', 'Male', '0f8b225730e5be91f0f4cbb29156cf44', 1),
+    ( 'brown38_3807', 'ross', 'morgan', 'Male', '07a841d9d548265d67ac8897f60f1b06', 1),
+    ( 'michael50_3808', 'mike', 'rogers', 'Female', 'a366cae9b72e785998b0ce78a2284bdb', 1),
+    ( 'wright93_3809', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
+    ( 'miller91_3810', 'mike', 'chrishaydon', 'Male', 'edb8e845ed0dc8c888f7225fa43eb2a6', 1),
+    ( 'daniel21_3811', 'cooper', 'sanders', 'Female', 'df3e462d93781ea422c125a4c2b5566a', 1),
+    ( 'bell44_3812', 'ross', 'smith', 'Male', 'a49601f3e6a85c0387539bba4bcdbe6c', 1),
+    ( 'brooks58_3813', 'ross', 'john', 'Male', '6e5b2a0375b4d4d500ee07e62cad6d6c', 1),
+    ( 'chrishaydon86_3814', 'sanders', 'david', 'Female', 'c3bf2214f10104ec078bed756539f9af', 1),
+    ( 'jenny0910_3815', 'paul', 'smith', 'Male', '58eea070ea61891bfea5954c38cb9336', 1),
+    ( 'rivera53_3816', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
+    ( 'brown75_3817', 'sanders', 'rivera', 'Female', '7c5cef8a2e387abe6908ee2f3fbc534a', 1),
+    ( 'maria40_3818', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
+    ( 'john12_3819', 'sanders', 'sanders', 'Male', 'cba0f422fbd342f81feb1dd78a43cad6', 1),
+    ( 'sanders88_3820', 'paul', 'miller', 'Male', 'b6153be014b1af1811c85aa770c4cc46', 1),
+    ( 'morris98_3821', 'morgan', 'brooks', 'Female', 'd793df005919fa5c4f45a61a7f9f8e87', 1),
+    ( 'miller17_3822', 'daniel', 'brown', 'Female', 'a11bf4bd7d2f8c8162d97c7447ab07f8', 1),
+    ( 'cooper84_3823', 'miller', 'miller', 'Male', '941752ab63605763d87142453aa35988', 1),
+    ( 'ross49_3824', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
+    ( 'morgan54_3825', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
+    ( 'chrishaydon29_3826', 'wright', 'cooper', 'Female', 'b6ed3804fd6ad8ccd1c85c0a7deda4e3', 1),
+    ( 'paul3_3827', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
+    ( 'morris98_3828', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
+    ( 'cooper55_3829', 'mark', 'ross', 'Female', '6c794b04248c5d6120dd84b8e94967a7', 1),
+    ( 'rivera85_3830', 'brown', 'brooks', 'Female', '0ca4d10e4c787cc061e18feb815f747b', 1),
+    ( 'rogers43_3831', 'jenny09', 'mike', 'Female', 'aa98ad5a318401147dc9580a9e134a7e', 1),
+    ( 'ross42_3832', 'mark', 'michael', 'Female', '1507a0565d69991ff963cd3489b1c491', 1),
+    ( 'james29_3833', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
+    ( 'chrishaydon20_3834', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
+    ( 'wright68_3835', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
+    ( 'cooper47_3836', 'ross', 'paul', 'Male', '524f2968fabb85f0fdb2fa63110f6690', 1),
+    ( 'morgan73_3837', 'chrishaydon', 'cooper', 'Male', '33b7c4b03c90ac30be52d86c3229577b', 1),
+    ( 'maria82_3838', 'daniel', 'daniel', 'Male', '9a83d41058fafd2a50b9fcce02811361', 1),
+    ( 'john8_3839', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
+    ( 'miller63_3840', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
+    ( 'morgan26_3841', 'michael', 'rogers', 'Male', 'e4a653c83b7fa702099b4c780e5fa68d', 1),
+    ( 'rivera69_3842', 'brown', 'david', 'Female', 'b58472e08b58b46875487093c2867ec6', 1),
+    ( 'daniel31_3843', 'john', 'rogers', 'Male', '9d84f6219123fb1920cf2e7494a44d77', 1),
+    ( 'sanders100_3844', 'michael', 'john', 'Male', '349fda3c9d90de7e62c9ada6c0550a8b', 1),
+    ( 'mark84_3845', 'morris', 'chrishaydon', 'Female', '8f9307568ba555323b0599ce0c715b1a', 1),
+    ( 'smith56_3846', 'david', 'paul', 'Male', 'baaf6963e059f92921d196b5fef6669d', 1),
+    ( 'morris93_3847', 'chrishaydon', 'james', 'Female', 'dba13ee70b381ea5b9ceac0ece3d850f', 1),
+    ( 'michael45_3848', 'michael', 'paul', 'Female', 'd9f0344646b6ba7f224d9d3a0986cc7e', 1),
+    ( 'mike88_3849', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
+    ( 'morgan96_3850', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
+    ( 'james5_3851', 'brooks', 'mike', 'Female', 'ac332ec30f36d30d5bf9221fe1b32bf0', 1),
+    ( 'morris83_3852', 'sanders', 'maria', 'Male', 'fcb81feea910ea24117d4f26171991f8', 1),
+    ( 'rivera19_3853', 'rivera', 'bell', 'Female', '0ba1e8782ffcf3e71c079e1e54c0a41c', 1),
+    ( 'daniel57_3854', 'paul', 'bell', 'Female', '62d04b1633be93a4b4f93256a56e28d0', 1),
+    ( 'maria10_3855', 'wright', 'sanders', 'Female', '9c8c23c03a74a7a320d742aa95cf08e3', 1),
+    ( 'david71_3856', 'jenny09', 'david', 'Male', '16a061f8fdf5c93e9e77631dfb7d471a', 1),
+    ( 'sanders88_3857', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
+    ( 'rogers37_3858', 'chrishaydon', 'brown', 'Male', '822141a673303a54b9387d8fc6a0c425', 1),
+    ( 'chrishaydon10_3859', 'chrishaydon', 'john', 'Male', '1b7d631cf551c86b671fd6bc2ec9d0dc', 1),
+    ( 'sanders27_3860', 'bell', 'morris', 'Male', '576352444376fe3069d256ded463666f', 1),
+    ( 'brown17_3861', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
+    ( 'morris86_3862', 'rogers', 'ross', 'Male', '896b690b9566f53a875e03a3a324c091', 1),
+    ( 'mark79_3863', 'bell', 'sanders', 'Male', 'cee8dbd9130bccbe4d945c9cfc41f330', 1),
+    ( 'david58_3864', 'david', 'morgan', 'Female', 'ca391efb9f114371dced52c8eb18a412', 1),
+    ( 'daniel95_3865', 'wright', 'ross', 'Male', '4458808784017babe1bb3ed79c61f0f7', 1),
+    ( 'brooks49_3866', 'sanders', 'morgan', 'Female', 'dbd61cc2c16fa763662c8a29d63215e0', 1),
+    ( 'jenny0926_3867', 'wright', 'david', 'Female', 'd2d4451b918dbb9730bb7e010a50ab60', 1),
+    ( 'bell37_3868', 'morgan', 'john', 'Female', '9019a44759e7bdece498cc5dce570446', 1),
+    ( 'john46_3869', 'miller', 'john', 'Female', 'c618d2bfb0bf88f1de98313820f226a8', 1),
+    ( 'chrishaydon43_3870', 'miller', 'chrishaydon', 'Female', 'e63ca65668b82c9fd07eef6b719ad4aa', 1),
+    ( 'rogers19_3871', 'mike', 'john', 'Male', '59843bfb2ca080a23bcbbc5278fa6982', 1),
+    ( 'brooks29_3872', 'rivera', 'rivera', 'Female', 'f151014abd62636e598dbad4a5b43aa3', 1),
+    ( 'chrishaydon74_3873', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
+    ( 'brooks43_3874', 'morgan', 'cooper', 'Male', '669e54b4368a4a99595fe4a56b9bf6ff', 1),
+    ( 'ross44_3875', 'morgan', 'smith', 'Female', 'a84cf2d984ed2eb7948b9fda85275a7f', 1),
+    ( 'bell63_3876', 'chrishaydon', 'james', 'Male', '863d1292a4a36cdc9134a7f84e8070c9', 1),
+    ( 'rivera41_3877', 'maria', 'mark', 'Male', 'a9dd9815cf33560650fc661a97f41547', 1),
+    ( 'wright17_3878', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
+    ( 'michael15_3879', 'david', 'mark', 'Female', 'a167a5d9cf8157d98d45c1d0e32b72f4', 1),
+    ( 'ross53_3880', 'morris', 'daniel', 'Female', 'b4a6baa51bd47cf407213f6ba9254f71', 1),
+    ( 'cooper65_3881', 'brooks', 'mark', 'Female', '26f039d32dad1e54e8f74b015ea9ec68', 1),
+    ( 'rivera2_3882', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
+    ( 'james32_3883', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
+    ( 'mark97_3884', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
+    ( 'smith93_3885', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
+    ( 'chrishaydon84_3886', 'james', 'brown', 'Male', '5957038b6a3f0a0f305123af998bc437', 1),
+    ( 'smith30_3887', 'maria', 'wright', 'Male', '62d191a19bda44ba011ebd37976b5a23', 1),
+    ( 'maria51_3888', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
+    ( 'rivera52_3889', 'cooper', 'michael', 'Male', 'c15a7058e0c14b26f6863fbd7549a1e7', 1),
+    ( 'chrishaydon48_3890', 'david', 'cooper', 'Female', 'e51d84e0afa82e704755b26fe59f566e', 1),
+    ( 'morgan90_3891', 'mike', 'maria', 'Female', 'e78f1e49da6ebf2fe756ec38b646ba36', 1),
+    ( 'sanders53_3892', 'bell', 'mark', 'Female', '15d2a8035ab8f89a8d2388049cdb1ee9', 1),
+    ( 'john47_3893', 'sanders', 'sanders', 'Female', 'd3945958288a3f00f1d5fe7230670061', 1),
+    ( 'daniel100_3894', 'paul', 'cooper', 'Male', '1ca7a74ac2257ad3c0f5885cb90099f2', 1),
+    ( 'wright13_3895', 'brown', 'mark', 'Male', '35e1bd4961d74f12ed2e2399f219aaa9', 1),
+    ( 'morgan86_3896', 'brown', 'daniel', 'Female', 'ba7d8e424270cfe9f48eb09c99ff68a1', 1),
+    ( 'wright17_3897', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
+    ( 'morris93_3898', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
+    ( 'james40_3899', 'rogers', 'rogers', 'Male', 'b44bc9d82406f9a445889a66b255f271', 1),
+    ( 'ross47_3900', 'sanders', 'chrishaydon', 'Male', '4592e91917d68abf8835aeae27f38cfc', 1),
+    ( 'wright8_3901', 'bell', 'cooper', 'Male', 'e30161a98a0e9e3933dbb856e6af4719', 1),
+    ( 'ross83_3902', 'morris', 'sanders', 'Female', '2ec9470fb5a92621648ad5c708b050f8', 1),
+    ( 'ross9_3903', 'bell', 'sanders', 'Female', '85b8a1781fe0eda5fcdb86d950c2e239', 1),
+    ( 'miller47_3904', 'morris', 'david', 'Female', '2fb2bd61613de4adc3ab2fc7fc05fe88', 1),
+    ( 'smith28_3905', 'brown', 'john', 'Female', '2e3a6218c8c4652563470fd23b5c9004', 1),
+    ( 'morris2_3906', 'bell', 'jenny09', 'Male', '9248ee5ee8d1ff997d0009348e2ea662', 1),
+    ( 'morris4_3907', 'rogers', 'brooks', 'Male', 'd9290c1d84c18de491112fab16fc0172', 1),
+    ( 'jenny0926_3908', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
+    ( 'morgan21_3909', 'john', 'cooper', 'Male', 'c1c1328643f85dca460e8ead1600c650', 1),
+    ( 'david3_3910', 'michael', 'miller', 'Male', '6c5c00ec2728ed2459e821dce4a85349', 1),
+    ( 'ross58_3911', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
+    ( 'jenny0949_3912', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
+    ( 'john96_3913', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
+    ( 'paul5_3914', 'rivera', 'ross', 'Male', '13a3756c2cb7b486e32054a08271d3f1', 1),
+    ( 'sanders80_3915', 'michael', 'james', 'Female', '73f63edc5f6f3b571886fbb1316c43bc', 1),
+    ( 'smith65_3916', 'brooks', 'bell', 'Male', '7ba86a4101f3074f458df9a6e43e3f5a', 1),
+    ( 'rogers26_3917', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
+    ( 'maria43_3918', 'miller', 'bell', 'Male', '0c879f0908f637ebe8cc0db994ae0832', 1),
+    ( 'david99_3919', 'maria', 'jenny09', 'Female', 'b38a8c25edada7483420c20a76b9d4b0', 1),
+    ( 'wright20_3920', 'smith', 'rogers', 'Female', 'e11d269be9b74106223b357b06f2da33', 1),
+    ( 'brooks87_3921', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
+    ( 'mike43_3922', 'morgan', 'ross', 'Male', '024142778439c88b675219780cf1c578', 1),
+    ( 'ross30_3923', 'mark', 'david', 'Male', 'c5fb92d8af4e45581d8bb7c71a5c0a1c', 1),
+    ( 'jenny0981_3924', 'morgan', 'brooks', 'Female', '34ae1e72198b2ccb37610a3269368fa7', 1),
+    ( 'cooper11_3925', 'james', 'james', 'Female', '243cffae81569cca39840aec1e9e2852', 1),
+    ( 'rogers97_3926', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
+    ( 'wright84_3927', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
+    ( 'jenny0932_3928', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
+    ( 'sanders40_3929', 'paul', 'sanders', 'Female', 'be92492fa64d6126726a71584a75a878', 1),
+    ( 'john80_3930', 'daniel', 'ross', 'Female', '8a608ab5ea3865ae688ac2f8b1cc3b61', 1),
+    ( 'brooks58_3931', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
+    ( 'miller37_3932', 'brooks', 'bell', 'Female', '62c1ace1a0aa8e798169f547586457c8', 1),
+    ( 'james93_3933', 'chrishaydon', 'james', 'Male', '7ef00ef6e7f47472db040222dae2dc8e', 1),
+    ( 'bell16_3934', 'bell', 'james', 'Male', '0d5e1a6eab996a7d1d32a0e2ed22ff2a', 1),
+    ( 'smith96_3935', 'mike', 'bell', 'Female', '68f2fb17fd3f13d8a0a394de396ce447', 1),
+    ( 'ross94_3936', 'david', 'bell', 'Male', '84c62bc5b6f1dab11a1ca3d8d7a5e29e', 1),
+    ( 'ross95_3937', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
+    ( 'chrishaydon82_3938', 'jenny09', 'maria', 'Female', '8093f823794b8fa03c379c035300fd0b', 1),
+    ( 'michael3_3939', 'mike', 'jenny09', 'Male', 'fc13f6e30b38a8529a57add8a59b5ec0', 1),
+    ( 'jenny0918_3940', 'rogers', 'brown', 'Male', '8b9e34ac36a2fd947614e2616f3d6826', 1),
+    ( 'rogers43_3941', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
+    ( 'morris91_3942', 'paul', 'rivera', 'Male', '613be5cffa8a578c1816a1baf6bb8501', 1),
+    ( 'john4_3943', 'james', 'rivera', 'Female', '76ebe07cd3c27e769f71251f294d4c94', 1),
+    ( 'cooper5_3944', 'wright', 'morris', 'Male', '8105c71bb32ba7bf731c4162ff9c1f21', 1),
+    ( 'chrishaydon20_3945', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
+    ( 'chrishaydon44_3946', 'mark', 'smith', 'Female', 'a54db1865fb0fa3a66861d93e037741d', 1),
+    ( 'daniel13_3947', 'brooks', 'brown', 'Female', '91270e97491a918496e6569c9fcff808', 1),
+    ( 'chrishaydon37_3948', 'brown', 'mark', 'Male', 'a3efe2fadf9e33423b80532af0f95c33', 1),
+    ( 'david31_3949', 'wright', 'paul', 'Female', 'b8e0691f01b08a810fade049028d9c3e', 1),
+    ( 'mark21_3950', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
+    ( 'wright81_3951', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
+    ( 'michael13_3952', 'morris', 'cooper', 'Female', '9d450e0529ca7328d07679163206f732', 1),
+    ( 'rogers63_3953', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
+    ( 'mike28_3954', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
+    ( 'rivera92_3955', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
+    ( 'ross95_3956', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
+    ( 'paul85_3957', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
+    ( 'smith34_3958', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
+    ( 'james84_3959', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
+    ( 'daniel53_3960', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
+    ( 'brooks80_3961', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
+    ( 'morgan65_3962', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
+    ( 'sanders84_3963', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
+    ( 'maria40_3964', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
+    ( 'brown71_3965', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
+    ( 'james63_3966', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
+    ( 'jenny0993_3967', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
+    ( 'john96_3968', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
+    ( 'miller64_3969', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
+    ( 'mark46_3970', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
+    ( 'jenny0988_3971', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
+    ( 'mark80_3972', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
+    ( 'morris72_3973', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
+    ( 'wright39_3974', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
+    ( 'paul68_3975', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
+    ( 'smith60_3976', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
+    ( 'bell43_3977', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
+    ( 'rogers79_3978', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
+    ( 'daniel56_3979', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
+    ( 'brooks85_3980', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
+    ( 'mike30_3981', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
+    ( 'paul92_3982', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
+    ( 'bell96_3983', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
+    ( 'john8_3984', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
+    ( 'chrishaydon12_3985', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
+    ( 'morgan13_3986', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
+    ( 'james83_3987', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
+    ( 'chrishaydon8_3988', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
+    ( 'ross85_3989', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
+    ( 'ross46_3990', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
+    ( 'smith4_3991', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
+    ( 'paul4_3992', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
+    ( 'daniel26_3993', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
+    ( 'chrishaydon2_3994', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
+    ( 'david82_3995', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
+    ( 'john97_3996', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
+    ( 'david57_3997', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
+    ( 'rivera100_3998', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
+    ( 'bell13_3999', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
+    ( 'brooks65_4000', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
+    ( 'daniel40_4001', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
+    ( 'cooper100_4002', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
+    ( 'morris14_4003', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
+    ( 'smith82_4004', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
+    ( 'cooper35_4005', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
+    ( 'morgan94_4006', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
+    ( 'michael92_4007', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
+    ( 'sanders48_4008', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
+    ( 'brown76_4009', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
+    ( 'james16_4010', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
+    ( 'michael26_4011', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
+    ( 'wright57_4012', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
+    ( 'wright68_4013', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
+    ( 'brooks1_4014', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
+    ( 'bell2_4015', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
+    ( 'miller100_4016', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
+    ( 'rogers53_4017', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
+    ( 'mike1_4018', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
+    ( 'cooper57_4019', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
+    ( 'daniel38_4020', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
+    ( 'mark2_4021', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
+    ( 'daniel79_4022', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
+    ( 'wright4_4023', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
+    ( 'brown84_4024', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
+    ( 'paul41_4025', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
+    ( 'mark5_4026', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
+    ( 'jenny0994_4027', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
+    ( 'morris53_4028', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
+    ( 'paul68_4029', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
+    ( 'brooks86_4030', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
+    ( 'james54_4031', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
+    ( 'rogers58_4032', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
+    ( 'maria31_4033', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
+    ( 'david5_4034', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
+    ( 'mark21_4035', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
+    ( 'jenny0932_4036', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
+    ( 'john92_4037', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
+    ( 'rogers98_4038', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
+    ( 'rogers95_4039', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
+    ( 'james50_4040', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
+    ( 'miller80_4041', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
+    ( 'mark29_4042', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
+    ( 'cooper77_4043', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
+    ( 'john24_4044', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
+    ( 'chrishaydon32_4045', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
+    ( 'bell41_4046', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
+    ( 'ross99_4047', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
+    ( 'smith9_4048', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
+    ( 'miller73_4049', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
+    ( 'michael44_4050', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
+    ( 'michael36_4051', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
+    ( 'smith93_4052', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
+    ( 'morgan38_4053', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
+    ( 'brown70_4054', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
+    ( 'mike14_4055', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
+    ( 'daniel72_4056', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
+    ( 'jenny0974_4057', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
+    ( 'maria51_4058', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
+    ( 'michael97_4059', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
+    ( 'chrishaydon33_4060', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
+    ( 'sanders42_4061', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
+    ( 'brooks87_4062', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
+    ( 'bell4_4063', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
+    ( 'jenny0945_4064', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
+    ( 'james65_4065', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
+    ( 'john98_4066', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
+    ( 'paul80_4067', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
+    ( 'john92_4068', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
+    ( 'wright69_4069', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
+    ( 'bell68_4070', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
+    ( 'brooks97_4071', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
+    ( 'mark14_4072', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
+    ( 'david60_4073', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
+    ( 'rivera68_4074', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
+    ( 'brooks32_4075', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
+    ( 'brown15_4076', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
+    ( 'bell88_4077', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
+    ( 'john26_4078', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
+    ( 'paul55_4079', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
+    ( 'david59_4080', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
+    ( 'morris73_4081', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
+    ( 'michael77_4082', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
+    ( 'mark5_4083', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
+    ( 'james65_4084', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
+    ( 'maria39_4085', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
+    ( 'michael37_4086', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
+    ( 'sanders1_4087', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
+    ( 'daniel30_4088', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
+    ( 'brown53_4089', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
+    ( 'john52_4090', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
+    ( 'wright73_4091', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
+    ( 'mark63_4092', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
+    ( 'james29_4093', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
+    ( 'mark23_4094', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
+    ( 'cooper22_4095', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
+    ( 'miller19_4096', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
+    ( 'jenny0913_4097', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
+    ( 'maria100_4098', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
+    ( 'chrishaydon51_4099', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
+    ( 'david9_4100', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
+    ( 'mike52_4101', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
+    ( 'wright5_4102', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
+    ( 'brown21_4103', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
+    ( 'daniel46_4104', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
+    ( 'brown86_4105', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
+    ( 'chrishaydon85_4106', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
+    ( 'john41_4107', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
+    ( 'mark72_4108', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
+    ( 'michael67_4109', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
+    ( 'jenny0943_4110', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
+    ( 'daniel61_4111', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
+    ( 'morgan34_4112', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
+    ( 'jenny097_4113', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
+    ( 'miller11_4114', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
+    ( 'daniel99_4115', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
+    ( 'miller20_4116', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
+    ( 'sanders29_4117', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
+
+    ( 'mark43_4118', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
+    ( 'james86_4119', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
+    ( 'chrishaydon74_4120', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
+    ( 'brooks37_4121', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
+    ( 'michael78_4122', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
+    ( 'paul9_4123', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
+    ( 'james57_4124', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
+    ( 'mark91_4125', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
+    ( 'wright30_4126', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
+    ( 'rogers97_4127', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
+    ( 'daniel8_4128', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
+    ( 'mark66_4129', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
+    ( 'daniel6_4130', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
+    ( 'bell72_4131', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
+    ( 'morgan77_4132', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
+    ( 'bell24_4133', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
+    ( 'michael31_4134', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
+    ( 'cooper98_4135', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
+    ( 'daniel41_4136', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
+    ( 'michael1_4137', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
+    ( 'chrishaydon20_4138', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
+    ( 'daniel66_4139', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
+    ( 'cooper52_4140', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
+    ( 'chrishaydon99_4141', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
+    ( 'smith66_4142', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
+    ( 'mike48_4143', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
+    ( 'rivera80_4144', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
+    ( 'morgan35_4145', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
+    ( 'brooks16_4146', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
+    ( 'mike78_4147', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
+    ( 'michael38_4148', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
+    ( 'mark7_4149', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
+    ( 'rogers80_4150', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
+    ( 'wright81_4151', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
+    ( 'michael21_4152', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
+    ( 'daniel56_4153', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
+    ( 'ross3_4154', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
+    ( 'chrishaydon72_4155', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
+    ( 'sanders66_4156', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
+    ( 'michael4_4157', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
+    ( 'brown66_4158', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
+    ( 'wright26_4159', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
+    ( 'james81_4160', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
+    ( 'morris21_4161', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
+    ( 'john30_4162', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
+    ( 'jenny0929_4163', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
+    ( 'wright91_4164', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
+    ( 'jenny0922_4165', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
+    ( 'rivera35_4166', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
+    ( 'rogers53_4167', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
+    ( 'ross50_4168', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
+    ( 'ross41_4169', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
+    ( 'rivera78_4170', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
+    ( 'miller43_4171', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
+    ( 'morgan59_4172', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
+    ( 'chrishaydon63_4173', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
+    ( 'wright93_4174', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
+    ( 'maria62_4175', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
+    ( 'mark85_4176', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
+    ( 'wright3_4177', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
+    ( 'brooks37_4178', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
+    ( 'paul66_4179', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
+    ( 'rivera36_4180', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
+    ( 'paul42_4181', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
+    ( 'morgan93_4182', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
+    ( 'rivera43_4183', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
+    ( 'daniel71_4184', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
+    ( 'mike81_4185', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
+    ( 'daniel83_4186', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
+    ( 'smith54_4187', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
+    ( 'sanders38_4188', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
+    ( 'morris63_4189', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
+    ( 'michael25_4190', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
+    ( 'smith89_4191', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
+    ( 'john97_4192', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
+    ( 'jenny0985_4193', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
+    ( 'wright84_4194', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
+    ( 'maria53_4195', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
+    ( 'brooks72_4196', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
+    ( 'brown61_4197', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
+    ( 'paul95_4198', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
+    ( 'brooks92_4199', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
+    ( 'james44_4200', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
+    ( 'chrishaydon100_4201', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
+    ( 'mark97_4202', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
+    ( 'brooks91_4203', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
+    ( 'jenny0929_4204', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
+    ( 'morgan37_4205', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
+    ( 'daniel43_4206', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
+    ( 'mike98_4207', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
+    ( 'john48_4208', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
+    ( 'brooks65_4209', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
+    ( 'cooper18_4210', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
+    ( 'chrishaydon54_4211', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
+    ( 'john67_4212', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
+    ( 'morris27_4213', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
+    ( 'ross45_4214', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
+    ( 'rogers85_4215', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
+    ( 'sanders96_4216', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
+    ( 'smith70_4217', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
+    ( 'wright31_4218', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
+    ( 'paul10_4219', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
+    ( 'sanders2_4220', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
+    ( 'rogers14_4221', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
+    ( 'morgan79_4222', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
+    ( 'morris87_4223', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
+    ( 'james6_4224', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
+    ( 'wright33_4225', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
+    ( 'maria85_4226', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
+    ( 'ross31_4227', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
+    ( 'chrishaydon75_4228', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
+    ( 'david54_4229', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
+    ( 'chrishaydon50_4230', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
+    ( 'miller24_4231', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
+    ( 'maria9_4232', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
+    ( 'smith91_4233', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
+    ( 'rivera11_4234', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
+    ( 'james72_4235', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
+    ( 'miller36_4236', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
+    ( 'jenny0991_4237', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
+    ( 'jenny0959_4238', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
+    ( 'mike41_4239', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
+    ( 'morgan20_4240', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
+    ( 'miller29_4241', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
+    ( 'ross56_4242', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
+    ( 'chrishaydon63_4243', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
+    ( 'morris54_4244', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
+    ( 'bell20_4245', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
+    ( 'paul41_4246', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
+    ( 'morgan42_4247', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
+    ( 'ross100_4248', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
+    ( 'wright1_4249', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
+    ( 'jenny0921_4250', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
+    ( 'paul37_4251', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
+    ( 'michael92_4252', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
+    ( 'chrishaydon14_4253', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
+    ( 'miller40_4254', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
+    ( 'wright43_4255', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
+    ( 'smith15_4256', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
+    ( 'miller49_4257', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
+    ( 'morris21_4258', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
+    ( 'ross27_4259', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
+    ( 'rivera16_4260', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
+    ( 'maria73_4261', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
+    ( 'chrishaydon60_4262', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
+    ( 'david40_4263', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
+    ( 'michael66_4264', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
+    ( 'paul27_4265', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
+    ( 'jenny0911_4266', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
+    ( 'miller79_4267', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
+    ( 'mark14_4268', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
+    ( 'ross87_4269', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
+    ( 'paul47_4270', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
+    ( 'sanders61_4271', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
+    ( 'rivera13_4272', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
+    ( 'chrishaydon22_4273', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
+    ( 'sanders42_4274', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
+    ( 'david78_4275', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
+    ( 'ross60_4276', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
+    ( 'morris24_4277', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
+    ( 'jenny0952_4278', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
+    ( 'rogers72_4279', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
+    ( 'daniel70_4280', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
+    ( 'cooper76_4281', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
+    ( 'jenny0942_4282', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
+    ( 'daniel39_4283', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
+    ( 'morris59_4284', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
+    ( 'mike70_4285', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
+    ( 'rogers20_4286', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
+    ( 'david33_4287', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
+    ( 'morris65_4288', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
+    ( 'michael23_4289', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
+    ( 'john96_4290', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
+    ( 'wright64_4291', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
+    ( 'david91_4292', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
+    ( 'daniel87_4293', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
+    ( 'ross60_4294', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
+    ( 'morris60_4295', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
+    ( 'rivera50_4296', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
+    ( 'maria77_4297', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
+    ( 'morgan64_4298', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
+    ( 'miller41_4299', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
+    ( 'david65_4300', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
+    ( 'morgan96_4301', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
+    ( 'ross73_4302', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
+    ( 'brooks95_4303', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
+    ( 'sanders92_4304', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
+    ( 'cooper53_4305', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
+    ( 'ross100_4306', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
+    ( 'michael91_4307', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
+    ( 'brooks51_4308', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
+    ( 'ross26_4309', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
+    ( 'morris58_4310', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
+    ( 'morris58_4311', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
+    ( 'ross12_4312', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
+    ( 'brooks60_4313', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
+    ( 'mark29_4314', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
+    ( 'john14_4315', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
+    ( 'rivera26_4316', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
+    ( 'jenny0949_4317', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
+    ( 'rogers96_4318', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
+    ( 'david55_4319', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
+    ( 'chrishaydon3_4320', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
+    ( 'ross81_4321', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
+    ( 'john70_4322', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
+    ( 'miller34_4323', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
+    ( 'bell26_4324', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
+    ( 'ross92_4325', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
+    ( 'rogers34_4326', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
+    ( 'james51_4327', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
+    ( 'ross54_4328', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
+    ( 'brown59_4329', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
+    ( 'michael83_4330', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
+    ( 'miller10_4331', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
+    ( 'jenny0939_4332', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
+    ( 'paul100_4333', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
+    ( 'smith82_4334', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
+    ( 'morris6_4335', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
+    ( 'paul76_4336', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
+    ( 'mark97_4337', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
+    ( 'john74_4338', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
+    ( 'miller78_4339', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
+    ( 'miller54_4340', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
+    ( 'chrishaydon9_4341', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
+    ( 'daniel4_4342', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
+    ( 'john24_4343', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
+    ( 'smith31_4344', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
+    ( 'smith77_4345', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
+    ( 'maria29_4346', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
+    ( 'michael76_4347', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
+    ( 'mark64_4348', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
+    ( 'morris5_4349', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
+    ( 'brown69_4350', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
+    ( 'miller53_4351', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
+    ( 'wright94_4352', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
+    ( 'rogers26_4353', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
+    ( 'jenny0977_4354', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
+    ( 'daniel23_4355', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
+    ( 'cooper66_4356', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
+    ( 'chrishaydon61_4357', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
+    ( 'maria95_4358', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
+    ( 'james17_4359', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
+    ( 'james18_4360', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
+    ( 'mike47_4361', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
+    ( 'john57_4362', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
+    ( 'mark54_4363', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
+    ( 'bell38_4364', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
+    ( 'morgan31_4365', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
+    ( 'miller16_4366', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
+    ( 'rivera14_4367', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
+    ( 'rogers22_4368', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
+    ( 'jenny0963_4369', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
+    ( 'mark42_4370', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
+    ( 'mark7_4371', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
+    ( 'john1_4372', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
+    ( 'chrishaydon3_4373', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
+    ( 'smith11_4374', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
+    ( 'john22_4375', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
+    ( 'rivera23_4376', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
+    ( 'james32_4377', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
+    ( 'daniel58_4378', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
+    ( 'james18_4379', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
+    ( 'brown93_4380', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
+    ( 'bell89_4381', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
+    ( 'miller82_4382', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
+    ( 'paul21_4383', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
+    ( 'chrishaydon88_4384', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
+    ( 'rivera44_4385', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
+    ( 'bell89_4386', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
+    ( 'bell66_4387', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
+    ( 'rogers31_4388', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
+    ( 'bell47_4389', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
+    ( 'sanders58_4390', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
+    ( 'mark26_4391', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
+    ( 'miller22_4392', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
+    ( 'daniel46_4393', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
+    ( 'morgan100_4394', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
+    ( 'ross75_4395', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
+    ( 'david68_4396', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
+    ( 'rivera35_4397', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
+    ( 'daniel63_4398', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
+    ( 'ross37_4399', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
+    ( 'michael23_4400', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
+    ( 'mike48_4401', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
+    ( 'david57_4402', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
+    ( 'morris67_4403', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
+    ( 'rogers49_4404', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
+    ( 'morris14_4405', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
+    ( 'brooks74_4406', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
+    ( 'mike71_4407', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
+    ( 'mark18_4408', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
+    ( 'mark9_4409', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
+    ( 'brooks21_4410', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
+    ( 'bell4_4411', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
+    ( 'brown17_4412', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
+    ( 'jenny0923_4413', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
+    ( 'smith66_4414', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
+    ( 'daniel53_4415', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
+    ( 'brown33_4416', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
+    ( 'mark55_4417', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
+    ( 'rivera83_4418', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
+    ( 'paul72_4419', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
+    ( 'david25_4420', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
+    ( 'paul47_4421', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
+    ( 'james61_4422', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
+    ( 'paul90_4423', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
+    ( 'david95_4424', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
+    ( 'paul52_4425', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
+    ( 'wright82_4426', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
+    ( 'james61_4427', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
+    ( 'michael89_4428', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
+    ( 'wright44_4429', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
+    ( 'chrishaydon60_4430', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
+    ( 'john30_4431', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
+    ( 'rivera2_4432', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
+    ( 'wright17_4433', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
+    ( 'rivera30_4434', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
+    ( 'daniel63_4435', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
+    ( 'brooks56_4436', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
+    ( 'wright29_4437', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
+    ( 'morris52_4438', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
+    ( 'morgan10_4439', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
+    ( 'sanders55_4440', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
+    ( 'mike9_4441', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
+    ( 'michael69_4442', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
+    ( 'david18_4443', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
+    ( 'daniel41_4444', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
+    ( 'david59_4445', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
+    ( 'daniel17_4446', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
+    ( 'john45_4447', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
+    ( 'morgan17_4448', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
+    ( 'john42_4449', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
+    ( 'rogers50_4450', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
+    ( 'smith35_4451', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
+    ( 'mark56_4452', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
+    ( 'chrishaydon12_4453', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
+    ( 'mark90_4454', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
+    ( 'maria85_4455', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
+    ( 'rogers42_4456', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
+    ( 'brooks95_4457', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
+    ( 'brooks38_4458', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
+    ( 'morris49_4459', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
+    ( 'david86_4460', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
+    ( 'wright15_4461', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
+    ( 'wright75_4462', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
+    ( 'sanders78_4463', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
+    ( 'jenny092_4464', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
+    ( 'cooper68_4465', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
+    ( 'paul87_4466', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
+    ( 'brooks59_4467', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
+    ( 'rivera36_4468', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
+    ( 'cooper25_4469', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
+    ( 'mike3_4470', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
+    ( 'rivera24_4471', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
+    ( 'wright89_4472', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
+    ( 'cooper12_4473', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
+    ( 'paul32_4474', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
+    ( 'wright11_4475', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
+    ( 'maria51_4476', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
+    ( 'morris85_4477', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
+    ( 'chrishaydon88_4478', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
+    ( 'brown49_4479', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
+    ( 'mike55_4480', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
+    ( 'paul6_4481', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
+    ( 'james19_4482', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
+    ( 'brown35_4483', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
+    ( 'chrishaydon15_4484', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
+    ( 'jenny0962_4485', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
+    ( 'brooks57_4486', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
+    ( 'chrishaydon7_4487', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
+    ( 'morgan15_4488', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
+    ( 'chrishaydon47_4489', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
+    ( 'brooks41_4490', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
+    ( 'mark61_4491', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
+    ( 'brooks82_4492', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
+    ( 'maria6_4493', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
+    ( 'brown2_4494', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
+    ( 'maria94_4495', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
+    ( 'smith95_4496', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
+    ( 'ross3_4497', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
+    ( 'smith24_4498', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
+    ( 'john19_4499', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
+    ( 'michael93_4500', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
+    ( 'bell35_4501', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
+    ( 'david80_4502', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
+    ( 'paul3_4503', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
+    ( 'michael27_4504', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
+    ( 'smith12_4505', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
+    ( 'maria61_4506', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
+    ( 'daniel3_4507', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
+    ( 'jenny0923_4508', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
+    ( 'morris5_4509', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
+    ( 'ross58_4510', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
+    ( 'michael48_4511', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
+    ( 'brown92_4512', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
+    ( 'morgan54_4513', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
+    ( 'sanders87_4514', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
+    ( 'paul54_4515', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
+    ( 'cooper59_4516', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
+    ( 'brooks16_4517', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
+    ( 'rivera44_4518', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
+    ( 'sanders60_4519', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
+    ( 'morgan82_4520', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
+    ( 'john69_4521', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
+    ( 'david36_4522', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
+    ( 'mike88_4523', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
+    ( 'david9_4524', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
+    ( 'david34_4525', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
+    ( 'sanders20_4526', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
+    ( 'mike22_4527', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
+    ( 'maria63_4528', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
+    ( 'miller94_4529', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
+    ( 'chrishaydon17_4530', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
+    ( 'brooks60_4531', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
+    ( 'bell34_4532', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
+    ( 'chrishaydon65_4533', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
+    ( 'paul70_4534', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
+    ( 'jenny0972_4535', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
+    ( 'john41_4536', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
+    ( 'smith78_4537', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
+    ( 'morgan55_4538', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
+    ( 'smith81_4539', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
+    ( 'jenny0988_4540', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
+    ( 'chrishaydon49_4541', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
+    ( 'morris31_4542', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
+    ( 'mike15_4543', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
+    ( 'smith61_4544', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
+    ( 'chrishaydon42_4545', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
+    ( 'john62_4546', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
+    ( 'maria40_4547', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
+    ( 'james50_4548', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
+    ( 'brown93_4549', 'wright', 'brown', 'Female', 'cdd6bf95eefe26b43fed49f64e635536', 1),
+    ( 'maria85_4550', 'david', 'miller', 'Female', '53a677bd77675fbc69c160f8c01f8c63', 1),
+    ( 'miller63_4551', 'morgan', 'morris', 'Female', 'ee33d4596af639660986c67f04e6ff92', 1),
+    ( 'smith50_4552', 'brown', 'brown', 'Female', 'bd1e4b23c510e1eae5fa69baa618f3e2', 1),
+    ( 'mike83_4553', 'smith', 'cooper', 'Female', '4a3309bacad462ce5af197a808839446', 1),
+    ( 'mark90_4554', 'chrishaydon', 'rivera', 'Female', '2572dd23b663effeed3c1cbe4e5e061b', 1),
+    ( 'morris71_4555', 'rogers', 'sanders', 'Male', 'a7fd9a391ed17cc9be339bcb87af3451', 1),
+    ( 'brown70_4556', 'mark', 'smith', 'Female', '7a7bb74f2a0a42146251f5f83344e9b6', 1),
+    ( 'jenny0930_4557', 'bell', 'rivera', 'Female', 'c25f2f612dde293ec2155a5dfe7901f2', 1),
+    ( 'smith60_4558', 'brown', 'maria', 'Male', '5e264d9345fda43338b21a91cd7635ff', 1),
+    ( 'cooper56_4559', 'ross', 'mike', 'Female', '81b32a932116fed6d09aaa2c2bc522df', 1),
+    ( 'chrishaydon67_4560', 'wright', 'mark', 'Female', 'a4808f35e9eca8fd90326ad5632ff220', 1),
+    ( 'cooper90_4561', 'ross', 'rivera', 'Male', '077380568f1971f1003978046142baeb', 1),
+    ( 'morris82_4562', 'ross', 'miller', 'Female', '8d863a559ac2d9fba0dfa98649e55637', 1),
+    ( 'morgan3_4563', 'sanders', 'mark', 'Male', '4d5a20ef5496dae22ea2bbfa40defc12', 1),
+    ( 'daniel25_4564', 'michael', 'ross', 'Female', '6794c36a5638c0c0df58155493512e60', 1),
+    ( 'rivera64_4565', 'morgan', 'mike', 'Female', '041dcc147d542af1dc6bf50192c1b10f', 1),
+    ( 'bell41_4566', 'smith', 'david', 'Female', '51ac771526c1909f0763cae561568011', 1),
+    ( 'rivera34_4567', 'bell', 'paul', 'Male', '7d4a65a26f424093d93857b14104585e', 1),
+    ( 'chrishaydon22_4568', 'daniel', 'rivera', 'Female', 'a6baf41f2eff1bf85db674924bc6bd59', 1),
+    ( 'miller53_4569', 'chrishaydon', 'ross', 'Male', '66dd352fd071e1e6f62d5dcc49733bb8', 1),
+    ( 'miller58_4570', 'sanders', 'paul', 'Male', '3fa66dc95e8f6e524cd5afbf4abfebdf', 1),
+    ( 'wright41_4571', 'smith', 'sanders', 'Female', '7ba55de0a483cd485e1629176c9fa5ac', 1),
+    ( 'john69_4572', 'rivera', 'mark', 'Female', 'c7d56827d1e68c192e6536dfa6d3277f', 1),
+    ( 'smith6_4573', 'smith', 'paul', 'Female', '69b7b0f8981d3f462bddcd10e83d73b8', 1),
+    ( 'rogers88_4574', 'miller', 'rivera', 'Male', '18b2b0e6b66ce69949cfd500bdbd547b', 1),
+    ( 'john98_4575', 'paul', 'mike', 'Male', 'c28c9df8f6748527700855dfd42526fe', 1),
+    ( 'paul69_4576', 'john', 'morgan', 'Male', '98bdeac0dbd9377cd342ab3e2ed67002', 1),
+    ( 'morris55_4577', 'wright', 'brown', 'Male', 'e808e39b0369890ca613a24d1bdbe80e', 1),
+    ( 'paul3_4578', 'rogers', 'mike', 'Female', 'd3c4d55d22641d9ba073fe8bfa9afdf7', 1),
+    ( 'rogers28_4579', 'brown', 'brown', 'Male', 'cb1b08d86f6053ce02919717671bd81f', 1),
+    ( 'brown16_4580', 'james', 'chrishaydon', 'Female', 'ef70b7e590eb6ef3d09bdb05084610e4', 1),
+    ( 'david68_4581', 'maria', 'mike', 'Male', '07a09583bb41fc5bb599299eca9a2fac', 1),
+    ( 'michael8_4582', 'paul', 'morgan', 'Female', '6691fbe0b3d4d260b0ada3cd9c0b78f5', 1),
+    ( 'sanders67_4583', 'brooks', 'paul', 'Male', 'ddf8e1b6ed97cdf30cb35d0f9e47517b', 1),
+    ( 'rivera75_4584', 'brooks', 'brooks', 'Male', 'fb77723ac6a75b2dfa7636213fc12803', 1),
+    ( 'miller81_4585', 'ross', 'david', 'Female', 'e5e1fa5d4c971a980e6d087016507eb7', 1),
+    ( 'jenny0952_4586', 'john', 'james', 'Male', '9f2040c62794e66ad032f119ff5d7ea7', 1),
+    ( 'rivera53_4587', 'bell', 'smith', 'Female', '1e3e64c56121038797a7e50b655464c6', 1),
+    ( 'morgan33_4588', 'wright', 'brown', 'Male', '8e5a808681a0070beaf455c2cabb782b', 1),
+    ( 'ross27_4589', 'james', 'john', 'Male', 'e1a8a0870aa50a2b1b0bdaa89af2ce82', 1),
+    ( 'miller94_4590', 'ross', 'morris', 'Female', 'c486388be0e406cf7629842e6739edf1', 1),
+    ( 'michael14_4591', 'paul', 'sanders', 'Female', '9462cebb50643d2a7731b89c5b1b8fe3', 1),
+    ( 'rogers60_4592', 'mark', 'smith', 'Male', 'bef7a008c13dcc9dd7947e5f248a1cfd', 1),
+    ( 'maria50_4593', 'rivera', 'miller', 'Male', '64b7a989ccd1e83d881215cddbeafb72', 1),
+    ( 'morgan96_4594', 'jenny09', 'mike', 'Male', '037e1ffb2901a910fe15ab0996acfe69', 1),
+    ( 'ross49_4595', 'sanders', 'jenny09', 'Female', '53083ad87812f62ccd391f01069e77b5', 1),
+    ( 'wright37_4596', 'miller', 'chrishaydon', 'Male', '0f8b225730e5be91f0f4cbb29156cf44', 1),
+    ( 'brown38_4597', 'ross', 'morgan', 'Male', '07a841d9d548265d67ac8897f60f1b06', 1),
+    ( 'michael50_4598', 'mike', 'rogers', 'Female', 'a366cae9b72e785998b0ce78a2284bdb', 1),
+    ( 'wright93_4599', 'morris', 'miller', 'Male', '811453fc8bf155d36303f9786f5509bf', 1),
+    ( 'miller91_4600', 'mike', 'chrishaydon', 'Male', 'edb8e845ed0dc8c888f7225fa43eb2a6', 1),
+    ( 'daniel21_4601', 'cooper', 'sanders', 'Female', 'df3e462d93781ea422c125a4c2b5566a', 1),
+    ( 'bell44_4602', 'ross', 'smith', 'Male', 'a49601f3e6a85c0387539bba4bcdbe6c', 1),
+    ( 'brooks58_4603', 'ross', 'john', 'Male', '6e5b2a0375b4d4d500ee07e62cad6d6c', 1),
+    ( 'chrishaydon86_4604', 'sanders', 'david', 'Female', 'c3bf2214f10104ec078bed756539f9af', 1),
+    ( 'jenny0910_4605', 'paul', 'smith', 'Male', '58eea070ea61891bfea5954c38cb9336', 1),
+    ( 'rivera53_4606', 'rogers', 'sanders', 'Female', 'ded6dcf5db7b79b5a94f17741a66b784', 1),
+    ( 'brown75_4607', 'sanders', 'rivera', 'Female', '7c5cef8a2e387abe6908ee2f3fbc534a', 1),
+    ( 'maria40_4608', 'james', 'rivera', 'Female', 'b34d0b57f3616ffebd3d38d5e8ebe129', 1),
+    ( 'john12_4609', 'sanders', 'sanders', 'Male', 'cba0f422fbd342f81feb1dd78a43cad6', 1),
+    ( 'sanders88_4610', 'paul', 'miller', 'Male', 'b6153be014b1af1811c85aa770c4cc46', 1),
+    ( 'morris98_4611', 'morgan', 'brooks', 'Female', 'd793df005919fa5c4f45a61a7f9f8e87', 1),
+    ( 'miller17_4612', 'daniel', 'brown', 'Female', 'a11bf4bd7d2f8c8162d97c7447ab07f8', 1),
+    ( 'cooper84_4613', 'miller', 'miller', 'Male', '941752ab63605763d87142453aa35988', 1),
+    ( 'ross49_4614', 'james', 'david', 'Male', '69a7777261dc890a0f976f902fdd2733', 1),
+    ( 'morgan54_4615', 'rivera', 'john', 'Female', '9e96760c00dea107bb73d28be3e9d145', 1),
+    ( 'chrishaydon29_4616', 'wright', 'cooper', 'Female', 'b6ed3804fd6ad8ccd1c85c0a7deda4e3', 1),
+    ( 'paul3_4617', 'wright', 'sanders', 'Female', 'ba55eb1bbb84696302c2029e411cb8ce', 1),
+    ( 'morris98_4618', 'brooks', 'mark', 'Female', '8aa647cb40624f377f4f1acef7b8c0e0', 1),
+    ( 'cooper55_4619', 'mark', 'ross', 'Female', '6c794b04248c5d6120dd84b8e94967a7', 1),
+    ( 'rivera85_4620', 'brown', 'brooks', 'Female', '0ca4d10e4c787cc061e18feb815f747b', 1),
+    ( 'rogers43_4621', 'jenny09', 'mike', 'Female', 'aa98ad5a318401147dc9580a9e134a7e', 1),
+    ( 'ross42_4622', 'mark', 'michael', 'Female', '1507a0565d69991ff963cd3489b1c491', 1),
+    ( 'james29_4623', 'john', 'maria', 'Female', '13b7b7adbcbc7c3ca5c0a75c2df6672e', 1),
+    ( 'chrishaydon20_4624', 'david', 'mike', 'Female', '46201dc2e9a3039d2d4edd12392d3e56', 1),
+    ( 'wright68_4625', 'rivera', 'morgan', 'Male', '3f781c34c198414b5d4758460caa481e', 1),
+    ( 'cooper47_4626', 'ross', 'paul', 'Male', '524f2968fabb85f0fdb2fa63110f6690', 1),
+    ( 'morgan73_4627', 'chrishaydon', 'cooper', 'Male', '33b7c4b03c90ac30be52d86c3229577b', 1),
+    ( 'maria82_4628', 'daniel', 'daniel', 'Male', '9a83d41058fafd2a50b9fcce02811361', 1),
+    ( 'john8_4629', 'brown', 'miller', 'Male', '43e1ed4b5c293a5f00ccd16017fc5bcb', 1),
+    ( 'miller63_4630', 'smith', 'chrishaydon', 'Female', '5b0dacd32827f2e1a7775b031a981ece', 1),
+    ( 'morgan26_4631', 'michael', 'rogers', 'Male', 'e4a653c83b7fa702099b4c780e5fa68d', 1),
+    ( 'rivera69_4632', 'brown', 'david', 'Female', 'b58472e08b58b46875487093c2867ec6', 1),
+    ( 'daniel31_4633', 'john', 'rogers', 'Male', '9d84f6219123fb1920cf2e7494a44d77', 1),
+    ( 'sanders100_4634', 'michael', 'john', 'Male', '349fda3c9d90de7e62c9ada6c0550a8b', 1),
+    ( 'mark84_4635', 'morris', 'chrishaydon', 'Female', '8f9307568ba555323b0599ce0c715b1a', 1),
+    ( 'smith56_4636', 'david', 'paul', 'Male', 'baaf6963e059f92921d196b5fef6669d', 1),
+    ( 'morris93_4637', 'chrishaydon', 'james', 'Female', 'dba13ee70b381ea5b9ceac0ece3d850f', 1),
+    ( 'michael45_4638', 'michael', 'paul', 'Female', 'd9f0344646b6ba7f224d9d3a0986cc7e', 1),
+    ( 'mike88_4639', 'daniel', 'morris', 'Female', 'b301535af6fdd8c35e49e6a740e7c647', 1),
+    ( 'morgan96_4640', 'brown', 'brooks', 'Female', 'e6ae2ac29778633f57dd2b2e131aefe2', 1),
+    ( 'james5_4641', 'brooks', 'mike', 'Female', 'ac332ec30f36d30d5bf9221fe1b32bf0', 1),
+    ( 'morris83_4642', 'sanders', 'maria', 'Male', 'fcb81feea910ea24117d4f26171991f8', 1),
+    ( 'rivera19_4643', 'rivera', 'bell', 'Female', '0ba1e8782ffcf3e71c079e1e54c0a41c', 1),
+    ( 'daniel57_4644', 'paul', 'bell', 'Female', '62d04b1633be93a4b4f93256a56e28d0', 1),
+    ( 'maria10_4645', 'wright', 'sanders', 'Female', '9c8c23c03a74a7a320d742aa95cf08e3', 1),
+    ( 'david71_4646', 'jenny09', 'david', 'Male', '16a061f8fdf5c93e9e77631dfb7d471a', 1),
+    ( 'sanders88_4647', 'jenny09', 'rivera', 'Male', 'e45df09552a41cfbba27c834c797792e', 1),
+    ( 'rogers37_4648', 'chrishaydon', 'brown', 'Male', '822141a673303a54b9387d8fc6a0c425', 1),
+    ( 'chrishaydon10_4649', 'chrishaydon', 'john', 'Male', '1b7d631cf551c86b671fd6bc2ec9d0dc', 1),
+    ( 'sanders27_4650', 'bell', 'morris', 'Male', '576352444376fe3069d256ded463666f', 1),
+    ( 'brown17_4651', 'daniel', 'ross', 'Female', '62db5968dbc97952709f7c45328a5acb', 1),
+    ( 'morris86_4652', 'rogers', 'ross', 'Male', '896b690b9566f53a875e03a3a324c091', 1),
+    ( 'mark79_4653', 'bell', 'sanders', 'Male', 'cee8dbd9130bccbe4d945c9cfc41f330', 1),
+    ( 'david58_4654', 'david', 'morgan', 'Female', 'ca391efb9f114371dced52c8eb18a412', 1),
+    ( 'daniel95_4655', 'wright', 'ross', 'Male', '4458808784017babe1bb3ed79c61f0f7', 1),
+    ( 'brooks49_4656', 'sanders', 'morgan', 'Female', 'dbd61cc2c16fa763662c8a29d63215e0', 1),
+    ( 'jenny0926_4657', 'wright', 'david', 'Female', 'd2d4451b918dbb9730bb7e010a50ab60', 1),
+    ( 'bell37_4658', 'morgan', 'john', 'Female', '9019a44759e7bdece498cc5dce570446', 1),
+    ( 'john46_4659', 'miller', 'john', 'Female', 'c618d2bfb0bf88f1de98313820f226a8', 1),
+    ( 'chrishaydon43_4660', 'miller', 'chrishaydon', 'Female', 'e63ca65668b82c9fd07eef6b719ad4aa', 1),
+    ( 'rogers19_4661', 'mike', 'john', 'Male', '59843bfb2ca080a23bcbbc5278fa6982', 1),
+    ( 'brooks29_4662', 'rivera', 'rivera', 'Female', 'f151014abd62636e598dbad4a5b43aa3', 1),
+    ( 'chrishaydon74_4663', 'morgan', 'mike', 'Female', '51d098dcc39f68b26feb79e6dfff6eec', 1),
+    ( 'brooks43_4664', 'morgan', 'cooper', 'Male', '669e54b4368a4a99595fe4a56b9bf6ff', 1),
+    ( 'ross44_4665', 'morgan', 'smith', 'Female', 'a84cf2d984ed2eb7948b9fda85275a7f', 1),
+    ( 'bell63_4666', 'chrishaydon', 'james', 'Male', '863d1292a4a36cdc9134a7f84e8070c9', 1),
+    ( 'rivera41_4667', 'maria', 'mark', 'Male', 'a9dd9815cf33560650fc661a97f41547', 1),
+    ( 'wright17_4668', 'smith', 'wright', 'Female', 'dbda8f25d0b2e3b1d3712ac08963fadb', 1),
+    ( 'michael15_4669', 'david', 'mark', 'Female', 'a167a5d9cf8157d98d45c1d0e32b72f4', 1),
+    ( 'ross53_4670', 'morris', 'daniel', 'Female', 'b4a6baa51bd47cf407213f6ba9254f71', 1),
+    ( 'cooper65_4671', 'brooks', 'mark', 'Female', '26f039d32dad1e54e8f74b015ea9ec68', 1),
+    ( 'rivera2_4672', 'sanders', 'ross', 'Male', 'e05e1e9bcf08d91b18aad54fd807fdf9', 1),
+    ( 'james32_4673', 'mark', 'rogers', 'Female', '3dd6cfec510eae9cb9951c5099c97701', 1),
+    ( 'mark97_4674', 'mike', 'paul', 'Female', '5b6f24c9e1e6cbd55c1e60863efdf263', 1),
+    ( 'smith93_4675', 'chrishaydon', 'mark', 'Female', 'efe02c4fc3cfeb4b883d2836de89927e', 1),
+    ( 'chrishaydon84_4676', 'james', 'brown', 'Male', '5957038b6a3f0a0f305123af998bc437', 1),
+    ( 'smith30_4677', 'maria', 'wright', 'Male', '62d191a19bda44ba011ebd37976b5a23', 1),
+    ( 'maria51_4678', 'wright', 'morgan', 'Male', '7d6cbe2c2340af8e492647890dbbe2cc', 1),
+    ( 'rivera52_4679', 'cooper', 'michael', 'Male', 'c15a7058e0c14b26f6863fbd7549a1e7', 1),
+    ( 'chrishaydon48_4680', 'david', 'cooper', 'Female', 'e51d84e0afa82e704755b26fe59f566e', 1),
+    ( 'morgan90_4681', 'mike', 'maria', 'Female', 'e78f1e49da6ebf2fe756ec38b646ba36', 1),
+    ( 'sanders53_4682', 'bell', 'mark', 'Female', '15d2a8035ab8f89a8d2388049cdb1ee9', 1),
+    ( 'john47_4683', 'sanders', 'sanders', 'Female', 'd3945958288a3f00f1d5fe7230670061', 1),
+    ( 'daniel100_4684', 'paul', 'cooper', 'Male', '1ca7a74ac2257ad3c0f5885cb90099f2', 1),
+    ( 'wright13_4685', 'brown', 'mark', 'Male', '35e1bd4961d74f12ed2e2399f219aaa9', 1),
+    ( 'morgan86_4686', 'brown', 'daniel', 'Female', 'ba7d8e424270cfe9f48eb09c99ff68a1', 1),
+    ( 'wright17_4687', 'james', 'rogers', 'Female', '3dc5cc06467053d6dfc1e4003741d47c', 1),
+    ( 'morris93_4688', 'john', 'john', 'Female', '88e44c67c9e05074cdfbf7fd5b51a0fa', 1),
+    ( 'james40_4689', 'rogers', 'rogers', 'Male', 'b44bc9d82406f9a445889a66b255f271', 1),
+    ( 'ross47_4690', 'sanders', 'chrishaydon', 'Male', '4592e91917d68abf8835aeae27f38cfc', 1),
+    ( 'wright8_4691', 'bell', 'cooper', 'Male', 'e30161a98a0e9e3933dbb856e6af4719', 1),
+    ( 'ross83_4692', 'morris', 'sanders', 'Female', '2ec9470fb5a92621648ad5c708b050f8', 1),
+    ( 'ross9_4693', 'bell', 'sanders', 'Female', '85b8a1781fe0eda5fcdb86d950c2e239', 1),
+    ( 'miller47_4694', 'morris', 'david', 'Female', '2fb2bd61613de4adc3ab2fc7fc05fe88', 1),
+    ( 'smith28_4695', 'brown', 'john', 'Female', '2e3a6218c8c4652563470fd23b5c9004', 1),
+    ( 'morris2_4696', 'bell', 'jenny09', 'Male', '9248ee5ee8d1ff997d0009348e2ea662', 1),
+    ( 'morris4_4697', 'rogers', 'brooks', 'Male', 'd9290c1d84c18de491112fab16fc0172', 1),
+    ( 'jenny0926_4698', 'rogers', 'john', 'Male', '3f95015a079c4d7d56054cd34942e8af', 1),
+    ( 'morgan21_4699', 'john', 'cooper', 'Male', 'c1c1328643f85dca460e8ead1600c650', 1),
+    ( 'david3_4700', 'michael', 'miller', 'Male', '6c5c00ec2728ed2459e821dce4a85349', 1),
+    ( 'ross58_4701', 'michael', 'wright', 'Male', '781cc5259d185a84cf5d480903aa448a', 1),
+    ( 'jenny0949_4702', 'david', 'mark', 'Male', '4c4caf98369d8e9a144a5621415d911b', 1),
+
+    ( 'john96_4703', 'mark', 'david', 'Male', '139cba23ae7508b3b61869bda95f0886', 1),
+    ( 'paul5_4704', 'rivera', 'ross', 'Male', '13a3756c2cb7b486e32054a08271d3f1', 1),
+    ( 'sanders80_4705', 'michael', 'james', 'Female', '73f63edc5f6f3b571886fbb1316c43bc', 1),
+    ( 'smith65_4706', 'brooks', 'bell', 'Male', '7ba86a4101f3074f458df9a6e43e3f5a', 1),
+    ( 'rogers26_4707', 'cooper', 'sanders', 'Female', '39ad9a9ee4d57a2d150f8b7b2b058bba', 1),
+    ( 'maria43_4708', 'miller', 'bell', 'Male', '0c879f0908f637ebe8cc0db994ae0832', 1),
+    ( 'david99_4709', 'maria', 'jenny09', 'Female', 'b38a8c25edada7483420c20a76b9d4b0', 1),
+    ( 'wright20_4710', 'smith', 'rogers', 'Female', 'e11d269be9b74106223b357b06f2da33', 1),
+    ( 'brooks87_4711', 'mark', 'maria', 'Male', '39048e4bf8923b66c03e9f38c6e4cf0a', 1),
+    ( 'mike43_4712', 'morgan', 'ross', 'Male', '024142778439c88b675219780cf1c578', 1),
+    ( 'ross30_4713', 'mark', 'david', 'Male', 'c5fb92d8af4e45581d8bb7c71a5c0a1c', 1),
+    ( 'jenny0981_4714', 'morgan', 'brooks', 'Female', '34ae1e72198b2ccb37610a3269368fa7', 1),
+    ( 'cooper11_4715', 'james', 'james', 'Female', '243cffae81569cca39840aec1e9e2852', 1),
+    ( 'rogers97_4716', 'rivera', 'cooper', 'Female', '2c1b0008658b163a08e7392d26f15241', 1),
+    ( 'wright84_4717', 'james', 'morgan', 'Female', '161471c50b1c2a75957ba66acf8da9f5', 1),
+    ( 'jenny0932_4718', 'paul', 'morgan', 'Male', '50285433069b9faf53c900cd2642fa9d', 1),
+    ( 'sanders40_4719', 'paul', 'sanders', 'Female', 'be92492fa64d6126726a71584a75a878', 1),
+    ( 'john80_4720', 'daniel', 'ross', 'Female', '8a608ab5ea3865ae688ac2f8b1cc3b61', 1),
+    ( 'brooks58_4721', 'david', 'chrishaydon', 'Male', 'abcac734158a5d228b0597afccc907a3', 1),
+    ( 'miller37_4722', 'brooks', 'bell', 'Female', '62c1ace1a0aa8e798169f547586457c8', 1),
+    ( 'james93_4723', 'chrishaydon', 'james', 'Male', '7ef00ef6e7f47472db040222dae2dc8e', 1),
+    ( 'bell16_4724', 'bell', 'james', 'Male', '0d5e1a6eab996a7d1d32a0e2ed22ff2a', 1),
+    ( 'smith96_4725', 'mike', 'bell', 'Female', '68f2fb17fd3f13d8a0a394de396ce447', 1),
+    ( 'ross94_4726', 'david', 'bell', 'Male', '84c62bc5b6f1dab11a1ca3d8d7a5e29e', 1),
+    ( 'ross95_4727', 'jenny09', 'sanders', 'Female', 'ce0ea990586cc636eac7051b80d52eae', 1),
+    ( 'chrishaydon82_4728', 'jenny09', 'maria', 'Female', '8093f823794b8fa03c379c035300fd0b', 1),
+    ( 'michael3_4729', 'mike', 'jenny09', 'Male', 'fc13f6e30b38a8529a57add8a59b5ec0', 1),
+    ( 'jenny0918_4730', 'rogers', 'brown', 'Male', '8b9e34ac36a2fd947614e2616f3d6826', 1),
+    ( 'rogers43_4731', 'james', 'brown', 'Female', '0ed13b523813a089363aa1eb5e86fe46', 1),
+    ( 'morris91_4732', 'paul', 'rivera', 'Male', '613be5cffa8a578c1816a1baf6bb8501', 1),
+    ( 'john4_4733', 'james', 'rivera', 'Female', '76ebe07cd3c27e769f71251f294d4c94', 1),
+    ( 'cooper5_4734', 'wright', 'morris', 'Male', '8105c71bb32ba7bf731c4162ff9c1f21', 1),
+    ( 'chrishaydon20_4735', 'rogers', 'wright', 'Male', '107d0e7f6bde9964ed99fff7b38df718', 1),
+    ( 'chrishaydon44_4736', 'mark', 'smith', 'Female', 'a54db1865fb0fa3a66861d93e037741d', 1),
+    ( 'daniel13_4737', 'brooks', 'brown', 'Female', '91270e97491a918496e6569c9fcff808', 1),
+    ( 'chrishaydon37_4738', 'brown', 'mark', 'Male', 'a3efe2fadf9e33423b80532af0f95c33', 1),
+    ( 'david31_4739', 'wright', 'paul', 'Female', 'b8e0691f01b08a810fade049028d9c3e', 1),
+    ( 'mark21_4740', 'daniel', 'smith', 'Male', 'edb756698a3383f440dc36d2a412d864', 1),
+    ( 'wright81_4741', 'mike', 'maria', 'Male', '9e24be3c24ab1a4617986bce579f04f2', 1),
+    ( 'michael13_4742', 'morris', 'cooper', 'Female', '9d450e0529ca7328d07679163206f732', 1),
+    ( 'rogers63_4743', 'david', 'john', 'Female', 'e6a33eee180b07e563d74fee8c2c66b8', 1),
+    ( 'mike28_4744', 'rogers', 'paul', 'Male', '2e7dc6b8a1598f4f75c3eaa47958ee2f', 1),
+    ( 'rivera92_4745', 'david', 'john', 'Male', '1c3a8e03f448d211904161a6f5849b68', 1),
+    ( 'ross95_4746', 'maria', 'sanders', 'Male', '62f0a68a4179c5cdd997189760cbcf18', 1),
+    ( 'paul85_4747', 'morris', 'miller', 'Female', '61bd060b07bddfecccea56a82b850ecf', 1),
+    ( 'smith34_4748', 'daniel', 'michael', 'Female', '7055b3d9f5cb2829c26cd7e0e601cde5', 1),
+    ( 'james84_4749', 'sanders', 'paul', 'Female', 'b7f72d6eb92b45458020748c8d1a3573', 1),
+    ( 'daniel53_4750', 'mark', 'mike', 'Male', '299cbf7171ad1b2967408ed200b4e26c', 1),
+    ( 'brooks80_4751', 'morgan', 'maria', 'Female', 'aa736a35dc15934d67c0a999dccff8f6', 1),
+    ( 'morgan65_4752', 'paul', 'miller', 'Female', 'a28dca31f5aa5792e1cefd1dfd098569', 1),
+    ( 'sanders84_4753', 'david', 'miller', 'Female', '0629e4f9f0e01e6f20bc2066175e09f7', 1),
+    ( 'maria40_4754', 'chrishaydon', 'bell', 'Female', '17f286a78c74db7ee24374c608a2f20c', 1),
+    ( 'brown71_4755', 'michael', 'brown', 'Male', 'fa0c46cc4339a8a51a7da1b33e9d2831', 1),
+    ( 'james63_4756', 'morgan', 'james', 'Male', 'b945416fa907fac533d94efe1974ec07', 1),
+    ( 'jenny0993_4757', 'rogers', 'chrishaydon', 'Female', '388823cb9249d4cebc9d677a99e1d79d', 1),
+    ( 'john96_4758', 'morgan', 'wright', 'Male', 'd0bb977705c3cdad1e346c898f32a1b7', 1),
+    ( 'miller64_4759', 'morgan', 'wright', 'Male', '58b207ee33794b046511203967c8e0d7', 1),
+    ( 'mark46_4760', 'david', 'ross', 'Female', '21cdcb68a932871524e16680fac72e18', 1),
+    ( 'jenny0988_4761', 'maria', 'morgan', 'Female', 'ec9ed18ae2a13fef709964af24bb60e6', 1),
+    ( 'mark80_4762', 'mike', 'bell', 'Male', '084489b355edd349bca1c798788de19a', 1),
+    ( 'morris72_4763', 'miller', 'michael', 'Male', 'bdb047eb9ea511052fc690a8ac72a7d3', 1),
+    ( 'wright39_4764', 'ross', 'rogers', 'Female', '1b6859df2da2a416c5b0fa044b1c6a75', 1),
+    ( 'paul68_4765', 'brooks', 'mike', 'Male', '12d836bf64839f987338414ccbec657f', 1),
+    ( 'smith60_4766', 'miller', 'daniel', 'Male', '494610644518624d05e2bdc8b9df3c36', 1),
+    ( 'bell43_4767', 'mike', 'wright', 'Male', '2bd4e16a15f5527cb43282ee0ef94619', 1),
+    ( 'rogers79_4768', 'wright', 'smith', 'Female', '4df306580eed9e0758a759e8c54cc0d7', 1),
+    ( 'daniel56_4769', 'david', 'morgan', 'Male', 'c374aac91fe75e5ca9d4d46351c90291', 1),
+    ( 'brooks85_4770', 'smith', 'bell', 'Female', '5160256831bf840f1d0af550dce108cf', 1),
+    ( 'mike30_4771', 'paul', 'wright', 'Female', '44cd7d4f05cd775b99d2f68b169d2764', 1),
+    ( 'paul92_4772', 'michael', 'james', 'Female', '06a8728ad70c4ba4d298650d6f68d62c', 1),
+    ( 'bell96_4773', 'michael', 'sanders', 'Female', 'da77805fb5b220853e9ee1a888ea4870', 1),
+    ( 'john8_4774', 'john', 'rivera', 'Female', '8f4eedbae6486c91521dcc9e2e746978', 1),
+    ( 'chrishaydon12_4775', 'paul', 'michael', 'Male', '341f71ff99f299c10b7bd10bb0ffd5c0', 1),
+    ( 'morgan13_4776', 'ross', 'mark', 'Female', '8f9ecff6d4562e1f2d344f753c0d540e', 1),
+    ( 'james83_4777', 'brooks', 'smith', 'Female', '4180a37ebe6c56665ecc0c09f97749bc', 1),
+    ( 'chrishaydon8_4778', 'cooper', 'brown', 'Female', '48655cff7595c40da5309e9ed6c41095', 1),
+    ( 'ross85_4779', 'ross', 'daniel', 'Male', 'a2088dbb45598312937f9c2b39d76b6b', 1),
+    ( 'ross46_4780', 'cooper', 'miller', 'Male', 'ccbffd8ac04c96f4a19b8987221f389c', 1),
+    ( 'smith4_4781', 'jenny09', 'maria', 'Female', '61210cd033e05eefd7904582f42bd9f3', 1),
+    ( 'paul4_4782', 'paul', 'rivera', 'Female', '1f76110a33d9fe38bffcbd6d6dd49a29', 1),
+    ( 'daniel26_4783', 'maria', 'sanders', 'Male', 'c2b161779bf8f62752b8cdcfeabcb952', 1),
+    ( 'chrishaydon2_4784', 'bell', 'david', 'Female', 'aae5b1e30f985f2f6eedc4eec8dd2de8', 1),
+    ( 'david82_4785', 'rivera', 'cooper', 'Male', '10752c85ab371579e5744ecce8b8dfc0', 1),
+    ( 'john97_4786', 'mark', 'david', 'Female', '8eb2c044f3d3215c910973fded3718f9', 1),
+    ( 'david57_4787', 'paul', 'cooper', 'Male', '218a9c83939355cb9b81036857412d7f', 1),
+    ( 'rivera100_4788', 'brooks', 'david', 'Male', 'eefc9091a99e39015b020af27c2e80a6', 1),
+    ( 'bell13_4789', 'james', 'maria', 'Male', '90687b869096ea955b55a88a55b0b982', 1),
+    ( 'brooks65_4790', 'john', 'mark', 'Female', 'ac3a36b10fad8f53b5b0a3d5c4aab9de', 1),
+    ( 'daniel40_4791', 'rivera', 'jenny09', 'Female', '25c8261763223229a55949b9cbaac0c6', 1),
+    ( 'cooper100_4792', 'chrishaydon', 'sanders', 'Female', '9b86a2c6fa37f5842c75dcb6aa43453d', 1),
+    ( 'morris14_4793', 'bell', 'david', 'Female', '1b8e375c5826da045b4b80cbeaffb281', 1),
+    ( 'smith82_4794', 'morris', 'brooks', 'Female', '8f9459d4946b4025c0fc92a319f62769', 1),
+    ( 'cooper35_4795', 'cooper', 'mark', 'Male', 'b87551b47f0515089a0e6c197a0524c7', 1),
+    ( 'morgan94_4796', 'james', 'brooks', 'Male', '6cd7ed7e8f66ed1154abfe390c18b271', 1),
+    ( 'michael92_4797', 'brooks', 'morris', 'Male', 'c6e7402e9de6381fd6ee0936ae304bd4', 1),
+    ( 'sanders48_4798', 'morgan', 'sanders', 'Female', '1606ebcb8b02982109e5a9ad6817d93c', 1),
+    ( 'brown76_4799', 'rivera', 'cooper', 'Female', '45903192c7e1eae93463b4881aaf3d3e', 1),
+    ( 'james16_4800', 'bell', 'john', 'Female', '2b3f531f9940613c33217c4756844069', 1),
+    ( 'michael26_4801', 'wright', 'brown', 'Male', '3c86daac8f13d18f3da5f0fef72d2d41', 1),
+    ( 'wright57_4802', 'wright', 'sanders', 'Male', 'b6b283c151b7c2f8bd6307867fac6207', 1),
+    ( 'wright68_4803', 'smith', 'michael', 'Female', 'b6d7044f51097af805a29408ab2aa895', 1),
+    ( 'brooks1_4804', 'bell', 'rivera', 'Male', '87037e26aacc077d41d83f8d6c91a95c', 1),
+    ( 'bell2_4805', 'rivera', 'david', 'Female', '0479c8271fb4dbe47106570c92abbb74', 1),
+    ( 'miller100_4806', 'brooks', 'wright', 'Male', '39e5cddf9d6fe5c39d348b5e2d45c07d', 1),
+    ( 'rogers53_4807', 'chrishaydon', 'brown', 'Male', '0377bf6ebd9bacfbe96a492c532f0e3b', 1),
+    ( 'mike1_4808', 'michael', 'sanders', 'Male', 'b9ff9aa4450707644faf5cf872a57f41', 1),
+    ( 'cooper57_4809', 'daniel', 'mark', 'Female', 'adab67243e70ed8d0938696ba1dfdabe', 1),
+    ( 'daniel38_4810', 'bell', 'michael', 'Male', '753bd83042af00c1af6af82ae4236726', 1),
+    ( 'mark2_4811', 'brown', 'bell', 'Female', '5160c711eb1a1fb416cb296cfa30d3c6', 1),
+    ( 'daniel79_4812', 'rogers', 'john', 'Male', '97dbce061c4488e48613a6d66e57c1e1', 1),
+    ( 'wright4_4813', 'paul', 'smith', 'Female', 'be2fb6743dd0c143427d6fdbb61d82ab', 1),
+    ( 'brown84_4814', 'john', 'ross', 'Male', '738cb4da81a2790a9a845f902a811ea2', 1),
+    ( 'paul41_4815', 'wright', 'brooks', 'Male', '3ce24a34ab204d82e12e60e205ff5ede', 1),
+    ( 'mark5_4816', 'brooks', 'brown', 'Male', '751933d2077ded39b30aac68060b71c5', 1),
+    ( 'jenny0994_4817', 'brown', 'morgan', 'Male', '59bb0aea62b70ddc63832302636c713c', 1),
+    ( 'morris53_4818', 'chrishaydon', 'brown', 'Male', '422bc412471dd80dc4f174c2d9a7e021', 1),
+    ( 'paul68_4819', 'mark', 'smith', 'Female', '313afaad7095a093eea942a0da8398ee', 1),
+    ( 'brooks86_4820', 'brooks', 'ross', 'Male', '73bbba08c3776debd5837a2c0dfe1e8b', 1),
+    ( 'james54_4821', 'jenny09', 'morris', 'Male', '7f686fb7a9ba33dfee86197c127365f5', 1),
+    ( 'rogers58_4822', 'morgan', 'maria', 'Female', 'f1b9d20083738141fb8c72c4d3364b4f', 1),
+    ( 'maria31_4823', 'rogers', 'brooks', 'Male', '328bb700b7eee8e5cbb15839243d327b', 1),
+    ( 'david5_4824', 'rivera', 'brown', 'Male', '14ab3096cfe6e150a56280c789e6e1e1', 1),
+    ( 'mark21_4825', 'wright', 'maria', 'Female', '442eff629cdd5657580d8c6205050e19', 1),
+    ( 'jenny0932_4826', 'mike', 'brooks', 'Female', 'a45d934a95f56a43ad85752800cfa859', 1),
+    ( 'john92_4827', 'sanders', 'mike', 'Male', 'b945d691d0ffe06cb8a6a520119a90ef', 1),
+    ( 'rogers98_4828', 'james', 'jenny09', 'Male', '79c89f1132cc08e88456b035f12d0097', 1),
+    ( 'rogers95_4829', 'jenny09', 'bell', 'Female', 'f318e4c186ab19e3d3d3591a2e075d03', 1),
+    ( 'james50_4830', 'chrishaydon', 'mark', 'Male', 'ef650493f25a16d7f4ef206cd5354f9f', 1),
+    ( 'miller80_4831', 'sanders', 'chrishaydon', 'Male', '8d0027ca30d88ad9a9880d35174919d9', 1),
+    ( 'mark29_4832', 'bell', 'paul', 'Female', '21698003655695103412c11ffe08a118', 1),
+    ( 'cooper77_4833', 'michael', 'maria', 'Male', '101faf06bcf8140ead914fbe116c941a', 1),
+    ( 'john24_4834', 'brown', 'paul', 'Male', '93a5fe6210bfcdb573ccd348e19e6a56', 1),
+    ( 'chrishaydon32_4835', 'john', 'ross', 'Female', '5c6f05dfb66be73f1a6e8e48fabcfe44', 1),
+    ( 'bell41_4836', 'morris', 'chrishaydon', 'Female', 'd5273c01c17187153a1e725d27d51034', 1),
+    ( 'ross99_4837', 'wright', 'brown', 'Male', '2b27aec5a1caf4d613a8eb8154560f49', 1),
+    ( 'smith9_4838', 'miller', 'morris', 'Male', '97ee0765b9c05d35b53769a3c4133b13', 1),
+    ( 'miller73_4839', 'chrishaydon', 'morgan', 'Male', '6c4283471ace6b4af590c180bd13b1bf', 1),
+    ( 'michael44_4840', 'cooper', 'maria', 'Female', 'dd4d053a12a3d8450166dba9177bac2c', 1),
+    ( 'michael36_4841', 'miller', 'cooper', 'Female', '36ab21ccb2a64acd5351bbb59753df9d', 1),
+    ( 'smith93_4842', 'bell', 'mike', 'Female', '8fbfdb81391ef264ae8b0df7e7e91d25', 1),
+    ( 'morgan38_4843', 'michael', 'wright', 'Male', '7fe5e229f17d1c7f98af6229bd33549b', 1),
+    ( 'brown70_4844', 'brown', 'daniel', 'Male', '80925ec8958ba5847ff2b28ec00daabb', 1),
+    ( 'mike14_4845', 'brown', 'jenny09', 'Male', 'a44db187c1c09c5872dc847ffb672e24', 1),
+    ( 'daniel72_4846', 'jenny09', 'rogers', 'Female', 'f2e51aec5731a5069e6631ae84bc86de', 1),
+    ( 'jenny0974_4847', 'ross', 'mark', 'Male', '997f99ffe068d1e4a1e6afbf872b64af', 1),
+    ( 'maria51_4848', 'rogers', 'ross', 'Male', 'ba22dcb1ad6c9240781fc6b29dcf90a8', 1),
+    ( 'michael97_4849', 'jenny09', 'morgan', 'Female', 'b3f1d9569d684ad845e82ad322aff039', 1),
+    ( 'chrishaydon33_4850', 'cooper', 'brown', 'Male', '6e097d46239427a59e93864cd22651bb', 1),
+    ( 'sanders42_4851', 'david', 'david', 'Female', 'c746602f44b9b51f87e8ca6c6ce4d4df', 1),
+    ( 'brooks87_4852', 'michael', 'wright', 'Male', '3b3d93f0c198684f4b48c026aeb798c5', 1),
+    ( 'bell4_4853', 'smith', 'cooper', 'Male', 'e50e309e6a683b1cbcf31f99b1290a9d', 1),
+    ( 'jenny0945_4854', 'bell', 'sanders', 'Male', 'eb04440b1c92d35d4466a2c164fce1fb', 1),
+    ( 'james65_4855', 'ross', 'ross', 'Female', 'a6dd8b5321189009e29fb9065371ddd0', 1),
+    ( 'john98_4856', 'morris', 'paul', 'Male', 'fe5d5050aefb9bd316b4304df5f5eb2b', 1),
+    ( 'paul80_4857', 'morgan', 'david', 'Male', 'e888d54c6981b4e7e92bbd874655a3bf', 1),
+    ( 'john92_4858', 'brown', 'jenny09', 'Female', '59624207640e3eb1c3a25caaa0d387c0', 1),
+    ( 'wright69_4859', 'david', 'chrishaydon', 'Male', 'b4ceea9331194b6884885396b2fa9ab9', 1),
+    ( 'bell68_4860', 'daniel', 'bell', 'Female', '7a47747a891fd8e6cfa1b5e13f6bd305', 1),
+    ( 'brooks97_4861', 'maria', 'cooper', 'Male', '59cd3d27cf79ce5d0f0e722e02ababcb', 1),
+    ( 'mark14_4862', 'cooper', 'wright', 'Female', '72c558cfdd0b27b021ed0c326655b419', 1),
+    ( 'david60_4863', 'john', 'bell', 'Female', 'daf7f99e70cc6bd895635ab8117f906c', 1),
+    ( 'rivera68_4864', 'miller', 'brooks', 'Female', '6ce20ee0cd25f2265f9c839747f3f60a', 1),
+    ( 'brooks32_4865', 'mark', 'brown', 'Male', '1d3d6cb6ad2d65a22f7202ee48687192', 1),
+    ( 'brown15_4866', 'wright', 'michael', 'Female', 'bfec76c4b0f279fb5fa947cdea45634c', 1),
+    ( 'bell88_4867', 'ross', 'brooks', 'Male', 'e4e8ae68e6791cce2718301e3e806417', 1),
+    ( 'john26_4868', 'brown', 'miller', 'Female', 'af2717f20db66dcc1069529d8470e03c', 1),
+    ( 'paul55_4869', 'morris', 'morgan', 'Male', 'f71110684519ea4c9997ee181344ca5b', 1),
+    ( 'david59_4870', 'rogers', 'morris', 'Male', '4123d932f75f7093e9f7be9dd4d8531c', 1),
+    ( 'morris73_4871', 'sanders', 'david', 'Male', '0955de128fd73fa725f8fb63dddb2b02', 1),
+    ( 'michael77_4872', 'john', 'brooks', 'Female', '0a23e450ab5659abde2283c4bbc629b5', 1),
+    ( 'mark5_4873', 'james', 'john', 'Male', 'ef5e4a71b458f98799d282a6954c9895', 1),
+    ( 'james65_4874', 'smith', 'paul', 'Male', '84bd8c4ac8f11f1fefdf519f372783a4', 1),
+    ( 'maria39_4875', 'miller', 'chrishaydon', 'Female', '91f576e83d57c336e54b6fc73ea2bfbf', 1),
+    ( 'michael37_4876', 'jenny09', 'rogers', 'Female', '74911c4886c6ad901dd8f3083ea7d008', 1),
+    ( 'sanders1_4877', 'morgan', 'maria', 'Female', '186e155dd946be048c37dc8f2e7eed7e', 1),
+    ( 'daniel30_4878', 'mike', 'morgan', 'Female', 'e41a85a3b3313519146041378173534e', 1),
+    ( 'brown53_4879', 'mike', 'sanders', 'Male', '3a5871a13dc27be7d72e82bed22580b2', 1),
+    ( 'john52_4880', 'morgan', 'maria', 'Female', 'bf4c4513b084ba0aa915dc428e2f5aa1', 1),
+    ( 'wright73_4881', 'rivera', 'rivera', 'Male', '4ee2e5750afd3933bf890098f6f15778', 1),
+    ( 'mark63_4882', 'daniel', 'morgan', 'Female', '3279caba4dbd1aebc014e13103454ad0', 1),
+    ( 'james29_4883', 'mark', 'mike', 'Male', '7d5eb23481c4dde548aa4d5b439007b5', 1),
+    ( 'mark23_4884', 'cooper', 'sanders', 'Male', '26e4b55f45b1589b8f79d3e207c68b0b', 1),
+    ( 'cooper22_4885', 'john', 'brooks', 'Male', 'db4f42f1500cbb9918558d9d7ec02ee7', 1),
+    ( 'miller19_4886', 'michael', 'brown', 'Male', 'a88e080d2d0a0caf75fb9df08a09b223', 1),
+    ( 'jenny0913_4887', 'brown', 'mark', 'Female', 'edb17fda48abe064968e3823daf2406e', 1),
+    ( 'maria100_4888', 'michael', 'james', 'Male', '528b3dcb48d9ade25eead37020925cf7', 1),
+    ( 'chrishaydon51_4889', 'daniel', 'morgan', 'Male', '51f2f1f0edfc42ea487cc60c1a8b2235', 1),
+    ( 'david9_4890', 'sanders', 'wright', 'Male', '117993f0e0796f188e658c1ae73f54e0', 1),
+    ( 'mike52_4891', 'bell', 'mark', 'Male', '049aaf70feca52840f5f645cc392997b', 1),
+    ( 'wright5_4892', 'sanders', 'mike', 'Female', '82c719790b9f9265313ca1e5f71be710', 1),
+    ( 'brown21_4893', 'bell', 'paul', 'Female', 'a42bd7f0e498cb3fa3fcce569aa72cb2', 1),
+    ( 'daniel46_4894', 'wright', 'maria', 'Female', '935dec640caf2b4d58749d288277937b', 1),
+    ( 'brown86_4895', 'rivera', 'morgan', 'Female', 'be56de82935f6c8fe2fe3a5fdc7c89d6', 1),
+    ( 'chrishaydon85_4896', 'mike', 'brown', 'Male', '3894408d593726ee2ff44b61b678ee68', 1),
+    ( 'john41_4897', 'brown', 'james', 'Male', '416e74d1a0155b8027abb780304d418e', 1),
+    ( 'mark72_4898', 'brooks', 'maria', 'Female', '6865af4cedf1b219b1f2e65cb4f1b9bf', 1),
+    ( 'michael67_4899', 'jenny09', 'rivera', 'Male', '8d10c08cbeefcf018eb92a009ec8aad1', 1),
+    ( 'jenny0943_4900', 'john', 'miller', 'Male', 'f919eb50f03a7f34d754291da70276e1', 1),
+    ( 'daniel61_4901', 'john', 'ross', 'Male', 'b1b1f0fe2064aa6d5d84498f57ebfccc', 1),
+    ( 'morgan34_4902', 'morris', 'john', 'Female', '604faa08103f852cf9d6aaeb98315a23', 1),
+    ( 'jenny097_4903', 'jenny09', 'smith', 'Female', '3454a779083ac3cb6aa60350341187f7', 1),
+    ( 'miller11_4904', 'jenny09', 'ross', 'Female', '6256f4a433a1073717389415d8722ff6', 1),
+    ( 'daniel99_4905', 'bell', 'morris', 'Female', 'e221f8e93dd0b68bfebe9dff53a85b5a', 1),
+    ( 'miller20_4906', 'michael', 'wright', 'Male', 'c2b80bc3a042354b4cc3793d6b1c3b3b', 1),
+    ( 'sanders29_4907', 'paul', 'michael', 'Female', 'd2950033ad75a63b2aaf909256c9efac', 1),
+    ( 'mark43_4908', 'mark', 'john', 'Male', '3f8172ec318a44eb0307e9e45615b579', 1),
+    ( 'james86_4909', 'morris', 'paul', 'Male', 'ae34b9c97686915640a636783b6cecea', 1),
+    ( 'chrishaydon74_4910', 'john', 'rogers', 'Male', '8c2ea9755dd593fce7d8e241d50bde58', 1),
+    ( 'brooks37_4911', 'daniel', 'maria', 'Female', 'd4648e8fa2eef67d21c468d85b061da9', 1),
+    ( 'michael78_4912', 'paul', 'cooper', 'Female', '15d36cdc2526c1ed9b64730b640b7d58', 1),
+    ( 'paul9_4913', 'mark', 'john', 'Male', '9d8c5d4a08ffd9ef258b086c7ccea3cc', 1),
+    ( 'james57_4914', 'cooper', 'morris', 'Female', '6ab6dd47d785163089c40bb9bf7f1564', 1),
+    ( 'mark91_4915', 'james', 'smith', 'Male', 'cf0eff977e087b674b8a49c87d14c916', 1),
+    ( 'wright30_4916', 'paul', 'rivera', 'Male', 'd5865a905e7e012256d86d12a1d4b023', 1),
+    ( 'rogers97_4917', 'brooks', 'ross', 'Male', 'f65862683e163fb7177b2496bd9df34f', 1),
+    ( 'daniel8_4918', 'james', 'rivera', 'Male', 'aed8f7681ae44f6aa9898c5716f5bcc9', 1),
+    ( 'mark66_4919', 'david', 'jenny09', 'Male', '303dd369979dbcb94fa0cd5783f53e0a', 1),
+    ( 'daniel6_4920', 'john', 'mike', 'Male', '958ceedfce1a1abaac93614a33a6ea55', 1),
+    ( 'bell72_4921', 'morris', 'jenny09', 'Male', '45c9bd3537ce1e0da7fc2bfe8301d601', 1),
+    ( 'morgan77_4922', 'david', 'mark', 'Female', 'ee140015e05be0264b24afedda71ca8b', 1),
+    ( 'bell24_4923', 'cooper', 'brooks', 'Male', 'ce1d4ec89996ac1454c4c32e1ea0e8eb', 1),
+    ( 'michael31_4924', 'cooper', 'jenny09', 'Female', 'de6eb39870bf46a4630bc3f35de80865', 1),
+    ( 'cooper98_4925', 'mike', 'brooks', 'Female', '083e4eb6f1f0afcd0d7b490b6570bbcb', 1),
+    ( 'daniel41_4926', 'bell', 'mark', 'Female', '029dbe991eb002148795377a745539a5', 1),
+    ( 'michael1_4927', 'bell', 'michael', 'Male', 'ca67281974023f68939bab6fff9c7775', 1),
+    ( 'chrishaydon20_4928', 'daniel', 'morris', 'Male', '0d11594096725a315584de6912918368', 1),
+    ( 'daniel66_4929', 'david', 'chrishaydon', 'Female', '40eb7d974a510189a9384a7e1878d1a2', 1),
+    ( 'cooper52_4930', 'smith', 'wright', 'Male', 'da29e66a9e8c1b89cde015ff151043bb', 1),
+    ( 'chrishaydon99_4931', 'ross', 'smith', 'Male', 'c641d1a255542bbcfa30b3daa34d6cda', 1),
+    ( 'smith66_4932', 'ross', 'james', 'Female', '1514dce3cead18e9e8784cd2b773feb0', 1),
+    ( 'mike48_4933', 'miller', 'chrishaydon', 'Female', '946833a02a91ede4d2e02002131296ab', 1),
+    ( 'rivera80_4934', 'michael', 'morris', 'Female', 'b13b2e2b309f5d0142d408bf2df0bd28', 1),
+    ( 'morgan35_4935', 'wright', 'brown', 'Female', '4dcd4fc0ab8d58437c6c6b04e054a2e9', 1),
+    ( 'brooks16_4936', 'michael', 'paul', 'Male', 'cb7dfca585a7404664aa6b31e9ea2940', 1),
+    ( 'mike78_4937', 'morgan', 'wright', 'Male', '17e39e780901de9d84538e7b2f0377a4', 1),
+    ( 'michael38_4938', 'david', 'daniel', 'Male', 'f34ab0578614889981fcfba9d8841da9', 1),
+    ( 'mark7_4939', 'cooper', 'morgan', 'Female', 'd2695e31deb9f73db3deeb7a2ae4fee8', 1),
+    ( 'rogers80_4940', 'mark', 'morgan', 'Male', 'e5f6a02bfd722c079093bdf30229d771', 1),
+    ( 'wright81_4941', 'john', 'brown', 'Male', 'e1021d43911ca2c1845910d84f40aeae', 1),
+    ( 'michael21_4942', 'wright', 'mark', 'Female', 'f289dc0b3863e4e762ee8b462a4ac20e', 1),
+    ( 'daniel56_4943', 'rogers', 'bell', 'Male', '89978e486ff3e020498757df87ecb956', 1),
+    ( 'ross3_4944', 'maria', 'morris', 'Female', '06edca508a58f3817a74183dc4fef1c7', 1),
+    ( 'chrishaydon72_4945', 'sanders', 'morgan', 'Female', '3a03e0b57f61d84609f777acaac77701', 1),
+    ( 'sanders66_4946', 'brooks', 'jenny09', 'Male', '2c0ac07e37743d6b8e8daba5dad9ae06', 1),
+    ( 'michael4_4947', 'wright', 'morris', 'Female', 'fb90312df1c22489a6c25b2ab67bf3fc', 1),
+    ( 'brown66_4948', 'bell', 'mark', 'Female', '77cb9a330118fa84d027315c0740df1c', 1),
+    ( 'wright26_4949', 'morgan', 'cooper', 'Male', 'e8c529514ec002b6bb1ce139adc2eaf8', 1),
+    ( 'james81_4950', 'daniel', 'brown', 'Female', '6ce961f58c6f12943531080a444f570d', 1),
+    ( 'morris21_4951', 'brooks', 'bell', 'Male', 'cdb7e38db356ab50ea8aafe200cd0546', 1),
+    ( 'john30_4952', 'chrishaydon', 'mike', 'Female', 'ae52474ed8e9e1d9e234af0b55060b77', 1),
+    ( 'jenny0929_4953', 'james', 'sanders', 'Female', 'a2d20e79c149ce7094f7ca9ee31c0d96', 1),
+    ( 'wright91_4954', 'michael', 'wright', 'Female', '87858af2834c88fed342f1860ed5381f', 1),
+    ( 'jenny0922_4955', 'miller', 'mike', 'Female', 'a0ee06f687e848307c45b271b56783c1', 1),
+    ( 'rivera35_4956', 'david', 'chrishaydon', 'Male', 'df5ff0bd5d70fe4c9efcada4fbbcda6b', 1),
+    ( 'rogers53_4957', 'wright', 'david', 'Male', 'a8e7bf81e2bc2a1832617ebaa73df373', 1),
+    ( 'ross50_4958', 'brooks', 'miller', 'Female', '8cfc508e7de78fa38ceee7ad63200be6', 1),
+    ( 'ross41_4959', 'rivera', 'rivera', 'Female', 'bf8567fb971c2375c10e79d185d8dae9', 1),
+    ( 'rivera78_4960', 'maria', 'john', 'Male', '060e5652d07a67751f480b8f221d7a6e', 1),
+    ( 'miller43_4961', 'paul', 'michael', 'Male', '9c492d1d3938663f2efbea7d2d865ae0', 1),
+    ( 'morgan59_4962', 'brown', 'paul', 'Male', 'a07ba9c1dcd19e02d382aaf90245d111', 1),
+    ( 'chrishaydon63_4963', 'mike', 'michael', 'Female', '90f0dd703b569ccb9170b300a3c3cd78', 1),
+    ( 'wright93_4964', 'daniel', 'daniel', 'Male', '8099bed8b4ea601446f87cce34bc5d8d', 1),
+    ( 'maria62_4965', 'bell', 'sanders', 'Male', 'b1b5dc761bb978100b9eaf043b12d4fc', 1),
+    ( 'mark85_4966', 'miller', 'morris', 'Male', '471e4f18cdd74e04e79f9ca51319e4d5', 1),
+    ( 'wright3_4967', 'john', 'james', 'Male', '37635df24f31807e825bff951a048a8a', 1),
+    ( 'brooks37_4968', 'james', 'wright', 'Female', '6c19e0a4c6303acdf0356cfcc1ba5bba', 1),
+    ( 'paul66_4969', 'bell', 'paul', 'Male', 'f182dd452d8dff40f53669fa3a4b2c08', 1),
+    ( 'rivera36_4970', 'sanders', 'daniel', 'Female', '5af7933bfe3164ba49ab1cb3350170a9', 1),
+    ( 'paul42_4971', 'brown', 'morgan', 'Female', 'd5e50295cc02e37f39533a47aa4a9549', 1),
+    ( 'morgan93_4972', 'david', 'miller', 'Female', 'd9843de34cf22245739b7d9bd2afda2a', 1),
+    ( 'rivera43_4973', 'paul', 'maria', 'Male', '172911033ad4c363072c04d9fa768083', 1),
+    ( 'daniel71_4974', 'bell', 'mike', 'Male', '833a23c4071baf5389549ef117331bf3', 1),
+    ( 'mike81_4975', 'bell', 'rivera', 'Male', '8e7991af8afa942dc572950e01177da5', 1),
+    ( 'daniel83_4976', 'maria', 'paul', 'Female', '15e474092b5b5a167f65cdd11f29c2b8', 1),
+    ( 'smith54_4977', 'cooper', 'john', 'Female', '1845bc6b23e2c86365abae4eec1918bc', 1),
+    ( 'sanders38_4978', 'maria', 'miller', 'Female', 'd1d5eb3a1d067c8a11324be711ba87e4', 1),
+    ( 'morris63_4979', 'brooks', 'morris', 'Female', '5f185f2923ea5a0f61e29457946a7a01', 1),
+    ( 'michael25_4980', 'jenny09', 'morris', 'Male', 'afd4b0886c4743441488580ca8045ad8', 1),
+    ( 'smith89_4981', 'brown', 'smith', 'Female', '6af31b2b4f3e2911f6d61f2a4956b4e5', 1),
+    ( 'john97_4982', 'rivera', 'chrishaydon', 'Female', '5cd654fddcf0871f8247f277357519d8', 1),
+    ( 'jenny0985_4983', 'paul', 'sanders', 'Male', 'daa3a33a6d4d57691cafe0e98a45d8ee', 1),
+    ( 'wright84_4984', 'rogers', 'jenny09', 'Female', '497566072da1608bfd0d68391fc73f7b', 1),
+    ( 'maria53_4985', 'michael', 'mike', 'Male', '142a614657f247ff87052228c243de8b', 1),
+    ( 'brooks72_4986', 'bell', 'maria', 'Male', '024034a33ae2db318180373067df724c', 1),
+    ( 'brown61_4987', 'cooper', 'jenny09', 'Female', '0baad3f6549913877c4fda38a600fd6c', 1),
+    ( 'paul95_4988', 'rogers', 'ross', 'Male', '78724cdbe5148adbb9027148b7693819', 1),
+    ( 'brooks92_4989', 'sanders', 'michael', 'Male', 'fc454c8ba8a709708babbabfff940f58', 1),
+    ( 'james44_4990', 'daniel', 'bell', 'Male', 'f9741cab62f038c2b9eb3d1ab67c2de9', 1),
+    ( 'chrishaydon100_4991', 'david', 'jenny09', 'Male', 'fab3809c732adbcac197055b0a11d605', 1),
+    ( 'mark97_4992', 'maria', 'ross', 'Male', 'b4d612b7a7d52a52aaeda65008541a2b', 1),
+    ( 'brooks91_4993', 'daniel', 'daniel', 'Male', '8b48b3ca4e8d5eceaae8e5864b25650f', 1),
+    ( 'jenny0929_4994', 'brown', 'paul', 'Female', '1741ae0367e3ddc7115302efbb37c912', 1),
+    ( 'morgan37_4995', 'ross', 'wright', 'Female', '4a829ae5dfebde0e47d0de1a584f00da', 1),
+    ( 'daniel43_4996', 'miller', 'morris', 'Male', '7d9b72750e15c3bf088f2e116162d29b', 1),
+    ( 'mike98_4997', 'cooper', 'rivera', 'Male', 'ce532a517cb81283ad91e75b6084723e', 1),
+    ( 'john48_4998', 'michael', 'david', 'Male', '0944506deea6026fd0615cf2eb85bbc1', 1),
+    ( 'brooks65_4999', 'paul', 'brooks', 'Female', '3ae19685715d953e6de4a17d8b66aa2a', 1),
+    ( 'cooper18_5000', 'jenny09', 'james', 'Female', '85e3c33ad22b3422c31fe8222aed963c', 1),
+    ( 'chrishaydon54_5001', 'smith', 'mike', 'Male', 'bf056e85835c5493c6901b3b8f99adb0', 1),
+    ( 'john67_5002', 'cooper', 'smith', 'Female', '4cce6f13484f80aae763165ac8b7655a', 1),
+    ( 'morris27_5003', 'james', 'paul', 'Female', '5ccbdf7d41c38f76174903522f1e4ced', 1),
+    ( 'ross45_5004', 'james', 'wright', 'Male', 'cb304967e6838e2717a0e0dc37839f18', 1),
+    ( 'rogers85_5005', 'miller', 'jenny09', 'Male', '361822466c80eee584f15551b3643dc7', 1),
+    ( 'sanders96_5006', 'brown', 'james', 'Female', '3a9cca50509f24bab4ce79ce1649fe21', 1),
+    ( 'smith70_5007', 'paul', 'morgan', 'Female', '8187e274643712759695cfadf17547c3', 1),
+    ( 'wright31_5008', 'rogers', 'michael', 'Female', '6a6e74c5ecbf3d09a4f211eae299ec21', 1),
+    ( 'paul10_5009', 'cooper', 'bell', 'Female', '771b3d3eb8d615f8bf90e123ce3510cc', 1),
+    ( 'sanders2_5010', 'smith', 'sanders', 'Female', 'f5c16fbe3bdeafdf2a1bd22f49d12ef3', 1),
+    ( 'rogers14_5011', 'mike', 'morgan', 'Female', '0285387aff6c1c25f0568f37da220dd0', 1),
+    ( 'morgan79_5012', 'smith', 'paul', 'Male', 'a8a42002078806511bc0a781288d634c', 1),
+    ( 'morris87_5013', 'david', 'rivera', 'Female', '2f1b7080e2606009563d199177396445', 1),
+    ( 'james6_5014', 'morris', 'maria', 'Male', '00ee2ee18c1321879129d05bc645635f', 1),
+    ( 'wright33_5015', 'morgan', 'jenny09', 'Female', '966b89773689212bf12ee5a3092468e8', 1),
+    ( 'maria85_5016', 'mike', 'brown', 'Male', '84b65a7a7224101a8dd90708d096ed14', 1),
+    ( 'ross31_5017', 'bell', 'jenny09', 'Female', '0c2a093206f1d48625ee2f77ac980640', 1),
+    ( 'chrishaydon75_5018', 'brown', 'sanders', 'Male', '2a61817d2d231b3f38109ffeb4c48587', 1),
+    ( 'david54_5019', 'james', 'maria', 'Female', '12d8835f599ead7c2b8953f3df7a6d0d', 1),
+    ( 'chrishaydon50_5020', 'miller', 'cooper', 'Male', 'f7c60cdf9b56ba3763f1a5f8d30f53e6', 1),
+    ( 'miller24_5021', 'ross', 'mike', 'Female', '18a819c8f5089f50b781a6a6c3eac64b', 1),
+    ( 'maria9_5022', 'rogers', 'morris', 'Male', 'd8a49a1bfb38981c58eb6183d5510f62', 1),
+    ( 'smith91_5023', 'james', 'james', 'Female', '6a2318e8ec3438cadd90ec42d0cb4703', 1),
+    ( 'rivera11_5024', 'brown', 'brown', 'Female', 'fa0f2f9b1f225e744eac562808504c34', 1),
+    ( 'james72_5025', 'morgan', 'sanders', 'Female', 'c9ed041405f719fc15d2166ef16f0781', 1),
+    ( 'miller36_5026', 'daniel', 'morgan', 'Female', '9d0759461b13524de71c6c52ffb9d310', 1),
+    ( 'jenny0991_5027', 'maria', 'brown', 'Female', 'b21934c32d38d058ecc7fb8b49d66f44', 1),
+    ( 'jenny0959_5028', 'david', 'john', 'Female', '84a5dadb9f8ea29a856671627cfecf01', 1),
+    ( 'mike41_5029', 'maria', 'mark', 'Female', '06b7476ec66a0df253337fbbbc39f2da', 1),
+    ( 'morgan20_5030', 'maria', 'morgan', 'Female', 'cdaeb1282d614772beb1e74c192bebda', 1),
+    ( 'miller29_5031', 'ross', 'mike', 'Male', '428a2f9ddfd7b8da02040e5e361ee562', 1),
+    ( 'ross56_5032', 'james', 'paul', 'Male', '85e124c6f242f36a3b38162bea2b12e8', 1),
+    ( 'chrishaydon63_5033', 'morgan', 'brown', 'Female', '5bc7f7b1c6143326952c6245dad6174e', 1),
+    ( 'morris54_5034', 'smith', 'mike', 'Male', 'c4425f268f8c0a0f96365d55ca670d79', 1),
+    ( 'bell20_5035', 'wright', 'morris', 'Male', '4d793872148020277e18d11b20f91dd3', 1),
+    ( 'paul41_5036', 'brown', 'wright', 'Male', '187799e784d829bd66e407985bf5a2e4', 1),
+    ( 'morgan42_5037', 'miller', 'chrishaydon', 'Male', '73956c0eddc01d38778ed80e7072d892', 1),
+    ( 'ross100_5038', 'morris', 'morgan', 'Male', '73747165760fc06c584bdb2b3b2ab028', 1),
+    ( 'wright1_5039', 'rivera', 'cooper', 'Female', '5893238bf127db5ac06b02079cb7aa7d', 1),
+    ( 'jenny0921_5040', 'james', 'brooks', 'Female', 'a1cbbbcf2d9ab6e8b654c684f7505536', 1),
+    ( 'paul37_5041', 'wright', 'miller', 'Female', 'e3876b2492dcc5dc68b279cd0022ecbe', 1),
+    ( 'michael92_5042', 'miller', 'ross', 'Male', '12991bdfa40c4e43f99a4d69e5b9f2a7', 1),
+    ( 'chrishaydon14_5043', 'morris', 'mark', 'Female', '8880ca0deddd14fc387dca5cd9538fa0', 1),
+    ( 'miller40_5044', 'daniel', 'sanders', 'Male', '3915cb57ca61e903aa46e17c8f0b0ce5', 1),
+    ( 'wright43_5045', 'bell', 'brown', 'Male', '4f1314b8d36b595ce2d3747a11efa043', 1),
+    ( 'smith15_5046', 'james', 'morris', 'Female', '8516b2ac05654682cf9f1a47bf797f88', 1),
+    ( 'miller49_5047', 'brown', 'brown', 'Female', '5a07cbbd8162a81a0a7e63003d9d7be2', 1),
+    ( 'morris21_5048', 'maria', 'paul', 'Male', 'cb53c534867a4ea811befc32fa517869', 1),
+    ( 'ross27_5049', 'ross', 'wright', 'Female', 'e32ce865f1b84d056dfcc32580571cf8', 1),
+    ( 'rivera16_5050', 'mark', 'michael', 'Male', '843d5972bcea30a9ea03c4de149dcb29', 1),
+    ( 'maria73_5051', 'rogers', 'sanders', 'Female', '0c9c0b25bd949503b33d1ea4d42d6b0f', 1),
+    ( 'chrishaydon60_5052', 'morris', 'smith', 'Female', '550c9dbf05ab7066ff29c96ae72dfd41', 1),
+    ( 'david40_5053', 'daniel', 'brooks', 'Female', 'cead008873bca4b07b2cebf8c0040fe6', 1),
+    ( 'michael66_5054', 'james', 'rogers', 'Female', '4d09b6cbca024d3cc29832a89075edda', 1),
+    ( 'paul27_5055', 'maria', 'brooks', 'Female', 'cb1c6d1df183681d658aa067ce637c31', 1),
+    ( 'jenny0911_5056', 'james', 'michael', 'Male', 'faf20b3d0fb5f8230c5e5a595dada0d8', 1),
+    ( 'miller79_5057', 'michael', 'morris', 'Female', '695fa6a9e95355b22788b3414f9ff73a', 1),
+    ( 'mark14_5058', 'john', 'ross', 'Female', 'da6abbc6868a4a93657574c13aaadf92', 1),
+    ( 'ross87_5059', 'smith', 'chrishaydon', 'Female', 'cfbdd53427adac998e0dd01f9c000b4d', 1),
+    ( 'paul47_5060', 'brown', 'morris', 'Female', '55e71b4408e917b9c7bb0df7d0b81af4', 1),
+    ( 'sanders61_5061', 'david', 'smith', 'Female', '17df64814d7c93fefb3c6d52c85b92f4', 1),
+    ( 'rivera13_5062', 'morris', 'sanders', 'Female', '0d8635347f039f586f8a7b62cf1540b5', 1),
+    ( 'chrishaydon22_5063', 'morris', 'daniel', 'Male', '6d5cf064b603438ab797df0465bb9bf2', 1),
+    ( 'sanders42_5064', 'miller', 'rogers', 'Male', '94dd43d6bee1ae1bcf4f754404fcc250', 1),
+    ( 'david78_5065', 'miller', 'mark', 'Female', '9629b9949b6ff7e3b4381aea26921077', 1),
+    ( 'ross60_5066', 'brown', 'wright', 'Male', '1447db202fdc45f3e7572e6c89b28fda', 1),
+    ( 'morris24_5067', 'paul', 'john', 'Male', '9c3e20114fe0cf8c66743542a29b8b25', 1),
+    ( 'jenny0952_5068', 'mike', 'smith', 'Male', '02893b6f931ebe1f44cd9ee3029ca080', 1),
+    ( 'rogers72_5069', 'rogers', 'brooks', 'Male', '5d0dfd7af5a17c3028fc17a74a27bdbb', 1),
+    ( 'daniel70_5070', 'rivera', 'rogers', 'Female', 'dfac65053b8c4b264c8a485fbcd0af82', 1),
+    ( 'cooper76_5071', 'miller', 'cooper', 'Female', '0f93c7bdbca2662e97fa967c86c036d5', 1),
+    ( 'jenny0942_5072', 'rogers', 'jenny09', 'Female', 'bc623024e7ee04b6eeee4b54764e634a', 1),
+    ( 'daniel39_5073', 'john', 'miller', 'Male', '1c34790dc216b192e2c31080c0c7e1ea', 1),
+    ( 'morris59_5074', 'morris', 'rogers', 'Male', '747f59a350044d27d42098554f78d986', 1),
+    ( 'mike70_5075', 'chrishaydon', 'james', 'Female', '5581a29b180f00da20fe744c6743b59b', 1),
+    ( 'rogers20_5076', 'john', 'morris', 'Male', '61b5c68987f9713756fcddf407618e52', 1),
+    ( 'david33_5077', 'john', 'michael', 'Male', '5ca8db0f7654b6c59c50567815892ccc', 1),
+    ( 'morris65_5078', 'michael', 'daniel', 'Male', '8386d7dcfe2fb76eb5f5e99b93f8dd23', 1),
+    ( 'michael23_5079', 'sanders', 'bell', 'Female', '2e1cb161a9938603d8f19991f8a59040', 1),
+    ( 'john96_5080', 'maria', 'brooks', 'Female', '4cb454ec7c724ee6bfc62e0a692e983a', 1),
+    ( 'wright64_5081', 'sanders', 'miller', 'Male', 'a87e4c3d1fc75d29546b3535c25e44ec', 1),
+    ( 'david91_5082', 'cooper', 'brooks', 'Male', '689df1dc4f2f5f09e9e6630ed7499b24', 1),
+    ( 'daniel87_5083', 'rivera', 'cooper', 'Female', '6f91e3e5afce8f38cf4c5f502fbf0a6a', 1),
+    ( 'ross60_5084', 'michael', 'morris', 'Female', 'f19a3fda06930339554d054f1699d136', 1),
+    ( 'morris60_5085', 'sanders', 'wright', 'Male', 'd84a4a0e185d2087a29db23bb936debb', 1),
+    ( 'rivera50_5086', 'morris', 'mark', 'Female', '447419171ae53caf38ab2b44554a141e', 1),
+    ( 'maria77_5087', 'mark', 'rogers', 'Female', '01918ce06c72b244e53a3fd232e7d082', 1),
+    ( 'morgan64_5088', 'smith', 'mark', 'Male', 'a726cbe5c21aa9a7c047096978fe8ae5', 1),
+    ( 'miller41_5089', 'cooper', 'mike', 'Female', 'e6122f875dfc38e49d05e5f50d292496', 1),
+    ( 'david65_5090', 'rogers', 'morris', 'Male', 'fa412f065944a1a182c42f10ace27744', 1),
+    ( 'morgan96_5091', 'paul', 'daniel', 'Male', '5328393f2b30aa145a4e6c248a9a8026', 1),
+    ( 'ross73_5092', 'david', 'miller', 'Female', '73e1af72752365d637bccb8a4e494c9a', 1),
+    ( 'brooks95_5093', 'brown', 'rogers', 'Female', '2d8728af45d61e98e419cdcbf61d8895', 1),
+    ( 'sanders92_5094', 'miller', 'sanders', 'Female', 'dd3c0fa847c90cc326ae1131946f7ca0', 1),
+    ( 'cooper53_5095', 'wright', 'james', 'Female', 'a321c57d32f66b1c969ac31bf8308777', 1),
+    ( 'ross100_5096', 'chrishaydon', 'rogers', 'Male', '3b95a878e7e99d5933f0abd36ca835fa', 1),
+    ( 'michael91_5097', 'wright', 'daniel', 'Female', '28d6abf291fdd1f27f7c5f75efc4ffb9', 1),
+    ( 'brooks51_5098', 'sanders', 'james', 'Female', '2a2b24197c68a7ce96e1fcd9e5cca0a8', 1),
+    ( 'ross26_5099', 'daniel', 'brown', 'Male', '4e1fca34cbfde3c501d854ddbcf0fc2e', 1),
+    ( 'morris58_5100', 'mark', 'miller', 'Female', '2cea5f10095a7daa81eeb626852b7e20', 1),
+    ( 'morris58_5101', 'mark', 'rogers', 'Female', '0e93754aeafd6e07fc3a821829330e96', 1),
+    ( 'ross12_5102', 'brooks', 'chrishaydon', 'Female', '4bbf7effaef3991e6707c7efa3ce5510', 1),
+    ( 'brooks60_5103', 'sanders', 'sanders', 'Female', '438f54f8c5a3a6f58ce5a69d37294fe9', 1),
+    ( 'mark29_5104', 'rivera', 'daniel', 'Male', 'cba8e1ca4c1c625ac80696ae492d69a0', 1),
+    ( 'john14_5105', 'sanders', 'paul', 'Female', '1fa1221343986bab24531bca16125e23', 1),
+    ( 'rivera26_5106', 'jenny09', 'chrishaydon', 'Male', '21354e8024a4260d693a0c258fb366d8', 1),
+    ( 'jenny0949_5107', 'ross', 'john', 'Male', '249c74900ff11712eb74680d0aa6b26f', 1),
+    ( 'rogers96_5108', 'morgan', 'daniel', 'Female', '1921493b5362e63fbe8983f4bd54157d', 1),
+    ( 'david55_5109', 'mike', 'wright', 'Male', 'a52dafd8f39429e36ccc056f1332486f', 1),
+    ( 'chrishaydon3_5110', 'rivera', 'cooper', 'Male', '51ae01270114b24c78d7f0ae5cd11974', 1),
+    ( 'ross81_5111', 'smith', 'paul', 'Male', 'c1df6d2d52d83a47d910954514d4536c', 1),
+    ( 'john70_5112', 'michael', 'mike', 'Female', '665ed5c13c53d667fed8c3b0123e67f5', 1),
+    ( 'miller34_5113', 'smith', 'cooper', 'Female', 'f122887c953d5850a07fb7773c9e75ca', 1),
+    ( 'bell26_5114', 'daniel', 'wright', 'Female', '808f48ff0a413346671a4cfd4939021e', 1),
+    ( 'ross92_5115', 'paul', 'wright', 'Female', 'e19deafc191fbda7a93c0f2edfd32fb6', 1),
+    ( 'rogers34_5116', 'james', 'michael', 'Female', '3519dedd9a7bbbe17921c43930ac301f', 1),
+    ( 'james51_5117', 'morris', 'morgan', 'Female', 'daa400d87736ccc7338f523a5222b0fc', 1),
+    ( 'ross54_5118', 'sanders', 'brooks', 'Male', 'c5cdc4d688579fade63d6541ef54cda9', 1),
+    ( 'brown59_5119', 'michael', 'bell', 'Male', '96f19a1544538c7a48eb5b32b1457e52', 1),
+    ( 'michael83_5120', 'michael', 'smith', 'Male', 'a8ef9d7aaeb65983d0830becadea8b3a', 1),
+    ( 'miller10_5121', 'bell', 'rivera', 'Female', '4d2fa82e354bb910a16701942e8939d2', 1),
+    ( 'jenny0939_5122', 'james', 'bell', 'Male', '798bcaf88bfbb1c606ce7d7e2afaee61', 1),
+    ( 'paul100_5123', 'wright', 'brooks', 'Female', '2d5c6b13e164fc9d89a995916c43487b', 1),
+    ( 'smith82_5124', 'brown', 'rivera', 'Male', '06456c89b038bbf183378ea26db3a536', 1),
+    ( 'morris6_5125', 'cooper', 'ross', 'Female', 'cf371f603fce7d593c97bc1326c353d7', 1),
+    ( 'paul76_5126', 'rogers', 'james', 'Female', '13ec70c64e9b3d24301393db75f6dc12', 1),
+    ( 'mark97_5127', 'miller', 'maria', 'Male', '5c6e3f71776551a20136b8bf5ce78a1b', 1),
+    ( 'john74_5128', 'david', 'brown', 'Male', 'f6cadb74de89fe47811ab4ca8b6b74b7', 1),
+    ( 'miller78_5129', 'miller', 'morgan', 'Male', '0bacfe7f9320e3f5d184522557531c0c', 1),
+    ( 'miller54_5130', 'sanders', 'morgan', 'Female', '603e79b8c114007e77226cb705e3c701', 1),
+    ( 'chrishaydon9_5131', 'maria', 'michael', 'Female', 'fc0e6a0d253365cc4d6ab3ec91402048', 1),
+    ( 'daniel4_5132', 'john', 'rogers', 'Male', '0bc20329e781207cb0532acc8aa39727', 1),
+    ( 'john24_5133', 'michael', 'miller', 'Male', 'c8053892fcccdbc4af2a7330b1d9a9c1', 1),
+    ( 'smith31_5134', 'james', 'rogers', 'Female', '19eb304f76c430ffb3a715144ac078d3', 1),
+    ( 'smith77_5135', 'jenny09', 'smith', 'Male', '82054797739059e5e0d8edfb419f5395', 1),
+    ( 'maria29_5136', 'paul', 'wright', 'Female', '50f6d8d0c381cb2a5b20a9b5247c29af', 1),
+    ( 'michael76_5137', 'jenny09', 'brooks', 'Male', '16b9af517ef844fdca5f1a70f4a3a5db', 1),
+    ( 'mark64_5138', 'david', 'brooks', 'Female', '62e04e5699fa010471f5135cc5697a1f', 1),
+    ( 'morris5_5139', 'brooks', 'chrishaydon', 'Female', 'd207678f6407819552de1a052f26a501', 1),
+    ( 'brown69_5140', 'mark', 'mike', 'Male', '2d7775e05708bd9916cd5ad97bb02cf8', 1),
+    ( 'miller53_5141', 'paul', 'jenny09', 'Female', '362bdce9582c044efc529ac17fb314da', 1),
+    ( 'wright94_5142', 'maria', 'john', 'Female', '1fa405a56ca82c8296c7081cf69627b3', 1),
+    ( 'rogers26_5143', 'miller', 'daniel', 'Female', 'f8d92a8069500fb16fceb6b1e620c55c', 1),
+    ( 'jenny0977_5144', 'maria', 'rogers', 'Female', '3d16cc235e35b948fd2829c3438d76f3', 1),
+    ( 'daniel23_5145', 'maria', 'morgan', 'Male', 'd23ebd9f25b327bd8c10bdf95a6f5be8', 1),
+    ( 'cooper66_5146', 'miller', 'rivera', 'Female', '5e7855afb47310c2866bf653bbff1e85', 1),
+    ( 'chrishaydon61_5147', 'smith', 'maria', 'Male', '0c2ee9227e7805666dcd29f63747ac69', 1),
+    ( 'maria95_5148', 'smith', 'jenny09', 'Female', 'f16e92c1f4d059673b429000700a4fe0', 1),
+    ( 'james17_5149', 'bell', 'james', 'Female', '3808d372a53eb62e5932d1ac01af621e', 1),
+    ( 'james18_5150', 'morgan', 'brooks', 'Female', 'b70a909b7a355517683ac8e8f0a3a446', 1),
+    ( 'mike47_5151', 'rivera', 'jenny09', 'Female', 'fd1e0eee0938a765c884fdf91beb02e9', 1),
+    ( 'john57_5152', 'miller', 'mike', 'Male', '3d2b771a9696b6cc498eea73a2564abe', 1),
+    ( 'mark54_5153', 'rogers', 'miller', 'Male', '974d7becbb896578952d22df5b4df72d', 1),
+    ( 'bell38_5154', 'daniel', 'john', 'Male', 'fad308492fe3d604fb9896e59d4b33ca', 1),
+    ( 'morgan31_5155', 'ross', 'chrishaydon', 'Male', '2ec91a5d75e13f8d81c9232d30aca589', 1),
+    ( 'miller16_5156', 'brown', 'james', 'Female', '1ce1959c06025096b040d6a29369bbcf', 1),
+    ( 'rivera14_5157', 'michael', 'chrishaydon', 'Female', '1672dd29743d34dde83b0de3a268dc4f', 1),
+    ( 'rogers22_5158', 'sanders', 'rogers', 'Male', '484115f76a79fce89dc652d146d793ac', 1),
+    ( 'jenny0963_5159', 'sanders', 'chrishaydon', 'Female', 'b3d29210f2029e12b37b9a9ab1e282a1', 1),
+    ( 'mark42_5160', 'sanders', 'michael', 'Female', '0d659ddc03566cb9c55c9ccf0eb2f1bb', 1),
+    ( 'mark7_5161', 'morris', 'morris', 'Female', '3a677cd8a58d34cd3eb0e01c9fd9c0d4', 1),
+    ( 'john1_5162', 'cooper', 'david', 'Female', '4584c38c736edc902f9e1ec6e57e60c2', 1),
+    ( 'chrishaydon3_5163', 'rogers', 'brown', 'Female', '0d0750f3076764c254b83fd9e6934ff4', 1),
+    ( 'smith11_5164', 'bell', 'sanders', 'Male', 'a753933332887a8e39679d733e595105', 1),
+    ( 'john22_5165', 'smith', 'mark', 'Female', '7527936a18d0303cd196c7290698b583', 1),
+    ( 'rivera23_5166', 'paul', 'david', 'Male', '9884c479cff91d0024d9ab7e95e3993e', 1),
+    ( 'james32_5167', 'ross', 'paul', 'Male', '759d1538832687bc89adb1696d0a7682', 1),
+    ( 'daniel58_5168', 'maria', 'john', 'Female', '83040a278811ebec6b1ae529da424bcd', 1),
+    ( 'james18_5169', 'miller', 'rogers', 'Male', '34e44c5db8a0296243376f72bcc922a7', 1),
+    ( 'brown93_5170', 'miller', 'sanders', 'Male', '12c2ea5790f39267caf0dcc0e56149fa', 1),
+    ( 'bell89_5171', 'morgan', 'john', 'Female', 'ef7ea08d5e8ef9223be624f955f742e7', 1),
+    ( 'miller82_5172', 'morgan', 'brown', 'Male', 'e6acd2b471521e86ab2e8a740b42696e', 1),
+    ( 'paul21_5173', 'cooper', 'james', 'Female', '476cc20df2c1d5555b011737e87450ba', 1),
+    ( 'chrishaydon88_5174', 'bell', 'ross', 'Male', '261bc89527e8c6c258df758daedcdc58', 1),
+    ( 'rivera44_5175', 'wright', 'brown', 'Male', 'cafd1208837e606d6e7bbc575fff8cd2', 1),
+    ( 'bell89_5176', 'cooper', 'rivera', 'Male', '9e72fbc60ffc66df2073d9af9a139365', 1),
+    ( 'bell66_5177', 'bell', 'miller', 'Male', '5f2fceaa5a602fead16179655d750f25', 1),
+    ( 'rogers31_5178', 'wright', 'paul', 'Female', 'b3a3e7554cdc27277dccd5fa99a5b870', 1),
+    ( 'bell47_5179', 'brown', 'mark', 'Female', '4c149290eb01d142fb72e8d667b7e35c', 1),
+    ( 'sanders58_5180', 'rivera', 'daniel', 'Female', '39674983390b393b9d4d93717bcfd758', 1),
+    ( 'mark26_5181', 'mark', 'chrishaydon', 'Male', 'edd4380771e20faad5020900d337a18f', 1),
+    ( 'miller22_5182', 'paul', 'wright', 'Male', '4d0b33b5012968d7184664859cf30a80', 1),
+    ( 'daniel46_5183', 'maria', 'sanders', 'Female', '46dccd259f8bcc5bd768f9961ccd7dd7', 1),
+    ( 'morgan100_5184', 'daniel', 'michael', 'Male', 'dfb7acb95bc071ce5fe5bd5fbd4a9313', 1),
+    ( 'ross75_5185', 'cooper', 'brown', 'Male', '136b86487c3a27486eb579415f1a0afe', 1),
+    ( 'david68_5186', 'bell', 'mark', 'Female', '2b2a54df255a79171cf908772b1ff1e1', 1),
+    ( 'rivera35_5187', 'bell', 'wright', 'Male', '87e8b4b7718ffaa536003a9e7ac1f469', 1),
+    ( 'daniel63_5188', 'james', 'brooks', 'Female', '5831d06a0d574cc478cde2bb60cbccb0', 1),
+    ( 'ross37_5189', 'bell', 'brooks', 'Female', '80624be7b6a1b4072632b34e0ee85750', 1),
+    ( 'michael23_5190', 'mike', 'morgan', 'Male', '61c7e71d2397dd17b9936d6df1289cb9', 1),
+    ( 'mike48_5191', 'mike', 'david', 'Male', '4782c7ad3418491a6a3827a716c1ace1', 1),
+    ( 'david57_5192', 'mike', 'sanders', 'Male', '6af7f1260da41de43f3687d23de782b9', 1),
+    ( 'morris67_5193', 'david', 'bell', 'Female', '88e98e6e9ee4f5142f346872e51321f5', 1),
+    ( 'rogers49_5194', 'jenny09', 'bell', 'Female', '89d590df89e99a0e503c6ff81f11b22a', 1),
+    ( 'morris14_5195', 'mark', 'rivera', 'Female', '530ec73ac444d117a754cf40fccf0cc5', 1),
+    ( 'brooks74_5196', 'mark', 'miller', 'Female', 'b7d7bd8ad5a68a5b4f1f24b87c12a0f2', 1),
+    ( 'mike71_5197', 'brown', 'brown', 'Female', '80f467ed9579d12835eeeb5166ac9028', 1),
+    ( 'mark18_5198', 'miller', 'cooper', 'Female', 'b5ea87043f0c8767e9137efedff4f91d', 1),
+    ( 'mark9_5199', 'ross', 'miller', 'Male', '50d5d07be6e93b6538c5de35a1294a7c', 1),
+    ( 'brooks21_5200', 'john', 'jenny09', 'Male', '7eaedec3a9c322efbc5e67ee6c957e15', 1),
+    ( 'bell4_5201', 'michael', 'miller', 'Male', '40e90db13ab31c7efd64228034182c2e', 1),
+    ( 'brown17_5202', 'chrishaydon', 'mike', 'Male', '0d50375f8eb5062f4cef8ff30fe63a54', 1),
+    ( 'jenny0923_5203', 'rivera', 'jenny09', 'Male', 'fb288e6d2aaef08984cd2af4d4947f3e', 1),
+    ( 'smith66_5204', 'chrishaydon', 'wright', 'Female', '18398d8917734ce9a9c229b4ecddb4bd', 1),
+    ( 'daniel53_5205', 'chrishaydon', 'wright', 'Female', 'a3e19210d31e89afc1cafaa28526db0e', 1),
+    ( 'brown33_5206', 'ross', 'james', 'Female', '27885060fe068e5fc308ffa950124b30', 1),
+    ( 'mark55_5207', 'jenny09', 'paul', 'Male', '586775ba800ef95305c96e8b27b30fc5', 1),
+    ( 'rivera83_5208', 'rivera', 'maria', 'Female', '7b0500141adcc0d9410f8ee9b71912d7', 1),
+    ( 'paul72_5209', 'mark', 'bell', 'Male', '3ff72807f3d8f043dc18e0025fd613ac', 1),
+    ( 'david25_5210', 'mike', 'james', 'Male', 'bfbe6d150fa8823ce636f4a8785a56ec', 1),
+    ( 'paul47_5211', 'rivera', 'morgan', 'Female', 'c20cfc9aacc3c9f0dbb4229323c98e09', 1),
+    ( 'james61_5212', 'miller', 'daniel', 'Female', '4ffa6908c18b5d24361c350898dad220', 1),
+    ( 'paul90_5213', 'michael', 'paul', 'Female', '81049ddd3be9e09d2decd132fc473804', 1),
+    ( 'david95_5214', 'cooper', 'wright', 'Male', 'c1866265f95fdebc1ffd591906cc2ce1', 1),
+    ( 'paul52_5215', 'smith', 'paul', 'Female', '794c1003106a8102bf7f06c1e68e9c3a', 1),
+    ( 'wright82_5216', 'paul', 'michael', 'Female', '6db28b6d5c5dc094e59ca7cc5dba81e8', 1),
+    ( 'james61_5217', 'miller', 'mark', 'Male', '99143ad0480bdbf2cbf1f0ef5d339c1e', 1),
+    ( 'michael89_5218', 'rogers', 'morgan', 'Male', '5b2652097a888a148b1c61b4a1e1230b', 1),
+    ( 'wright44_5219', 'mark', 'ross', 'Male', 'c364c77fb34fccd09c5aa76e797c9bf3', 1),
+    ( 'chrishaydon60_5220', 'jenny09', 'wright', 'Female', 'ff2a5c75e135814a8729a179349b5d0b', 1),
+    ( 'john30_5221', 'ross', 'morgan', 'Male', '93ccc2579e526596814fa3a33be4887e', 1),
+    ( 'rivera2_5222', 'morris', 'ross', 'Male', '79b14af58600b268c15ab4b8e85c3dcd', 1),
+    ( 'wright17_5223', 'maria', 'jenny09', 'Male', '0333dc1686f348ac6a361c367c83d0fa', 1),
+    ( 'rivera30_5224', 'miller', 'morgan', 'Female', 'eb2864111a083cca837b5301a98455ca', 1),
+    ( 'daniel63_5225', 'maria', 'brooks', 'Male', 'd9941265e1be2b87b39c86bde28c4bee', 1),
+    ( 'brooks56_5226', 'morgan', 'david', 'Male', 'fe4db4d3555edd58a6c7893c8cc9b4a8', 1),
+    ( 'wright29_5227', 'paul', 'mike', 'Female', '075cff39301c13a4628e738796211158', 1),
+    ( 'morris52_5228', 'bell', 'morgan', 'Female', '50acf2e83352b4c56de36b8b9ebfd5b7', 1),
+    ( 'morgan10_5229', 'morris', 'sanders', 'Male', '31335280ee2f7e3a6539c14c3ac1e5b2', 1),
+    ( 'sanders55_5230', 'john', 'bell', 'Female', '9914952845dec193e38253f74ea92ac5', 1),
+    ( 'mike9_5231', 'smith', 'daniel', 'Male', '4e93d36e6cb1abc458b10f484cdd3457', 1),
+    ( 'michael69_5232', 'rivera', 'sanders', 'Male', 'ed0eebbaa23beae2d9fcb2fd70cd6feb', 1),
+    ( 'david18_5233', 'rivera', 'bell', 'Female', '8f4d3d3cbeb704d0a8a48a19a3d96ef3', 1),
+    ( 'daniel41_5234', 'sanders', 'sanders', 'Female', 'daf67ee2be3d31563a6bf3e421448f76', 1),
+    ( 'david59_5235', 'brooks', 'mark', 'Male', 'b9a460fcae3ca69706c8e2e3383b9698', 1),
+    ( 'daniel17_5236', 'mike', 'jenny09', 'Female', '70cd9422c699a9c4c004eeded78d714e', 1),
+    ( 'john45_5237', 'brooks', 'brooks', 'Male', '83a42afe88473678096d1aa1da108f6d', 1),
+    ( 'morgan17_5238', 'david', 'rogers', 'Female', 'd531b52298110e9eed0738383c936399', 1),
+    ( 'john42_5239', 'brooks', 'cooper', 'Female', '5468389cbe236356bdd5b20c131eaf46', 1),
+    ( 'rogers50_5240', 'brooks', 'mark', 'Male', '6a4d6e1fc2c1cc17dd36b58678028351', 1),
+    ( 'smith35_5241', 'maria', 'james', 'Female', '7a4d7539aefae273af01d796e839bd16', 1),
+    ( 'mark56_5242', 'david', 'maria', 'Female', 'e19b2741c831b005970dbfd73923ec4a', 1),
+    ( 'chrishaydon12_5243', 'michael', 'brown', 'Male', 'f50c76cea17e3c675b01f051022a4cd9', 1),
+    ( 'mark90_5244', 'maria', 'daniel', 'Female', 'faa56a69c6898f2b12e6f50b679012c9', 1),
+    ( 'maria85_5245', 'brown', 'mike', 'Male', 'e71f1b32b8f3bf6b4eb335ee3517e9b5', 1),
+    ( 'rogers42_5246', 'maria', 'jenny09', 'Female', '49067a6d334eac6a782654d96f983ae4', 1),
+    ( 'brooks95_5247', 'maria', 'john', 'Female', '7aba8bea5d25213b4ff5ab309306cabf', 1),
+    ( 'brooks38_5248', 'james', 'daniel', 'Female', 'a45aeebe3e6f6fe8b9ce4bf7ea5e07ce', 1),
+    ( 'morris49_5249', 'brown', 'rogers', 'Female', 'b9a1e95cfd8d0b8eba13321a7ef789e9', 1),
+    ( 'david86_5250', 'john', 'chrishaydon', 'Male', '7933b75d6a6cdca9623d1038adc8e74c', 1),
+    ( 'wright15_5251', 'sanders', 'james', 'Male', 'e8645f00d43a14455077b8ce9546c3f0', 1),
+    ( 'wright75_5252', 'brooks', 'smith', 'Male', 'c7643b7aa037938a8bb8ae6088bdf9fa', 1),
+    ( 'sanders78_5253', 'james', 'rivera', 'Male', '4dc78ee9d84aeec573c4179447b5f17c', 1),
+    ( 'jenny092_5254', 'sanders', 'brown', 'Female', '64ff17838f80c64258cd790a282dc3f9', 1),
+    ( 'cooper68_5255', 'morris', 'mark', 'Male', 'a36fa990b8a15cc92fb780b60fea69b6', 1),
+    ( 'paul87_5256', 'bell', 'jenny09', 'Female', '0f56b6e11f8fb3d2c7b9e2b5197ffcbf', 1),
+    ( 'brooks59_5257', 'brooks', 'john', 'Female', 'ae166556c18344fbb05b9dedcf3413cc', 1),
+    ( 'rivera36_5258', 'rogers', 'rivera', 'Female', 'f1b651f998166553cac2f346d5821afd', 1),
+    ( 'cooper25_5259', 'maria', 'mark', 'Male', '6005d945a47f436577e76623a508727d', 1),
+    ( 'mike3_5260', 'jenny09', 'chrishaydon', 'Male', 'a997486cbd893417738f730605d99054', 1),
+    ( 'rivera24_5261', 'daniel', 'john', 'Female', 'ddeedb5dd430c8eba74f8eb99d9189e7', 1),
+    ( 'wright89_5262', 'daniel', 'paul', 'Male', '95168db3c813b2c5d9b854b227805c3e', 1),
+    ( 'cooper12_5263', 'john', 'mike', 'Female', 'c7d8d47d7f4958f0ed0b5b2b98909a3a', 1),
+    ( 'paul32_5264', 'paul', 'mike', 'Female', '489ab8665febfa2ee11f5fb8b7dbd39f', 1),
+    ( 'wright11_5265', 'rogers', 'daniel', 'Male', '6c2940089f8c9eaf6dfb54ababd79bb8', 1),
+    ( 'maria51_5266', 'john', 'maria', 'Female', '6340d1a400023f7fccfc9eb26f537575', 1),
+    ( 'morris85_5267', 'james', 'sanders', 'Male', 'c22e7b6dca3df44ae65fe9c5e3862352', 1),
+    ( 'chrishaydon88_5268', 'morgan', 'sanders', 'Female', 'b29b96b78762d7c4eb6089af70c1ffbf', 1),
+    ( 'brown49_5269', 'sanders', 'miller', 'Female', '580ff33d8cf5f43ed1cf9c6d8c0947eb', 1),
+    ( 'mike55_5270', 'ross', 'paul', 'Female', '8f395e42956816a5dc052c188c865a4d', 1),
+    ( 'paul6_5271', 'cooper', 'morris', 'Male', 'b55fb861684f108efc3d896b8724f00f', 1),
+    ( 'james19_5272', 'paul', 'wright', 'Female', '4198673960721d98d5c586b0e4513c70', 1),
+    ( 'brown35_5273', 'brooks', 'brown', 'Male', 'd0f13bbe38ff4e16b74e80a6b57c2172', 1),
+    ( 'chrishaydon15_5274', 'brooks', 'daniel', 'Male', '18ea27e101b2dbfad66fb53abd9ca48f', 1),
+    ( 'jenny0962_5275', 'cooper', 'morris', 'Female', '12113a40d01f2935f595440de44921a5', 1),
+    ( 'brooks57_5276', 'brown', 'ross', 'Male', '26ac191493cf6aa18c23295c926ac932', 1),
+    ( 'chrishaydon7_5277', 'bell', 'cooper', 'Female', 'dafd3660fc2586fd3c6144dc41f87059', 1),
+    ( 'morgan15_5278', 'ross', 'morgan', 'Female', 'd1885da7474cc37a39aff2573d607d75', 1),
+    ( 'chrishaydon47_5279', 'chrishaydon', 'morgan', 'Female', '598b2dcf555dceee8ef42d7566061fe2', 1),
+    ( 'brooks41_5280', 'david', 'daniel', 'Female', '2cdf58baaa126fcc015735645ab5cc2e', 1),
+    ( 'mark61_5281', 'brown', 'morris', 'Female', 'c12c5123fc7f6d7235eaca3950e50d8e', 1),
+    ( 'brooks82_5282', 'david', 'jenny09', 'Male', '27eff06e596108f7a9788395cdb06387', 1),
+    ( 'maria6_5283', 'david', 'mark', 'Female', 'd2e2db7fd52f7d7e8880ee081f5cd521', 1),
+    ( 'brown2_5284', 'miller', 'miller', 'Male', 'e82d49ab38cb8190072101c9671fc785', 1),
+    ( 'maria94_5285', 'brown', 'maria', 'Female', '1a45aa98069ff4dcfb86dc42438a746d', 1),
+    ( 'smith95_5286', 'daniel', 'smith', 'Female', 'ca88519b1e23347b5433eedf585bbb66', 1),
+    ( 'ross3_5287', 'daniel', 'david', 'Male', '50c0b3f1ac2eb7d177e1e7e11c94aeb9', 1),
+    ( 'smith24_5288', 'jenny09', 'sanders', 'Female', '37d1ad5adcc0d02ef2de1dd0939078ab', 1),
+    ( 'john19_5289', 'cooper', 'brooks', 'Female', '7fba0bcfd7c2bf8945d8af18c610e1a6', 1),
+    ( 'michael93_5290', 'chrishaydon', 'morris', 'Male', '29282505fa1675d9c26d7ad4868b8727', 1),
+
+    ( 'bell35_5291', 'morris', 'michael', 'Male', 'f38556efe7320b5ba0b37f1ac082931a', 1),
+    ( 'david80_5292', 'ross', 'sanders', 'Male', 'b6846b0186a035fcc76b1b1d26fd42fa', 1),
+    ( 'paul3_5293', 'daniel', 'wright', 'Male', 'd7e7f350f8bed24fef4232b75801c067', 1),
+    ( 'michael27_5294', 'paul', 'rivera', 'Male', '8f460a5bac166a88975cbed31695024a', 1),
+    ( 'smith12_5295', 'sanders', 'brooks', 'Male', '53e826b8f7e331fc4bc43156fa581858', 1),
+    ( 'maria61_5296', 'ross', 'michael', 'Male', '8b224ee2ef8e90b58c6940c17b05a5c7', 1),
+    ( 'daniel3_5297', 'miller', 'morgan', 'Female', '8af141d3c5a5146a3eac9d166ab4c458', 1),
+    ( 'jenny0923_5298', 'michael', 'mike', 'Male', '9865b79316da3dc2aa652a80d6830673', 1),
+    ( 'morris5_5299', 'daniel', 'paul', 'Male', '7d72b267afcd1c481b26b2194f23e3c9', 1),
+    ( 'ross58_5300', 'bell', 'mark', 'Female', '915cc23196822ac4f811c718bbebedfd', 1),
+    ( 'michael48_5301', 'jenny09', 'smith', 'Male', '75fe36d638add8a491fa7d08374b69f5', 1),
+    ( 'brown92_5302', 'james', 'david', 'Male', '7f10b80662587c371ab84ee65b0b6970', 1),
+    ( 'morgan54_5303', 'michael', 'james', 'Male', '00491bbd246b49579c3c04065163c3cf', 1),
+    ( 'sanders87_5304', 'chrishaydon', 'paul', 'Female', 'd42e30876b4c9e31198d241f65909c47', 1),
+    ( 'paul54_5305', 'cooper', 'bell', 'Male', 'ed7ec77be8029e30e8532d41448d1c52', 1),
+    ( 'cooper59_5306', 'jenny09', 'jenny09', 'Female', '21281109dfa14010fcc23de3c044a3c2', 1),
+    ( 'brooks16_5307', 'brooks', 'chrishaydon', 'Male', '2c50541aa6e124562f0793f727bfa711', 1),
+    ( 'rivera44_5308', 'john', 'morgan', 'Male', 'd4488acfe72bb3da5a38f7911ad711ad', 1),
+    ( 'sanders60_5309', 'john', 'morris', 'Male', 'ed804eac71ba458adc71df7d0c8a5a20', 1),
+    ( 'morgan82_5310', 'mark', 'bell', 'Male', '591ac07d8fed3cfdba111575059c3fb3', 1),
+    ( 'john69_5311', 'jenny09', 'chrishaydon', 'Female', 'af1aa97926e982a0eb064bc9bb2a7b05', 1),
+    ( 'david36_5312', 'rivera', 'sanders', 'Female', 'fcc4033153268829b02d73db357f03ea', 1),
+    ( 'mike88_5313', 'smith', 'james', 'Female', 'd4d05c2e262dc1b4f07d7c104c0b0c84', 1),
+    ( 'david9_5314', 'jenny09', 'chrishaydon', 'Female', '476fbef792ebde804fb21f546dbc2855', 1),
+    ( 'david34_5315', 'james', 'maria', 'Female', '64569f827f8b35a5875a4ccd41940e2d', 1),
+    ( 'sanders20_5316', 'wright', 'wright', 'Male', '6ef8878a9493e9e39d19c2ec2dbd003b', 1),
+    ( 'mike22_5317', 'ross', 'chrishaydon', 'Female', '4eb71ff2fea572ac4eba36612569bb14', 1),
+    ( 'maria63_5318', 'brown', 'morgan', 'Female', '8bae2668d9baa9309af2aa5ae18820db', 1),
+    ( 'miller94_5319', 'bell', 'smith', 'Male', 'c202a50838377ce5bb5248d78ad31c58', 1),
+    ( 'chrishaydon17_5320', 'john', 'daniel', 'Male', 'aa2750f848efac0a0233a97c28fc9fc5', 1),
+    ( 'brooks60_5321', 'mike', 'morris', 'Female', 'b9a7552791e7b0c37e52102056a0fbd2', 1),
+    ( 'bell34_5322', 'james', 'ross', 'Female', '156536d9a1f58a32f509b600827563da', 1),
+    ( 'chrishaydon65_5323', 'cooper', 'mark', 'Male', 'dc5e16512f553f1626683250cbb7af78', 1),
+    ( 'paul70_5324', 'miller', 'brooks', 'Female', '32ce9a21c85fa14b78f39f1f7d82618a', 1),
+    ( 'jenny0972_5325', 'morgan', 'chrishaydon', 'Male', 'e71bd18199aa8d0e75a2a0ceb3d369c9', 1),
+    ( 'john41_5326', 'bell', 'paul', 'Female', 'c308985c0f1d17f98317e5a96695c26b', 1),
+    ( 'smith78_5327', 'brooks', 'cooper', 'Female', 'a07db0ad5002d04602b3f657e05006fb', 1),
+    ( 'morgan55_5328', 'maria', 'cooper', 'Female', 'f5951d205429d536ea58d0584296ddc8', 1),
+    ( 'smith81_5329', 'maria', 'chrishaydon', 'Male', '17c19ef54ca5ff0f01b6d78d089c3237', 1),
+    ( 'jenny0988_5330', 'morgan', 'mike', 'Female', '8a0ab1e3b5f90ac8c677227c63c5b3c4', 1),
+    ( 'chrishaydon49_5331', 'david', 'john', 'Male', '2fe59c3c6cde3a53245fe223a3b6a838', 1),
+    ( 'morris31_5332', 'brown', 'smith', 'Male', '31eacf1d75af52241c63f0b74d07b8f4', 1),
+    ( 'mike15_5333', 'smith', 'sanders', 'Male', '5b57373e8b52f356f6b9b5e067016476', 1),
+    ( 'smith61_5334', 'daniel', 'brown', 'Female', '025a87d79c56604f376ed899adac1031', 1),
+    ( 'chrishaydon42_5335', 'ross', 'miller', 'Male', '3f7f11fc8d68737ab340dafa8228a8ba', 1),
+    ( 'john62_5336', 'morgan', 'bell', 'Male', 'cee38bdf685ff9779ffd7dfa84830914', 1),
+    ( 'maria40_5337', 'mike', 'mike', 'Female', '3c44940d53e0cf88126f4f2699275051', 1),
+    ( 'james50_5338', 'mark', 'john', 'Male', '3b0b82eb79617a9f8805573b1baec238', 1),
+    ( 'ross63_5339', 'morgan', 'mike', 'Female', '70d70c6d340b463fc4b3fde8d133de6d', 1)
+ON CONFLICT DO NOTHING;